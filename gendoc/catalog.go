@@ -0,0 +1,116 @@
+package main
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	cloud "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
+)
+
+// Catalog is the machine-readable schemas of the resources and data sources
+type Catalog struct {
+	Resources   map[string]*CatalogSchema `json:"resources"`
+	DataSources map[string]*CatalogSchema `json:"data_sources"`
+}
+
+type CatalogSchema struct {
+	Product     string                       `json:"product"`
+	Description string                       `json:"description"`
+	Deprecated  string                       `json:"deprecated,omitempty"`
+	Importable  bool                         `json:"importable,omitempty"`
+	IdFormat    string                       `json:"id_format,omitempty"`
+	Timeouts    []string                     `json:"timeouts,omitempty"`
+	Attributes  map[string]*CatalogAttribute `json:"attributes"`
+}
+
+type CatalogAttribute struct {
+	Type        string                       `json:"type"`
+	Description string                       `json:"description,omitempty"`
+	Required    bool                         `json:"required,omitempty"`
+	Optional    bool                         `json:"optional,omitempty"`
+	Computed    bool                         `json:"computed,omitempty"`
+	ForceNew    bool                         `json:"force_new,omitempty"`
+	Sensitive   bool                         `json:"sensitive,omitempty"`
+	Deprecated  string                       `json:"deprecated,omitempty"`
+	Default     interface{}                  `json:"default,omitempty"`
+	MaxItems    int                          `json:"max_items,omitempty"`
+	ElemType    string                       `json:"elem_type,omitempty"`
+	Block       map[string]*CatalogAttribute `json:"block,omitempty"`
+}
+
+// NewCatalog returns the empty catalog
+func NewCatalog() *Catalog {
+	return &Catalog{
+		Resources:   make(map[string]*CatalogSchema),
+		DataSources: make(map[string]*CatalogSchema),
+	}
+}
+
+// Add adds the resource or data source of the product into the catalog
+func (me *Catalog) Add(product, dtype, name, description string, resource *schema.Resource) {
+	s := &CatalogSchema{
+		Product:     product,
+		Description: description,
+		Deprecated:  resource.DeprecationMessage,
+		Attributes:  catalogAttributes(resource.Schema),
+	}
+	if dtype == "data_source" {
+		me.DataSources[name] = s
+		return
+	}
+
+	s.Importable = resource.Importer != nil
+	s.IdFormat = cloud.ResourceIdFormat(name)
+	if resource.Timeouts != nil {
+		for _, v := range []struct {
+			name    string
+			timeout *time.Duration
+		}{
+			{"create", resource.Timeouts.Create},
+			{"read", resource.Timeouts.Read},
+			{"update", resource.Timeouts.Update},
+			{"delete", resource.Timeouts.Delete},
+		} {
+			if v.timeout != nil {
+				s.Timeouts = append(s.Timeouts, v.name)
+			}
+		}
+	}
+	me.Resources[name] = s
+}
+
+// Marshal returns the indented JSON of the catalog, whose keys are sorted
+func (me *Catalog) Marshal() ([]byte, error) {
+	b, err := json.MarshalIndent(me, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+func catalogAttributes(schemas map[string]*schema.Schema) map[string]*CatalogAttribute {
+	attributes := make(map[string]*CatalogAttribute, len(schemas))
+	for k, v := range schemas {
+		attribute := &CatalogAttribute{
+			Type:        parseType(v),
+			Description: v.Description,
+			Required:    v.Required,
+			Optional:    v.Optional,
+			Computed:    v.Computed,
+			ForceNew:    v.ForceNew,
+			Sensitive:   v.Sensitive,
+			Deprecated:  v.Deprecated,
+			Default:     v.Default,
+			MaxItems:    v.MaxItems,
+		}
+		switch elem := v.Elem.(type) {
+		case *schema.Schema:
+			attribute.ElemType = parseType(elem)
+		case *schema.Resource:
+			attribute.Block = catalogAttributes(elem.Schema)
+		}
+		attributes[k] = attribute
+	}
+	return attributes
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCatalog(t *testing.T) {
+	catalog := NewCatalog()
+	catalog.Add("CLB", "resource", "tencentcloud_clb_listener_rule", "Provides a resource to create a CLB listener rule.", &schema.Resource{
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{Create: schema.DefaultTimeout(0)},
+		Schema: map[string]*schema.Schema{
+			"domain":    {Type: schema.TypeString, Required: true, ForceNew: true, Description: "Domain name."},
+			"scheduler": {Type: schema.TypeString, Optional: true, Default: "WRR", Description: "Scheduling method."},
+			"health_check": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"http_codes": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeInt}},
+				}},
+			},
+		},
+	})
+	catalog.Add("CLB", "data_source", "tencentcloud_clb_listener_rules", "Use this data source to query CLB listener rules.", &schema.Resource{
+		Importer: &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			"rule_list": {Type: schema.TypeList, Computed: true, Elem: &schema.Resource{Schema: map[string]*schema.Schema{}}},
+		},
+	})
+
+	b, err := catalog.Marshal()
+	if err != nil {
+		t.Fatalf("marshal catalog failed: %v", err)
+	}
+	var actual Catalog
+	if err := json.Unmarshal(b, &actual); err != nil {
+		t.Fatalf("unmarshal catalog failed: %v", err)
+	}
+
+	rule := actual.Resources["tencentcloud_clb_listener_rule"]
+	if rule == nil || rule.Product != "CLB" || !rule.Importable || rule.IdFormat != "<clb_id>#<listener_id>#<rule_id>" {
+		t.Fatalf("unexpected resource %+v", rule)
+	}
+	if len(rule.Timeouts) != 1 || rule.Timeouts[0] != "create" {
+		t.Errorf("unexpected timeouts %v", rule.Timeouts)
+	}
+	if domain := rule.Attributes["domain"]; domain.Type != "String" || !domain.Required || !domain.ForceNew {
+		t.Errorf("unexpected domain %+v", domain)
+	}
+	if scheduler := rule.Attributes["scheduler"]; scheduler.Default != "WRR" || !scheduler.Optional {
+		t.Errorf("unexpected scheduler %+v", scheduler)
+	}
+	healthCheck := rule.Attributes["health_check"]
+	if healthCheck.MaxItems != 1 || healthCheck.Block["http_codes"] == nil || healthCheck.Block["http_codes"].ElemType != "Int" {
+		t.Errorf("unexpected health_check %+v", healthCheck)
+	}
+
+	rules := actual.DataSources["tencentcloud_clb_listener_rules"]
+	if rules == nil || rules.Importable || !rules.Attributes["rule_list"].Computed {
+		t.Errorf("unexpected data source %+v", rules)
+	}
+}
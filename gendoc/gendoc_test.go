@@ -3,6 +3,9 @@ package main
 import (
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestSformatHCL(t *testing.T) {
@@ -96,3 +99,60 @@ func TestContainsBigSymbol(t *testing.T) {
 		}
 	}
 }
+
+func TestGetTimeouts(t *testing.T) {
+	resource := &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Hour),
+		},
+	}
+	expected := "* `create` - (Defaults to `90m`) Used when creating the resource.\n" +
+		"* `delete` - (Defaults to `2h`) Used when deleting the resource."
+	if timeouts := getTimeouts(resource); timeouts != expected {
+		t.Errorf("unexpected timeouts %q", timeouts)
+	}
+	if timeouts := getTimeouts(&schema.Resource{}); timeouts != "" {
+		t.Errorf("unexpected timeouts %q", timeouts)
+	}
+}
+
+func TestCheckHCL(t *testing.T) {
+	valid := strings.Replace(`
+Basic
+'hcl
+resource "tencentcloud_vpc" "foo" {
+  name       = "foo"
+  cidr_block = "10.0.0.0/16"
+}
+'
+`, "'", "```", -1)
+	if err := checkHCL(valid); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	for _, invalid := range []string{
+		"'hcl\nresource \"tencentcloud_vpc\" \"foo\" {\n  name =\n}\n'",
+		"'hcl\nresource \"tencentcloud_vpc\" \"foo\" {\n  name = \"foo\";\n}\n'",
+		"'hcl\nresource \"tencentcloud_vpc\" \"foo\" {\n  name = \"foo\"\n  name = \"bar\"\n}\n'",
+		"'hcl\nresource \"tencentcloud_vpc\" \"foo\" {\n  name = \"foo\"\n'",
+	} {
+		if err := checkHCL(strings.Replace(invalid, "'", "```", -1)); err == nil {
+			t.Errorf("expected error of %q", invalid)
+		}
+	}
+}
+
+func TestImportIds(t *testing.T) {
+	doc := strings.Replace(`CLB listener rule can be imported using the id, e.g.
+
+'
+$ terraform import tencentcloud_clb_listener_rule.foo lb-1#lbl-1#loc-1
+terraform import tencentcloud_clb_listener_rule.bar lb-2#lbl-2#loc-2
+terraform import tencentcloud_clb_listener.foo lb-3#lbl-3
+'`, "'", "```", -1)
+	ids := importIds(doc, "tencentcloud_clb_listener_rule")
+	if len(ids) != 2 || ids[0] != "lb-1#lbl-1#loc-1" || ids[1] != "lb-2#lbl-2#loc-2" {
+		t.Errorf("unexpected ids %v", ids)
+	}
+}
@@ -1,9 +1,12 @@
 package main
 
 import (
+	"bytes"
+	"flag"
 	"fmt"
 	"go/parser"
 	"go/token"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -12,8 +15,10 @@ import (
 	"sort"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/fatih/color"
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cloud "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
@@ -33,7 +38,22 @@ var (
 	bigSymbol  = regexp.MustCompile("([\u007F-\uffff])")
 )
 
+var (
+	check       = flag.Bool("check", false, "check the docs are up to date and the examples are valid, without writing them")
+	catalogFile = flag.String("catalog", "", "write the JSON schema catalog of the resources and data sources to the file")
+
+	// the docs generated in the check mode
+	checkedDocs = make(map[string][]byte)
+	// the docs which are stale or invalid in the check mode
+	checkFailures []string
+	// the resources which can be imported but have no Import section
+	missingImports []string
+	catalog        = NewCatalog()
+)
+
 func main() {
+	flag.Parse()
+
 	provider := cloud.Provider()
 	vProvider := runtime.FuncForPC(reflect.ValueOf(cloud.Provider).Pointer())
 
@@ -55,6 +75,46 @@ func main() {
 			genDoc(product.Name, "resource", filePath, resource, provider.ResourcesMap[resource])
 		}
 	}
+
+	if len(missingImports) > 0 {
+		message("[SKIP!]%d resources can be imported but have no Import section:\n  %s", len(missingImports), strings.Join(missingImports, "\n  "))
+	}
+
+	if *catalogFile != "" {
+		b, err := catalog.Marshal()
+		if err != nil {
+			message("[FAIL!]marshal catalog failed: %s", err)
+			os.Exit(1)
+		}
+		if err := ioutil.WriteFile(*catalogFile, b, 0644); err != nil {
+			message("[FAIL!]write catalog %s failed: %s", *catalogFile, err)
+			os.Exit(1)
+		}
+		message("[SUCC.]write catalog to file success: %s", *catalogFile)
+	}
+
+	if *check {
+		checkDocs()
+	}
+
+	if len(checkFailures) > 0 {
+		message("[FAIL!]%d docs are stale or invalid, run `make doc` and fix the examples:\n  %s", len(checkFailures), strings.Join(checkFailures, "\n  "))
+		os.Exit(1)
+	}
+}
+
+// writeDoc writes the doc to the file, or keeps it for checkDocs in the check mode
+func writeDoc(filename string, doc []byte) {
+	if *check {
+		checkedDocs[filename] = doc
+		return
+	}
+
+	if err := ioutil.WriteFile(filename, doc, 0644); err != nil {
+		message("[FAIL!]write file %s failed: %s", filename, err)
+		os.Exit(1)
+	}
+	message("[SUCC.]write doc to file success: %s", filename)
 }
 
 // genIdx generating index for resource
@@ -98,22 +158,27 @@ func genIdx(filePath string) (prods []Product) {
 	}
 
 	filename = filepath.Join(docRoot, "..", fmt.Sprintf("%s.erb", cloudMark))
-	fd, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
-	if err != nil {
-		message("[FAIL!]open file %s failed: %s", filename, err)
-		os.Exit(1)
-	}
-
-	defer fd.Close()
 
 	tmpl := template.Must(template.New("t").Funcs(template.FuncMap{"replace": replace}).Parse(idxTPL))
 
-	if err := tmpl.Execute(fd, data); err != nil {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
 		message("[FAIL!]write file %s failed: %s", filename, err)
 		os.Exit(1)
 	}
 
-	message("[SUCC.]write doc to file success: %s", filename)
+	writeDoc(filename, buf.Bytes())
+	return
+}
+
+// importIds returns the ids of the `terraform import` examples of the resource
+func importIds(doc, name string) (ids []string) {
+	for _, line := range strings.Split(doc, "\n") {
+		fields := strings.Fields(strings.TrimPrefix(strings.TrimSpace(line), "$"))
+		if len(fields) == 4 && fields[0] == "terraform" && fields[1] == "import" && strings.HasPrefix(fields[2], name+".") {
+			ids = append(ids, fields[3])
+		}
+	}
 	return
 }
 
@@ -130,6 +195,7 @@ func genDoc(product, dtype, fpath, name string, resource *schema.Resource) {
 		"description":       "",
 		"description_short": "",
 		"import":            "",
+		"timeouts":          "",
 	}
 
 	filename := fmt.Sprintf("%s_%s_%s.go", dtype, cloudMarkShort, data["resource"])
@@ -151,10 +217,30 @@ func genDoc(product, dtype, fpath, name string, resource *schema.Resource) {
 	if importPos != -1 {
 		data["import"] = strings.TrimSpace(description[importPos+8:])
 		description = strings.TrimSpace(description[:importPos])
+		if format := cloud.ResourceIdFormat(name); format != "" {
+			for _, id := range importIds(data["import"], name) {
+				if err := cloud.CheckResourceId(name, id); err != nil {
+					message("[FAIL!]import example of %s: %s\n", filename, err)
+					os.Exit(1)
+				}
+			}
+			data["import"] = fmt.Sprintf("The id is in the format of `%s`.\n\n%s", format, data["import"])
+		}
+	}
+
+	if dtype == "resource" && resource.Importer != nil && data["import"] == "" {
+		missingImports = append(missingImports, name)
 	}
 
 	pos := strings.Index(description, "\nExample Usage\n")
 	if pos != -1 {
+		if err := checkHCL(description[pos+15:]); err != nil {
+			message("[FAIL!]example usage is invalid: %s: %s\n", filename, err)
+			if !*check {
+				os.Exit(1)
+			}
+			checkFailures = append(checkFailures, filename)
+		}
 		data["example"] = formatHCL(description[pos+15:])
 		description = strings.TrimSpace(description[:pos])
 	} else {
@@ -169,6 +255,7 @@ func genDoc(product, dtype, fpath, name string, resource *schema.Resource) {
 	} else {
 		data["description_short"] = description
 	}
+	catalog.Add(product, dtype, name, data["description_short"], resource)
 
 	var (
 		requiredArgs []string
@@ -276,25 +363,77 @@ func genDoc(product, dtype, fpath, name string, resource *schema.Resource) {
 	if dtype == "resource" {
 		idAttribute := "* `id` - ID of the resource.\n"
 		data["attributes"] = idAttribute + data["attributes"]
+		data["timeouts"] = getTimeouts(resource)
 	}
 
 	filename = filepath.Join(docRoot, dtype[:1], fmt.Sprintf("%s.html.markdown", data["resource"]))
 
-	fd, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
-	if err != nil {
-		message("[FAIL!]open file %s failed: %s", filename, err)
-		os.Exit(1)
-	}
-
-	defer fd.Close()
+	var doc bytes.Buffer
 	t := template.Must(template.New("t").Parse(docTPL))
-	err = t.Execute(fd, data)
+	err = t.Execute(&doc, data)
 	if err != nil {
 		message("[FAIL!]write file %s failed: %s", filename, err)
 		os.Exit(1)
 	}
 
-	message("[SUCC.]write doc to file success: %s", filename)
+	writeDoc(filename, doc.Bytes())
+}
+
+// checkDocs compares the docs with the files, the last one is kept if a doc is generated in several products
+func checkDocs() {
+	filenames := make([]string, 0, len(checkedDocs))
+	for filename := range checkedDocs {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	for _, filename := range filenames {
+		old, err := ioutil.ReadFile(filename)
+		if err != nil && !os.IsNotExist(err) {
+			message("[FAIL!]read file %s failed: %s", filename, err)
+			os.Exit(1)
+		}
+		if !bytes.Equal(old, checkedDocs[filename]) {
+			message("[FAIL!]doc is stale: %s", filename)
+			checkFailures = append(checkFailures, filename)
+		}
+	}
+	message("[SUCC.]%d docs are checked", len(filenames))
+}
+
+// getTimeouts get the configurable timeouts of resource
+func getTimeouts(resource *schema.Resource) string {
+	if resource.Timeouts == nil {
+		return ""
+	}
+	operations := []struct {
+		name    string
+		action  string
+		timeout *time.Duration
+	}{
+		{"create", "creating", resource.Timeouts.Create},
+		{"read", "reading", resource.Timeouts.Read},
+		{"update", "updating", resource.Timeouts.Update},
+		{"delete", "deleting", resource.Timeouts.Delete},
+	}
+	var timeouts []string
+	for _, v := range operations {
+		if v.timeout != nil {
+			timeouts = append(timeouts, fmt.Sprintf("* `%s` - (Defaults to `%s`) Used when %s the resource.", v.name, formatDuration(*v.timeout), v.action))
+		}
+	}
+	return strings.Join(timeouts, "\n")
+}
+
+// formatDuration formats d like the value of timeouts, e.g. `30m`
+func formatDuration(d time.Duration) string {
+	if d%time.Hour == 0 {
+		return fmt.Sprintf("%dh", d/time.Hour)
+	}
+	if d%time.Minute == 0 {
+		return fmt.Sprintf("%dm", d/time.Minute)
+	}
+	return fmt.Sprintf("%ds", d/time.Second)
 }
 
 // getAttributes get attributes from schema
@@ -418,6 +557,17 @@ func formatHCL(s string) string {
 	return strings.TrimSpace(strings.Join(rr, "\n"))
 }
 
+// checkHCL returns the error of the first HCL code of the example usage which can not be parsed
+func checkHCL(s string) error {
+	for i, v := range hclMatch.FindAllStringSubmatch(strings.TrimSpace(s), -1) {
+		_, diags := hclwrite.ParseConfig([]byte(strings.TrimSpace(v[3])), fmt.Sprintf("example%d.tf", i+1), hcl.InitialPos)
+		if diags.HasErrors() {
+			return diags
+		}
+	}
+	return nil
+}
+
 func formatUsageDesc(s string) string {
 	var rr []string
 	s = strings.TrimSpace(s)
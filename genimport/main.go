@@ -0,0 +1,287 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	cloud "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
+)
+
+var (
+	flagTypes  = flag.String("type", "", "the resource types to adopt, separated by commas, like tencentcloud_vpc,tencentcloud_subnet")
+	flagRegion = flag.String("region", "", "the region of the resources, TENCENTCLOUD_REGION by default")
+	flagOut    = flag.String("out", "", "the file to write, the standard output by default")
+	flagList   = flag.Bool("list", false, "list the supported resource types")
+)
+
+var labelInvalid = regexp.MustCompile(`[^a-z0-9_]+`)
+
+func main() {
+	flag.Parse()
+	// the messages are written to the standard error, so the standard output is the HCL
+	color.Output = os.Stderr
+	if os.Getenv("TF_LOG") == "" {
+		log.SetOutput(ioutil.Discard)
+	}
+	if *flagList {
+		for _, resourceType := range cloud.ImportableResourceTypes() {
+			fmt.Println(resourceType)
+		}
+		return
+	}
+	if *flagTypes == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	provider := cloud.Provider()
+	config := map[string]interface{}{}
+	if *flagRegion != "" {
+		config["region"] = *flagRegion
+	}
+	if err := diagError(provider.Configure(ctx, terraform.NewResourceConfigRaw(config))); err != nil {
+		message("[FAIL] configure provider failed: %v", err)
+		os.Exit(1)
+	}
+
+	var out bytes.Buffer
+	out.WriteString("# Generated by genimport, the import blocks need Terraform 1.5 or later.\n")
+	labels := make(map[string]bool)
+	failed := false
+	for _, resourceType := range strings.Split(*flagTypes, ",") {
+		resourceType = strings.TrimSpace(resourceType)
+		resource, ok := provider.ResourcesMap[resourceType]
+		if !ok || resource.Importer == nil {
+			message("[FAIL] %s is not a resource which can be imported", resourceType)
+			failed = true
+			continue
+		}
+		candidates, err := cloud.ListImportCandidates(ctx, provider.Meta(), resourceType)
+		if err != nil {
+			message("[FAIL] list %s failed: %v", resourceType, err)
+			failed = true
+			continue
+		}
+		message("[SUCC] %d %s found", len(candidates), resourceType)
+		for _, candidate := range candidates {
+			state, err := readState(ctx, resource, candidate.Id, provider.Meta())
+			if err != nil {
+				message("[SKIP] read %s %s failed, its arguments need to be written: %v", resourceType, candidate.Id, err)
+			}
+			label := resourceLabel(candidate, labels)
+			writeImport(&out, resourceType, label, candidate.Id, resource, state)
+		}
+	}
+
+	var w io.Writer = os.Stdout
+	if *flagOut != "" {
+		file, err := os.Create(*flagOut)
+		if err != nil {
+			message("[FAIL] create %s failed: %v", *flagOut, err)
+			os.Exit(1)
+		}
+		defer file.Close()
+		w = file
+	}
+	if _, err := w.Write(hclwrite.Format(out.Bytes())); err != nil {
+		message("[FAIL] write failed: %v", err)
+		os.Exit(1)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// readState imports the resource of id like `terraform import`, and reads it
+func readState(ctx context.Context, resource *schema.Resource, id string, meta interface{}) (*terraform.InstanceState, error) {
+	d := resource.Data(nil)
+	d.SetId(id)
+	var results []*schema.ResourceData
+	var err error
+	if resource.Importer.StateContext != nil {
+		results, err = resource.Importer.StateContext(ctx, d, meta)
+	} else if resource.Importer.State != nil {
+		results, err = resource.Importer.State(d, meta)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(results) > 0 {
+		d = results[0]
+	}
+	state, diags := resource.RefreshWithoutUpgrade(ctx, d.State(), meta)
+	if err := diagError(diags); err != nil {
+		return nil, err
+	}
+	if state == nil || state.ID == "" {
+		return nil, fmt.Errorf("not found")
+	}
+	return state, nil
+}
+
+// resourceLabel returns the unique label of the resource block, which is named after the name of the resource
+func resourceLabel(candidate cloud.ImportCandidate, labels map[string]bool) string {
+	base := strings.Trim(labelInvalid.ReplaceAllString(strings.ToLower(candidate.Name), "_"), "_")
+	if base == "" {
+		base = strings.Trim(labelInvalid.ReplaceAllString(strings.ToLower(candidate.Id), "_"), "_")
+	}
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = "r_" + base
+	}
+	label := base
+	for i := 2; labels[label]; i++ {
+		label = base + "_" + strconv.Itoa(i)
+	}
+	labels[label] = true
+	return label
+}
+
+// writeImport writes the import block and the skeleton of the resource, which has the required arguments and the
+// optional ones set, a nil state writes an empty skeleton
+func writeImport(out *bytes.Buffer, resourceType, label, id string, resource *schema.Resource, state *terraform.InstanceState) {
+	fmt.Fprintf(out, "\nimport {\nto = %s.%s\nid = %s\n}\n\n", resourceType, label, hclString(id))
+	fmt.Fprintf(out, "resource %q %q {\n", resourceType, label)
+	if state != nil {
+		d := resource.Data(state)
+		writeArguments(out, resource.Schema, func(k string) interface{} { return d.Get(k) })
+	}
+	out.WriteString("}\n")
+}
+
+func writeArguments(out *bytes.Buffer, schemas map[string]*schema.Schema, get func(k string) interface{}) {
+	keys := make([]string, 0, len(schemas))
+	for k := range schemas {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		s := schemas[k]
+		v := get(k)
+		if s.Deprecated != "" || !(s.Required || s.Optional && !s.Computed && !isZero(v) && !isDefault(s, v)) {
+			continue
+		}
+		if s.Sensitive {
+			fmt.Fprintf(out, "# %s is sensitive, it is not read\n", k)
+			continue
+		}
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			if set, ok := v.(*schema.Set); ok {
+				v = set.List()
+			}
+			items, _ := v.([]interface{})
+			for _, item := range items {
+				block, _ := item.(map[string]interface{})
+				fmt.Fprintf(out, "%s {\n", k)
+				writeArguments(out, elem.Schema, func(k string) interface{} { return block[k] })
+				out.WriteString("}\n")
+			}
+			continue
+		}
+		fmt.Fprintf(out, "%s = %s\n", k, hclValue(v))
+	}
+}
+
+func isZero(v interface{}) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case int:
+		return value == 0
+	case float64:
+		return value == 0
+	case bool:
+		return !value
+	case []interface{}:
+		return len(value) == 0
+	case map[string]interface{}:
+		return len(value) == 0
+	case *schema.Set:
+		return value.Len() == 0
+	}
+	return false
+}
+
+func isDefault(s *schema.Schema, v interface{}) bool {
+	return s.Default != nil && fmt.Sprint(s.Default) == fmt.Sprint(v)
+}
+
+func hclValue(v interface{}) string {
+	switch value := v.(type) {
+	case string:
+		return hclString(value)
+	case int:
+		return strconv.Itoa(value)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(value)
+	case *schema.Set:
+		return hclValue(value.List())
+	case []interface{}:
+		items := make([]string, 0, len(value))
+		for _, item := range value {
+			items = append(items, hclValue(item))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for k := range value {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var b strings.Builder
+		b.WriteString("{\n")
+		for _, k := range keys {
+			fmt.Fprintf(&b, "%s = %s\n", hclString(k), hclValue(value[k]))
+		}
+		b.WriteString("}")
+		return b.String()
+	}
+	return "null"
+}
+
+// hclString quotes s, and escapes the template sequences
+func hclString(s string) string {
+	s = strconv.Quote(s)
+	s = strings.Replace(s, "${", "$${", -1)
+	return strings.Replace(s, "%{", "%%{", -1)
+}
+
+func diagError(diags diag.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return fmt.Errorf("%s %s", d.Summary, d.Detail)
+		}
+	}
+	return nil
+}
+
+func message(msg string, v ...interface{}) {
+	if strings.Contains(msg, "FAIL") {
+		color.Red(fmt.Sprintf(msg, v...))
+	} else if strings.Contains(msg, "SUCC") {
+		color.Green(fmt.Sprintf(msg, v...))
+	} else if strings.Contains(msg, "SKIP") {
+		color.Yellow(fmt.Sprintf(msg, v...))
+	} else {
+		color.White(fmt.Sprintf(msg, v...))
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	cloud "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
+)
+
+func TestWriteImport(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":        {Type: schema.TypeString, Required: true},
+			"port":        {Type: schema.TypeInt, Optional: true, Default: 80},
+			"enabled":     {Type: schema.TypeBool, Optional: true},
+			"zone":        {Type: schema.TypeString, Optional: true, Computed: true},
+			"password":    {Type: schema.TypeString, Optional: true, Sensitive: true},
+			"old_name":    {Type: schema.TypeString, Optional: true, Deprecated: "use name"},
+			"tags":        {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"subnet_ids":  {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"create_time": {Type: schema.TypeString, Computed: true},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"cidr":   {Type: schema.TypeString, Required: true},
+					"action": {Type: schema.TypeString, Optional: true, Default: "ACCEPT"},
+				}},
+			},
+		},
+	}
+	state := &terraform.InstanceState{ID: "res-1#2", Attributes: map[string]string{
+		"id":             "res-1#2",
+		"name":           "web ${var}",
+		"port":           "80",
+		"enabled":        "true",
+		"zone":           "ap-guangzhou-3",
+		"password":       "secret",
+		"old_name":       "web",
+		"tags.%":         "1",
+		"tags.owner":     "ops",
+		"subnet_ids.#":   "1",
+		"subnet_ids.123": "subnet-1",
+		"create_time":    "2006-01-02 15:04:05",
+		"rule.#":         "1",
+		"rule.0.cidr":    "10.0.0.0/8",
+		"rule.0.action":  "DROP",
+	}}
+
+	var out bytes.Buffer
+	writeImport(&out, "tencentcloud_test", "web", state.ID, resource, state)
+	writeImport(&out, "tencentcloud_test", "missing", "res-2", resource, nil)
+	expected := `
+import {
+  to = tencentcloud_test.web
+  id = "res-1#2"
+}
+
+resource "tencentcloud_test" "web" {
+  enabled = true
+  name    = "web $${var}"
+  # password is sensitive, it is not read
+  rule {
+    action = "DROP"
+    cidr   = "10.0.0.0/8"
+  }
+  subnet_ids = ["subnet-1"]
+  tags = {
+    "owner" = "ops"
+  }
+}
+
+import {
+  to = tencentcloud_test.missing
+  id = "res-2"
+}
+
+resource "tencentcloud_test" "missing" {
+}
+`
+	if actual := string(hclwrite.Format(out.Bytes())); actual != expected {
+		t.Errorf("unexpected HCL:\n%s\nexpected:\n%s", actual, expected)
+	}
+}
+
+func TestResourceLabel(t *testing.T) {
+	labels := make(map[string]bool)
+	for _, c := range []struct {
+		candidate cloud.ImportCandidate
+		expected  string
+	}{
+		{cloud.ImportCandidate{Id: "vpc-1", Name: "Web VPC"}, "web_vpc"},
+		{cloud.ImportCandidate{Id: "vpc-2", Name: "web-vpc"}, "web_vpc_2"},
+		{cloud.ImportCandidate{Id: "vpc-3", Name: "网络"}, "vpc_3"},
+		{cloud.ImportCandidate{Id: "12.rtb-1"}, "r_12_rtb_1"},
+	} {
+		if label := resourceLabel(c.candidate, labels); label != c.expected {
+			t.Errorf("expected %s of %+v, got %s", c.expected, c.candidate, label)
+		}
+	}
+}
@@ -1,10 +1,15 @@
 package tencentcloud
 
 import (
+	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/mockapi"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -224,3 +229,79 @@ desc: this is a description`
 	assert.Equalf(t, reflect.TypeOf(yaml1).String(), "map[interface {}]interface {}", "")
 	assert.Equalf(t, yaml1["name"], "test-name", "")
 }
+
+func TestRetryWithPolicy(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	var code string
+	server.Handle("cvm", "DescribeInstances", func(request *mockapi.Request) (interface{}, error) {
+		if code != "" {
+			return nil, mockapi.NewError(code, "failed")
+		}
+		return map[string]interface{}{"TotalCount": 0, "InstanceSet": []interface{}{}}, nil
+	})
+
+	policy := &connectivity.RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  2 * time.Millisecond,
+		RetryableErrorCodes: map[string][]string{
+			"cvm": {"ResourceInsufficient"},
+		},
+	}
+	client := testMockapiMeta(server).apiV3Conn
+	client.RetryPolicy = policy
+	attempts := 0
+	describe := func(ctx context.Context) (interface{}, error) {
+		attempts++
+		if attempts > 1 && code == "ResourceInsufficient.CloudDiskUnavailable" {
+			code = ""
+		}
+		return client.UseCvmClient().DescribeInstances(cvm.NewDescribeInstancesRequest())
+	}
+
+	// retryable error gives up after max attempts
+	code = "ResourceInUse"
+	_, err := RetryWithContext(context.Background(), time.Minute, describe)
+	assert.Equalf(t, 3, attempts, "")
+	assert.Contains(t, err.Error(), "giving up after 3 attempts")
+
+	// configured error is retryable
+	attempts, code = 0, "ResourceInsufficient.CloudDiskUnavailable"
+	output, err := RetryWithContext(context.Background(), time.Minute, describe)
+	assert.Equalf(t, nil, err, "")
+	assert.NotNil(t, output)
+	assert.Equalf(t, 2, attempts, "")
+
+	// non-retryable error returns immediately
+	attempts, code = 0, "InvalidParameter"
+	_, err = RetryWithContext(context.Background(), time.Minute, describe)
+	assert.Equalf(t, 1, attempts, "")
+	assert.Equalf(t, true, isExpectError(err, []string{"InvalidParameter"}), "")
+
+	// the policy is the one of the client which sent the request
+	other := testMockapiMeta(server).apiV3Conn
+	other.RetryPolicy = &connectivity.RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	attempts, code = 0, "ResourceInsufficient"
+	_, err = RetryWithContext(context.Background(), time.Minute, func(ctx context.Context) (interface{}, error) {
+		attempts++
+		return other.UseCvmClient().DescribeInstances(cvm.NewDescribeInstancesRequest())
+	})
+	assert.Equalf(t, 1, attempts, "the error codes of the other client must not apply")
+	attempts, code = 0, "ResourceInUse"
+	_, err = RetryWithContext(context.Background(), time.Minute, func(ctx context.Context) (interface{}, error) {
+		attempts++
+		return other.UseCvmClient().DescribeInstances(cvm.NewDescribeInstancesRequest())
+	})
+	assert.Equalf(t, 2, attempts, "")
+	assert.Contains(t, err.Error(), "giving up after 2 attempts")
+
+	// cancelled context stops retrying
+	policy.MaxAttempts = 0
+	policy.MinBackoff, policy.MaxBackoff = time.Minute, time.Minute
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	code = "ResourceInUse"
+	_, err = RetryWithContext(ctx, time.Hour, describe)
+	assert.Contains(t, err.Error(), context.Canceled.Error())
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/mitchellh/go-homedir"
 	"github.com/pkg/errors"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentyun/cos-go-sdk-v5"
 	"gopkg.in/yaml.v2"
 )
@@ -101,6 +102,10 @@ func init() {
 	logFirstTime = fmt.Sprintf("%d", time.Now().UnixNano()/int64(time.Millisecond))
 }
 
+// invalidEnvs records the environment variables which can not be parsed,
+// they are reported by providerConfigure instead of panicking at init.
+var invalidEnvs []string
+
 func getEnvDefault(key string, defVal int) int {
 	val, ex := os.LookupEnv(key)
 	if !ex {
@@ -108,11 +113,21 @@ func getEnvDefault(key string, defVal int) int {
 	}
 	int, err := strconv.Atoi(val)
 	if err != nil {
-		panic("TENCENTCLOUD_READ_RETRY_TIMEOUT or TENCENTCLOUD_WRITE_RETRY_TIMEOUT must be int.")
+		log.Printf("[CRITAL] environment variable %s must be int, got %q, use default %d", key, val, defVal)
+		invalidEnvs = append(invalidEnvs, fmt.Sprintf("`%s` must be int, got %q", key, val))
+		return defVal
 	}
 	return int
 }
 
+// checkInvalidEnvs returns the error of invalid environment variables
+func checkInvalidEnvs() error {
+	if len(invalidEnvs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid environment variables: %s", strings.Join(invalidEnvs, ", "))
+}
+
 // string to time.Time
 func stringTotime(t string) time.Time {
 	template := TENCENTCLOUD_COMMON_TIME_LAYOUT
@@ -187,6 +202,11 @@ func inconsistentCheck(d *schema.ResourceData, meta interface{}) func() {
 
 // retryError returns retry error
 func retryError(err error, additionRetryableError ...string) *resource.RetryError {
+	if isContextError(err) {
+		log.Printf("[CRITAL] Canceled error: %v", err)
+		return resource.NonRetryableError(err)
+	}
+
 	switch realErr := errors.Cause(err).(type) {
 	case *sdkErrors.TencentCloudSDKError:
 		if isExpectError(realErr, retryableErrorCode) {
@@ -194,6 +214,14 @@ func retryError(err error, additionRetryableError ...string) *resource.RetryErro
 			return resource.RetryableError(err)
 		}
 
+		policy := connectivity.RequestRetryPolicy(realErr.RequestId)
+		if codes := policy.ErrorCodes(connectivity.RequestProduct(realErr.RequestId)); len(codes) > 0 {
+			if isExpectError(realErr, codes) {
+				log.Printf("[CRITAL] Retryable configured error: %v", err)
+				return resource.RetryableError(err)
+			}
+		}
+
 		if len(additionRetryableError) > 0 {
 			if isExpectError(realErr, additionRetryableError) {
 				log.Printf("[CRITAL] Retryable addition error: %v", err)
@@ -205,6 +233,12 @@ func retryError(err error, additionRetryableError ...string) *resource.RetryErro
 			log.Printf("[CRITAL] Retryable defined error: %v", err)
 			return resource.RetryableError(err)
 		}
+		if codes := connectivity.RequestRetryPolicy(cosRequestId(realErr)).ErrorCodes("cos"); len(codes) > 0 {
+			if isCosExpectedError(realErr, codes) {
+				log.Printf("[CRITAL] Retryable configured error: %v", err)
+				return resource.RetryableError(err)
+			}
+		}
 		if len(additionRetryableError) > 0 {
 			if isCosExpectedError(realErr, additionRetryableError) {
 				log.Printf("[CRITAL] Retryable additional error: %v", err)
@@ -219,15 +253,27 @@ func retryError(err error, additionRetryableError ...string) *resource.RetryErro
 }
 
 // RetryWhenContext retries the function `f` when the error it returns satisfies `predicate`.
-// `f` is retried until `timeout` expires.
+// `f` is retried until `timeout` expires, or the max attempts of the retry policy of the client reached.
 func RetryWithContext(
 	ctx context.Context,
 	timeout time.Duration,
 	f func(context.Context) (interface{}, error),
 	additionRetryableError ...string) (interface{}, error) {
-	var output interface{}
+	deadline := time.Now().Add(timeout)
+
+	// the policy is the one of the client which sent the failed request, so it is known after the first attempt
+	output, err := f(ctx)
+	if err == nil {
+		return output, nil
+	}
+	if policy := errorRetryPolicy(err); policy.Configured() {
+		return retryWithPolicy(ctx, policy, deadline, f, err, additionRetryableError...)
+	}
+	if retryErr := retryError(err, additionRetryableError...); !retryErr.Retryable {
+		return nil, retryErr.Err
+	}
 
-	retryErr := resource.Retry(timeout, func() *resource.RetryError {
+	retryErr := resource.Retry(time.Until(deadline), func() *resource.RetryError {
 		var err error
 		output, err = f(ctx)
 
@@ -243,6 +289,64 @@ func RetryWithContext(
 	return output, nil
 }
 
+// retryWithPolicy retries `f` which failed with err, with the max attempts and exponential backoff of policy,
+// until deadline or `ctx` is done.
+func retryWithPolicy(
+	ctx context.Context,
+	policy *connectivity.RetryPolicy,
+	deadline time.Time,
+	f func(context.Context) (interface{}, error),
+	err error,
+	additionRetryableError ...string) (interface{}, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	for attempt := 0; ; attempt++ {
+		retryErr := retryError(err, additionRetryableError...)
+		if !retryErr.Retryable {
+			return nil, retryErr.Err
+		}
+		if policy.MaxAttempts > 0 && attempt+1 >= policy.MaxAttempts {
+			return nil, fmt.Errorf("giving up after %d attempts: %w", attempt+1, retryErr.Err)
+		}
+
+		backoff := policy.Backoff(attempt)
+		if time.Now().Add(backoff).After(deadline) {
+			return nil, fmt.Errorf("timeout after %d attempts: %w", attempt+1, retryErr.Err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%v: %w", ctx.Err(), retryErr.Err)
+		case <-time.After(backoff):
+		}
+
+		var output interface{}
+		if output, err = f(ctx); err == nil {
+			return output, nil
+		}
+	}
+}
+
+// errorRetryPolicy returns the retry policy of the client which sent the request failed with err
+func errorRetryPolicy(err error) *connectivity.RetryPolicy {
+	switch realErr := errors.Cause(err).(type) {
+	case *sdkErrors.TencentCloudSDKError:
+		return connectivity.RequestRetryPolicy(realErr.RequestId)
+	case *cos.ErrorResponse:
+		return connectivity.RequestRetryPolicy(cosRequestId(realErr))
+	}
+	return nil
+}
+
+// cosRequestId returns the RequestId of COS error, which is in the response header if not in the body
+func cosRequestId(err *cos.ErrorResponse) string {
+	if err.RequestID == "" && err.Response != nil {
+		return err.Response.Header.Get("X-Cos-Request-Id")
+	}
+	return err.RequestID
+}
+
 // isCosExpectedError returns whether error is expected error when using COS SDK
 func isCosExpectedError(err error, expectedError []string) bool {
 	e, ok := err.(*cos.ErrorResponse)
@@ -395,6 +499,21 @@ func BuildTagResourceName(serviceType, resourceType, region, id string) string {
 	}
 }
 
+// ParseTagResourceName parses the name built by BuildTagResourceName, like the ones returned by the tag API
+func ParseTagResourceName(name string) (serviceType, resourceType, region, id string, err error) {
+	segments := strings.SplitN(name, ":", 6)
+	if len(segments) != 6 || segments[0] != "qcs" {
+		err = fmt.Errorf("invalid tag resource name %s", name)
+		return
+	}
+	resource := strings.SplitN(segments[5], "/", 2)
+	if len(resource) != 2 || resource[0] == "" || resource[1] == "" {
+		err = fmt.Errorf("invalid resource %s of tag resource name %s", segments[5], name)
+		return
+	}
+	return segments[2], resource[0], segments[3], resource[1], nil
+}
+
 // IsContains returns whether value is within array
 func IsContains(array interface{}, value interface{}) bool {
 	vv := reflect.ValueOf(array)
@@ -0,0 +1,388 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// CassetteMode is the mode of a Cassette
+type CassetteMode string
+
+const (
+	// CassetteRecord sends the requests and records them with the responses
+	CassetteRecord CassetteMode = "record"
+	// CassetteReplay serves the recorded responses without sending the requests
+	CassetteReplay CassetteMode = "replay"
+)
+
+// scrubbedHeaders are the headers not recorded, which are the credentials, the signatures and the ones changing
+// every request
+var scrubbedHeaders = map[string]bool{
+	"Authorization":        true,
+	"X-Tc-Token":           true,
+	"X-Tc-Timestamp":       true,
+	"X-Tc-Requestclient":   true,
+	"X-Cos-Security-Token": true,
+	"X-Amz-Security-Token": true,
+	"X-Amz-Date":           true,
+	"User-Agent":           true,
+	"Date":                 true,
+	"Content-Length":       true,
+	"Accept-Encoding":      true,
+	"Set-Cookie":           true,
+}
+
+// scrubbedParameters are the parameters of the signature v1 not recorded, which are in the query or the form body
+var scrubbedParameters = []string{"SecretId", "Signature", "SignatureMethod", "Timestamp", "Nonce", "Token"}
+
+// Interaction is a request and its response recorded by a Cassette
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest is a recorded request, its body is redacted like the logs
+type CassetteRequest struct {
+	Method string            `json:"method"`
+	URL    string            `json:"url"`
+	Header map[string]string `json:"header,omitempty"`
+	Body   string            `json:"body,omitempty"`
+}
+
+// CassetteResponse is a recorded response, its body is redacted like the logs
+type CassetteResponse struct {
+	Status int               `json:"status"`
+	Header map[string]string `json:"header,omitempty"`
+	Body   string            `json:"body,omitempty"`
+}
+
+// Cassette records the API requests of a test run into a file, and replays them later without an account.
+// It is the transport under LogRoundTripper, see RoundTripper.
+//
+// A request is replayed by the first unused interaction with the same method, URL, X-TC and X-COS headers and body,
+// or by the last used one if all of them are used, so the responses are served in the recorded order.
+type Cassette struct {
+	path string
+	mode CassetteMode
+
+	mutex        sync.Mutex
+	interactions []*Interaction
+	used         []bool
+}
+
+// NewCassette returns a cassette of the file path, which is loaded in the replay mode
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	me := &Cassette{path: path, mode: mode}
+	switch mode {
+	case CassetteRecord:
+	case CassetteReplay:
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(content, &me.interactions); err != nil {
+			return nil, fmt.Errorf("decode cassette %s failed: %v", path, err)
+		}
+		me.used = make([]bool, len(me.interactions))
+	default:
+		return nil, fmt.Errorf("invalid cassette mode %q, expected %q or %q", mode, CassetteRecord, CassetteReplay)
+	}
+	return me, nil
+}
+
+// Mode returns the mode of the cassette
+func (me *Cassette) Mode() CassetteMode {
+	return me.mode
+}
+
+// Save writes the recorded interactions to the file of the cassette
+func (me *Cassette) Save() error {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+
+	if me.mode != CassetteRecord {
+		return nil
+	}
+	content, err := json.MarshalIndent(me.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(me.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(me.path, append(content, '\n'), 0644)
+}
+
+// RoundTripper returns the transport which records the requests sent by transport, or replays them
+func (me *Cassette) RoundTripper(transport http.RoundTripper) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &cassetteRoundTripper{cassette: me, transport: transport}
+}
+
+type cassetteRoundTripper struct {
+	cassette  *Cassette
+	transport http.RoundTripper
+}
+
+func (me *cassetteRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	var body []byte
+	if request.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(request.Body); err != nil {
+			return nil, err
+		}
+		_ = request.Body.Close()
+		request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	recorded := CassetteRequest{
+		Method: request.Method,
+		URL:    cassetteURL(request.URL),
+		Header: cassetteHeader(request.Header),
+		Body:   cassetteRequestBody(body),
+	}
+
+	if me.cassette.mode == CassetteReplay {
+		interaction, err := me.cassette.replay(recorded)
+		if err != nil {
+			return nil, err
+		}
+		response := &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+			StatusCode:    interaction.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        make(http.Header),
+			Body:          ioutil.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       request,
+		}
+		for k, v := range interaction.Response.Header {
+			response.Header.Set(k, v)
+		}
+		return response, nil
+	}
+
+	response, err := me.transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	out, err := ioutil.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(out))
+
+	me.cassette.mutex.Lock()
+	defer me.cassette.mutex.Unlock()
+	me.cassette.interactions = append(me.cassette.interactions, &Interaction{
+		Request: recorded,
+		Response: CassetteResponse{
+			Status: response.StatusCode,
+			Header: cassetteHeader(response.Header),
+			Body:   cassetteBody(out),
+		},
+	})
+	return response, nil
+}
+
+func (me *Cassette) replay(request CassetteRequest) (*Interaction, error) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+
+	last := -1
+	for i, interaction := range me.interactions {
+		if !interaction.matches(request) {
+			continue
+		}
+		if !me.used[i] {
+			me.used[i] = true
+			return interaction, nil
+		}
+		last = i
+	}
+	if last >= 0 {
+		return me.interactions[last], nil
+	}
+	return nil, fmt.Errorf("no interaction of %s %s %s is recorded in cassette %s, the request may be changed and need to be recorded again",
+		request.Method, request.URL, request.Header["X-Tc-Action"], me.path)
+}
+
+func (me *Interaction) matches(request CassetteRequest) bool {
+	if me.Request.Method != request.Method || me.Request.URL != request.URL || me.Request.Body != request.Body {
+		return false
+	}
+	return reflect.DeepEqual(apiHeaders(me.Request.Header), apiHeaders(request.Header))
+}
+
+// apiHeaders returns the X-TC and X-COS headers, which are the parameters of the requests
+func apiHeaders(header map[string]string) map[string]string {
+	result := make(map[string]string)
+	for k, v := range header {
+		if strings.HasPrefix(k, "X-Tc-") || strings.HasPrefix(k, "X-Cos-") {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// cassetteHeader returns the headers to be recorded, which are the first values of the headers not scrubbed
+func cassetteHeader(header http.Header) map[string]string {
+	result := make(map[string]string, len(header))
+	for k, values := range header {
+		// the SDK sets the X-TC headers without canonicalizing the keys
+		key := http.CanonicalHeaderKey(k)
+		if scrubbedHeaders[key] || len(values) == 0 {
+			continue
+		}
+		result[key] = values[0]
+	}
+	return result
+}
+
+// cassetteBody returns the body to be recorded, the sensitive fields of a JSON body are redacted like the logs,
+// and the JSON is re-encoded so the same content is recorded the same
+func cassetteBody(body []byte) string {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		if form, err := url.ParseQuery(string(body)); err == nil && form.Get("Signature") != "" {
+			return scrubParameters(form).Encode()
+		}
+		return string(body)
+	}
+	logConfig.RLock()
+	value = redact(value)
+	logConfig.RUnlock()
+
+	content, err := json.Marshal(value)
+	if err != nil {
+		return string(body)
+	}
+	return string(content)
+}
+
+// cassetteRequestBody returns the request body to be recorded and matched, it is cassetteBody except that the
+// XML is canonicalized, since the S3 SDK encodes the fields of a request in the order of a map
+func cassetteRequestBody(body []byte) string {
+	if content, ok := canonicalXML(body); ok {
+		return content
+	}
+	return cassetteBody(body)
+}
+
+// xmlElement is an element of an XML body, its names keep the prefixes of the namespaces
+type xmlElement struct {
+	name     string
+	attrs    []xml.Attr
+	text     string
+	children []*xmlElement
+}
+
+// canonicalXML returns the XML body with the children of the elements sorted by name and the spaces around the
+// texts removed
+func canonicalXML(body []byte) (string, bool) {
+	if !bytes.HasPrefix(bytes.TrimSpace(body), []byte("<")) {
+		return "", false
+	}
+	var root *xmlElement
+	var stack []*xmlElement
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", false
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			element := &xmlElement{name: xmlName(token.Name), attrs: token.Attr}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, element)
+			} else if root == nil {
+				root = element
+			} else {
+				return "", false
+			}
+			stack = append(stack, element)
+		case xml.EndElement:
+			if len(stack) == 0 {
+				return "", false
+			}
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text += string(token)
+			}
+		}
+	}
+	if root == nil || len(stack) > 0 {
+		return "", false
+	}
+	var content strings.Builder
+	writeXMLElement(&content, root)
+	return content.String(), true
+}
+
+func xmlName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+func writeXMLElement(content *strings.Builder, element *xmlElement) {
+	content.WriteString("<" + element.name)
+	for _, attr := range element.attrs {
+		content.WriteString(" " + xmlName(attr.Name) + `="`)
+		_ = xml.EscapeText(content, []byte(attr.Value))
+		content.WriteString(`"`)
+	}
+	content.WriteString(">")
+	_ = xml.EscapeText(content, []byte(strings.TrimSpace(element.text)))
+	// the elements of the same name are a list, whose order is kept
+	sort.SliceStable(element.children, func(i, j int) bool {
+		return element.children[i].name < element.children[j].name
+	})
+	for _, child := range element.children {
+		writeXMLElement(content, child)
+	}
+	content.WriteString("</" + element.name + ">")
+}
+
+// cassetteURL returns the URL to be recorded, whose query is scrubbed if signed
+func cassetteURL(u *url.URL) string {
+	query := u.Query()
+	if query.Get("Signature") == "" {
+		return u.String()
+	}
+	scrubbed := *u
+	scrubbed.RawQuery = scrubParameters(query).Encode()
+	return scrubbed.String()
+}
+
+func scrubParameters(values url.Values) url.Values {
+	for _, k := range scrubbedParameters {
+		values.Del(k)
+	}
+	return values
+}
@@ -0,0 +1,118 @@
+package connectivity
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+)
+
+func TestCassette(t *testing.T) {
+	// the instance is pending at the first request
+	states := []string{"PENDING", "RUNNING"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state := states[0]
+		states = states[1:]
+		_, _ = w.Write([]byte(`{"Response":{"TotalCount":1,"InstanceSet":[{"InstanceId":"ins-1","InstanceState":"` + state +
+			`","LoginSettings":{"Password":"Pa55w0rd"}}],"RequestId":"req-1"}}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassettes", "TestCassette.json")
+	newClient := func(cassette *Cassette, secretKey string) *cvm.Client {
+		client := &TencentCloudClient{
+			Credential:    common.NewCredential("AKIDcassette", secretKey),
+			Region:        "ap-guangzhou",
+			Endpoints:     map[string]string{"cvm": server.URL},
+			HTTPTransport: cassette.RoundTripper(nil),
+		}
+		return client.UseCvmClient()
+	}
+	request := cvm.NewDescribeInstancesRequest()
+	request.InstanceIds = common.StringPtrs([]string{"ins-1"})
+
+	recorder, err := NewCassette(path, CassetteRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := newClient(recorder, "cassette-secret-key")
+	for _, expected := range []string{"PENDING", "RUNNING"} {
+		response, err := client.DescribeInstances(request)
+		if err != nil || *response.Response.InstanceSet[0].InstanceState != expected {
+			t.Fatalf("expected %s, got %v: %v", expected, response, err)
+		}
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, leaked := range []string{"AKIDcassette", "TC3-HMAC-SHA256", "Pa55w0rd", "X-Tc-Timestamp"} {
+		if strings.Contains(string(content), leaked) {
+			t.Errorf("%s is recorded in %s", leaked, content)
+		}
+	}
+
+	server.Close()
+	player, err := NewCassette(path, CassetteReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = newClient(player, "another-secret-key")
+	for _, expected := range []string{"PENDING", "RUNNING", "RUNNING"} {
+		response, err := client.DescribeInstances(request)
+		if err != nil || *response.Response.InstanceSet[0].InstanceState != expected {
+			t.Fatalf("expected %s replayed, got %v: %v", expected, response, err)
+		}
+	}
+
+	request.InstanceIds = common.StringPtrs([]string{"ins-2"})
+	if _, err := client.DescribeInstances(request); err == nil || !strings.Contains(err.Error(), "no interaction") {
+		t.Errorf("expected the changed request not replayed, got %v", err)
+	}
+
+	if _, err := NewCassette(filepath.Join(t.TempDir(), "missing.json"), CassetteReplay); err == nil {
+		t.Errorf("expected error of the missing cassette")
+	}
+	if _, err := NewCassette(path, "rewind"); err == nil {
+		t.Errorf("expected error of the invalid mode")
+	}
+}
+
+func TestCassetteScrubSignatureV1(t *testing.T) {
+	body := cassetteBody([]byte("Action=DescribeInstances&InstanceId=ins-1&Nonce=123&SecretId=AKIDcassette&Signature=abc&Timestamp=1"))
+	if body != "Action=DescribeInstances&InstanceId=ins-1" {
+		t.Errorf("unexpected body %s", body)
+	}
+	request, _ := http.NewRequest(http.MethodGet, "https://cvm.tencentcloudapi.com/?Action=DescribeInstances&SecretId=AKIDcassette&Signature=abc", nil)
+	if u := cassetteURL(request.URL); u != "https://cvm.tencentcloudapi.com/?Action=DescribeInstances" {
+		t.Errorf("unexpected url %s", u)
+	}
+}
+
+func TestCassetteCanonicalXML(t *testing.T) {
+	a := cassetteRequestBody([]byte(`<CORSConfiguration><CORSRule><MaxAgeSeconds>300</MaxAgeSeconds><AllowedMethod>GET</AllowedMethod><AllowedMethod>POST</AllowedMethod></CORSRule></CORSConfiguration>`))
+	b := cassetteRequestBody([]byte(`<CORSConfiguration>
+  <CORSRule>
+    <AllowedMethod>GET</AllowedMethod>
+    <AllowedMethod>POST</AllowedMethod>
+    <MaxAgeSeconds>300</MaxAgeSeconds>
+  </CORSRule>
+</CORSConfiguration>`))
+	if a != b {
+		t.Errorf("the same XML is recorded differently: %s and %s", a, b)
+	}
+	if expected := "<CORSConfiguration><CORSRule><AllowedMethod>GET</AllowedMethod><AllowedMethod>POST</AllowedMethod><MaxAgeSeconds>300</MaxAgeSeconds></CORSRule></CORSConfiguration>"; a != expected {
+		t.Errorf("unexpected body %s", a)
+	}
+	if body := cassetteRequestBody([]byte("<not xml")); body != "<not xml" {
+		t.Errorf("unexpected body %s", body)
+	}
+}
@@ -1,9 +1,8 @@
 package connectivity
 
 import (
-	"fmt"
+	"context"
 	"net/http"
-	"net/url"
 	"os"
 	"strconv"
 	"time"
@@ -86,6 +85,7 @@ import (
 	vod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vod/v20180717"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	ssl "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/wss/v20180426"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 const (
@@ -95,10 +95,20 @@ const (
 
 // TencentCloudClient is client for all TencentCloud service
 type TencentCloudClient struct {
-	Credential *common.Credential
-	Region     string
-	Protocol   string
-	Domain     string
+	Credential  common.CredentialIface
+	Region      string
+	Protocol    string
+	Domain      string
+	RetryPolicy *RetryPolicy
+	// Endpoints overrides the endpoints of products, the key is one of EndpointProducts
+	Endpoints map[string]string
+	// HTTPTransport sends the requests of all the clients, http.DefaultTransport is used if nil
+	HTTPTransport http.RoundTripper
+	// RateLimits limits the requests of all the clients, the default limits are used if nil
+	RateLimits *ratelimit.Limits
+
+	// ctx is the context of the requests, see WithContext
+	ctx context.Context
 
 	cosConn            *s3.S3
 	tencentCosConn     *cos.Client
@@ -173,6 +183,38 @@ type TencentCloudClient struct {
 	cdwchConn          *cdwch.Client
 }
 
+// WithRegion returns a client of region with the same credential and settings as me,
+// the clients of products are created on demand and not shared with me
+func (me *TencentCloudClient) WithRegion(region string) *TencentCloudClient {
+	return &TencentCloudClient{
+		Credential:    me.Credential,
+		Region:        region,
+		Protocol:      me.Protocol,
+		Domain:        me.Domain,
+		RetryPolicy:   me.RetryPolicy,
+		Endpoints:     me.Endpoints,
+		HTTPTransport: me.HTTPTransport,
+		RateLimits:    me.RateLimits,
+		ctx:           me.ctx,
+	}
+}
+
+// WithContext returns a client with the same region and settings as me, whose requests are sent with ctx,
+// so they are canceled when ctx is done. The clients of products are created on demand and not shared with me
+func (me *TencentCloudClient) WithContext(ctx context.Context) *TencentCloudClient {
+	client := me.WithRegion(me.Region)
+	client.ctx = ctx
+	return client
+}
+
+// Context returns the context of the requests, it is context.Background if the client is not bound to a context
+func (me *TencentCloudClient) Context() context.Context {
+	if me.ctx == nil {
+		return context.Background()
+	}
+	return me.ctx
+}
+
 // NewClientProfile returns a new ClientProfile
 func (me *TencentCloudClient) NewClientProfile(timeout int) *profile.ClientProfile {
 	cpf := profile.NewClientProfile()
@@ -187,6 +229,15 @@ func (me *TencentCloudClient) NewClientProfile(timeout int) *profile.ClientProfi
 	cpf.HttpProfile.RootDomain = me.Domain
 	// default language
 	cpf.Language = "en-US"
+	// retry the rate limited and network failed requests
+	if me.RetryPolicy.Configured() {
+		if me.RetryPolicy.MaxAttempts > 0 {
+			cpf.RateLimitExceededMaxRetries = me.RetryPolicy.MaxAttempts - 1
+			cpf.NetworkFailureMaxRetries = me.RetryPolicy.MaxAttempts - 1
+		}
+		cpf.RateLimitExceededRetryDuration = me.RetryPolicy.Backoff
+		cpf.NetworkFailureRetryDuration = me.RetryPolicy.Backoff
+	}
 
 	return cpf
 }
@@ -205,6 +256,15 @@ func (me *TencentCloudClient) NewClientIntlProfile(timeout int) *intlProfile.Cli
 	cpf.HttpProfile.RootDomain = me.Domain
 	// default language
 	cpf.Language = "en-US"
+	// retry the rate limited and network failed requests
+	if me.RetryPolicy.Configured() {
+		if me.RetryPolicy.MaxAttempts > 0 {
+			cpf.RateLimitExceededMaxRetries = me.RetryPolicy.MaxAttempts - 1
+			cpf.NetworkFailureMaxRetries = me.RetryPolicy.MaxAttempts - 1
+		}
+		cpf.RateLimitExceededRetryDuration = me.RetryPolicy.Backoff
+		cpf.NetworkFailureRetryDuration = me.RetryPolicy.Backoff
+	}
 
 	return cpf
 }
@@ -218,18 +278,19 @@ func (me *TencentCloudClient) UseCosClient() *s3.S3 {
 	resolver := func(service, region string, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
 		if service == endpoints.S3ServiceID {
 			return endpoints.ResolvedEndpoint{
-				URL:           fmt.Sprintf("https://cos.%s.myqcloud.com", region),
+				URL:           me.cosURL("cos", "").String(),
 				SigningRegion: region,
 			}, nil
 		}
 		return endpoints.DefaultResolver().EndpointFor(service, region, optFns...)
 	}
 
-	creds := credentials.NewStaticCredentials(me.Credential.SecretId, me.Credential.SecretKey, me.Credential.Token)
+	creds := credentials.NewCredentials(&awsCredentialProvider{credential: me.Credential})
 	sess := session.Must(session.NewSession(&aws.Config{
 		Credentials:      creds,
 		Region:           aws.String(me.Region),
 		EndpointResolver: endpoints.ResolverFunc(resolver),
+		HTTPClient:       &http.Client{Transport: me.httpTransport()},
 	}))
 
 	return s3.New(sess)
@@ -237,9 +298,9 @@ func (me *TencentCloudClient) UseCosClient() *s3.S3 {
 
 // UseTencentCosClient tencent cloud own client for service instead of aws
 func (me *TencentCloudClient) UseTencentCosClient(bucket string) *cos.Client {
-	u, _ := url.Parse(fmt.Sprintf("https://%s.cos.%s.myqcloud.com", bucket, me.Region))
+	u := me.cosURL("cos", bucket)
 
-	if me.tencentCosConn != nil && me.tencentCosConn.BaseURL.BucketURL == u {
+	if me.tencentCosConn != nil && me.tencentCosConn.BaseURL.BucketURL.String() == u.String() {
 		return me.tencentCosConn
 	}
 
@@ -248,12 +309,8 @@ func (me *TencentCloudClient) UseTencentCosClient(bucket string) *cos.Client {
 	}
 
 	me.tencentCosConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: 100 * time.Second,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
+		Timeout:   100 * time.Second,
+		Transport: me.NewCosAuthorizationTransport(),
 	})
 
 	return me.tencentCosConn
@@ -265,9 +322,9 @@ func (me *TencentCloudClient) UseMysqlClient() *cdb.Client {
 		return me.mysqlConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("cdb", 300)
 	me.mysqlConn, _ = cdb.NewClient(me.Credential, me.Region, cpf)
-	me.mysqlConn.WithHttpTransport(&LogRoundTripper{})
+	me.mysqlConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.mysqlConn
 }
@@ -278,9 +335,9 @@ func (me *TencentCloudClient) UseRedisClient() *redis.Client {
 		return me.redisConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("redis", 300)
 	me.redisConn, _ = redis.NewClient(me.Credential, me.Region, cpf)
-	me.redisConn.WithHttpTransport(&LogRoundTripper{})
+	me.redisConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.redisConn
 }
@@ -291,9 +348,9 @@ func (me *TencentCloudClient) UseAsClient() *as.Client {
 		return me.asConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("as", 300)
 	me.asConn, _ = as.NewClient(me.Credential, me.Region, cpf)
-	me.asConn.WithHttpTransport(&LogRoundTripper{})
+	me.asConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.asConn
 }
@@ -304,9 +361,9 @@ func (me *TencentCloudClient) UseVpcClient() *vpc.Client {
 		return me.vpcConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("vpc", 300)
 	me.vpcConn, _ = vpc.NewClient(me.Credential, me.Region, cpf)
-	me.vpcConn.WithHttpTransport(&LogRoundTripper{})
+	me.vpcConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.vpcConn
 }
@@ -318,9 +375,9 @@ func (me *TencentCloudClient) UseCbsClient() *cbs.Client {
 	}
 
 	var reqTimeout = getEnvDefault(PROVIDER_CBS_REQUEST_TIMEOUT, 300)
-	cpf := me.NewClientProfile(reqTimeout)
+	cpf := me.newServiceClientProfile("cbs", reqTimeout)
 	me.cbsConn, _ = cbs.NewClient(me.Credential, me.Region, cpf)
-	me.cbsConn.WithHttpTransport(&LogRoundTripper{})
+	me.cbsConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.cbsConn
 }
@@ -331,9 +388,9 @@ func (me *TencentCloudClient) UseDcClient() *dc.Client {
 		return me.dcConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("dc", 300)
 	me.dcConn, _ = dc.NewClient(me.Credential, me.Region, cpf)
-	me.dcConn.WithHttpTransport(&LogRoundTripper{})
+	me.dcConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.dcConn
 }
@@ -344,9 +401,9 @@ func (me *TencentCloudClient) UseMongodbClient() *mongodb.Client {
 		return me.mongodbConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("mongodb", 300)
 	me.mongodbConn, _ = mongodb.NewClient(me.Credential, me.Region, cpf)
-	me.mongodbConn.WithHttpTransport(&LogRoundTripper{})
+	me.mongodbConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.mongodbConn
 }
@@ -357,9 +414,9 @@ func (me *TencentCloudClient) UseClbClient() *clb.Client {
 		return me.clbConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("clb", 300)
 	me.clbConn, _ = clb.NewClient(me.Credential, me.Region, cpf)
-	me.clbConn.WithHttpTransport(&LogRoundTripper{})
+	me.clbConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.clbConn
 }
@@ -371,9 +428,9 @@ func (me *TencentCloudClient) UseCvmClient() *cvm.Client {
 	}
 
 	var reqTimeout = getEnvDefault(PROVIDER_CVM_REQUEST_TIMEOUT, 300)
-	cpf := me.NewClientProfile(reqTimeout)
+	cpf := me.newServiceClientProfile("cvm", reqTimeout)
 	me.cvmConn, _ = cvm.NewClient(me.Credential, me.Region, cpf)
-	me.cvmConn.WithHttpTransport(&LogRoundTripper{})
+	me.cvmConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.cvmConn
 }
@@ -384,9 +441,9 @@ func (me *TencentCloudClient) UseTagClient() *tag.Client {
 		return me.tagConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("tag", 300)
 	me.tagConn, _ = tag.NewClient(me.Credential, me.Region, cpf)
-	me.tagConn.WithHttpTransport(&LogRoundTripper{})
+	me.tagConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.tagConn
 }
@@ -397,9 +454,9 @@ func (me *TencentCloudClient) UseTkeClient() *tke.Client {
 		return me.tkeConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("tke", 300)
 	me.tkeConn, _ = tke.NewClient(me.Credential, me.Region, cpf)
-	me.tkeConn.WithHttpTransport(&LogRoundTripper{})
+	me.tkeConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.tkeConn
 }
@@ -410,9 +467,9 @@ func (me *TencentCloudClient) UseTdmqClient() *tdmq.Client {
 		return me.tdmqConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("tdmq", 300)
 	me.tdmqConn, _ = tdmq.NewClient(me.Credential, me.Region, cpf)
-	me.tdmqConn.WithHttpTransport(&LogRoundTripper{})
+	me.tdmqConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.tdmqConn
 }
@@ -423,9 +480,9 @@ func (me *TencentCloudClient) UseGaapClient() *gaap.Client {
 		return me.gaapConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("gaap", 300)
 	me.gaapConn, _ = gaap.NewClient(me.Credential, me.Region, cpf)
-	me.gaapConn.WithHttpTransport(&LogRoundTripper{})
+	me.gaapConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.gaapConn
 }
@@ -436,9 +493,11 @@ func (me *TencentCloudClient) UseSslClient() *ssl.Client {
 		return me.sslConn
 	}
 
-	cpf := me.NewClientProfile(300)
-	me.sslConn, _ = ssl.NewClient(me.Credential, me.Region, cpf)
-	me.sslConn.WithHttpTransport(&LogRoundTripper{})
+	cpf := me.newServiceClientProfile("wss", 300)
+	// the generated NewClient of ssl only accepts the static credential
+	me.sslConn = &ssl.Client{}
+	me.sslConn.Init(me.Region).WithCredential(me.Credential).WithProfile(cpf)
+	me.sslConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.sslConn
 }
@@ -449,9 +508,9 @@ func (me *TencentCloudClient) UseCamClient() *cam.Client {
 		return me.camConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("cam", 300)
 	me.camConn, _ = cam.NewClient(me.Credential, me.Region, cpf)
-	me.camConn.WithHttpTransport(&LogRoundTripper{})
+	me.camConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.camConn
 }
@@ -465,9 +524,9 @@ func (me *TencentCloudClient) UseStsClient() *sts.Client {
 		}
 	*/
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("sts", 300)
 	me.stsConn, _ = sts.NewClient(me.Credential, me.Region, cpf)
-	me.stsConn.WithHttpTransport(&LogRoundTripper{})
+	me.stsConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.stsConn
 }
@@ -478,9 +537,9 @@ func (me *TencentCloudClient) UseCfsClient() *cfs.Client {
 		return me.cfsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("cfs", 300)
 	me.cfsConn, _ = cfs.NewClient(me.Credential, me.Region, cpf)
-	me.cfsConn.WithHttpTransport(&LogRoundTripper{})
+	me.cfsConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.cfsConn
 }
@@ -491,9 +550,9 @@ func (me *TencentCloudClient) UseScfClient() *scf.Client {
 		return me.scfConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("scf", 300)
 	me.scfConn, _ = scf.NewClient(me.Credential, me.Region, cpf)
-	me.scfConn.WithHttpTransport(&LogRoundTripper{})
+	me.scfConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.scfConn
 }
@@ -504,9 +563,11 @@ func (me *TencentCloudClient) UseTcaplusClient() *tcaplusdb.Client {
 		return me.tcaplusConn
 	}
 
-	cpf := me.NewClientProfile(300)
-	me.tcaplusConn, _ = tcaplusdb.NewClient(me.Credential, me.Region, cpf)
-	me.tcaplusConn.WithHttpTransport(&LogRoundTripper{})
+	cpf := me.newServiceClientProfile("tcaplusdb", 300)
+	// the generated NewClient of tcaplusdb only accepts the static credential
+	me.tcaplusConn = &tcaplusdb.Client{}
+	me.tcaplusConn.Init(me.Region).WithCredential(me.Credential).WithProfile(cpf)
+	me.tcaplusConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.tcaplusConn
 }
@@ -517,9 +578,9 @@ func (me *TencentCloudClient) UseDayuClient() *dayu.Client {
 		return me.dayuConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("dayu", 300)
 	me.dayuConn, _ = dayu.NewClient(me.Credential, me.Region, cpf)
-	me.dayuConn.WithHttpTransport(&LogRoundTripper{})
+	me.dayuConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.dayuConn
 }
@@ -530,9 +591,9 @@ func (me *TencentCloudClient) UseCdnClient() *cdn.Client {
 		return me.cdnConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("cdn", 300)
 	me.cdnConn, _ = cdn.NewClient(me.Credential, me.Region, cpf)
-	me.cdnConn.WithHttpTransport(&LogRoundTripper{})
+	me.cdnConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.cdnConn
 }
@@ -543,9 +604,9 @@ func (me *TencentCloudClient) UseMonitorClient() *monitor.Client {
 		return me.monitorConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("monitor", 300)
 	me.monitorConn, _ = monitor.NewClient(me.Credential, me.Region, cpf)
-	me.monitorConn.WithHttpTransport(&LogRoundTripper{})
+	me.monitorConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.monitorConn
 }
@@ -556,10 +617,10 @@ func (me *TencentCloudClient) UseEsClient() *es.Client {
 		return me.esConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("es", 300)
 	cpf.Language = "zh-CN"
 	me.esConn, _ = es.NewClient(me.Credential, me.Region, cpf)
-	me.esConn.WithHttpTransport(&LogRoundTripper{})
+	me.esConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.esConn
 }
@@ -570,9 +631,9 @@ func (me *TencentCloudClient) UsePostgresqlClient() *postgre.Client {
 		return me.postgreConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("postgres", 300)
 	me.postgreConn, _ = postgre.NewClient(me.Credential, me.Region, cpf)
-	me.postgreConn.WithHttpTransport(&LogRoundTripper{})
+	me.postgreConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.postgreConn
 }
@@ -583,9 +644,9 @@ func (me *TencentCloudClient) UseSqlserverClient() *sqlserver.Client {
 		return me.sqlserverConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("sqlserver", 300)
 	me.sqlserverConn, _ = sqlserver.NewClient(me.Credential, me.Region, cpf)
-	me.sqlserverConn.WithHttpTransport(&LogRoundTripper{})
+	me.sqlserverConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.sqlserverConn
 }
@@ -596,9 +657,9 @@ func (me *TencentCloudClient) UseCkafkaClient() *ckafka.Client {
 		return me.ckafkaConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("ckafka", 300)
 	me.ckafkaConn, _ = ckafka.NewClient(me.Credential, me.Region, cpf)
-	me.ckafkaConn.WithHttpTransport(&LogRoundTripper{})
+	me.ckafkaConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.ckafkaConn
 }
@@ -609,9 +670,9 @@ func (me *TencentCloudClient) UseAuditClient() *audit.Client {
 		return me.auditConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("cloudaudit", 300)
 	me.auditConn, _ = audit.NewClient(me.Credential, me.Region, cpf)
-	me.auditConn.WithHttpTransport(&LogRoundTripper{})
+	me.auditConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.auditConn
 }
@@ -622,9 +683,9 @@ func (me *TencentCloudClient) UseCynosdbClient() *cynosdb.Client {
 		return me.cynosConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("cynosdb", 300)
 	me.cynosConn, _ = cynosdb.NewClient(me.Credential, me.Region, cpf)
-	me.cynosConn.WithHttpTransport(&LogRoundTripper{})
+	me.cynosConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.cynosConn
 }
@@ -635,9 +696,11 @@ func (me *TencentCloudClient) UseVodClient() *vod.Client {
 		return me.vodConn
 	}
 
-	cpf := me.NewClientProfile(300)
-	me.vodConn, _ = vod.NewClient(me.Credential, me.Region, cpf)
-	me.vodConn.WithHttpTransport(&LogRoundTripper{})
+	cpf := me.newServiceClientProfile("vod", 300)
+	// the generated NewClient of vod only accepts the static credential
+	me.vodConn = &vod.Client{}
+	me.vodConn.Init(me.Region).WithCredential(me.Credential).WithProfile(cpf)
+	me.vodConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.vodConn
 }
@@ -648,9 +711,9 @@ func (me *TencentCloudClient) UseAPIGatewayClient() *apigateway.Client {
 		return me.apiGatewayConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("apigateway", 300)
 	me.apiGatewayConn, _ = apigateway.NewClient(me.Credential, me.Region, cpf)
-	me.apiGatewayConn.WithHttpTransport(&LogRoundTripper{})
+	me.apiGatewayConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.apiGatewayConn
 }
@@ -661,9 +724,9 @@ func (me *TencentCloudClient) UseTCRClient() *tcr.Client {
 		return me.tcrConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("tcr", 300)
 	me.tcrConn, _ = tcr.NewClient(me.Credential, me.Region, cpf)
-	me.tcrConn.WithHttpTransport(&LogRoundTripper{})
+	me.tcrConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.tcrConn
 }
@@ -674,9 +737,11 @@ func (me *TencentCloudClient) UseSSLCertificateClient() *sslCertificate.Client {
 		return me.sslCertificateConn
 	}
 
-	cpf := me.NewClientProfile(300)
-	me.sslCertificateConn, _ = sslCertificate.NewClient(me.Credential, me.Region, cpf)
-	me.sslCertificateConn.WithHttpTransport(&LogRoundTripper{})
+	cpf := me.newServiceClientProfile("ssl", 300)
+	// the generated NewClient of sslCertificate only accepts the static credential
+	me.sslCertificateConn = &sslCertificate.Client{}
+	me.sslCertificateConn.Init(me.Region).WithCredential(me.Credential).WithProfile(cpf)
+	me.sslCertificateConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.sslCertificateConn
 }
@@ -687,9 +752,9 @@ func (me *TencentCloudClient) UseKmsClient() *kms.Client {
 		return me.kmsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("kms", 300)
 	me.kmsConn, _ = kms.NewClient(me.Credential, me.Region, cpf)
-	me.kmsConn.WithHttpTransport(&LogRoundTripper{})
+	me.kmsConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.kmsConn
 }
@@ -700,9 +765,9 @@ func (me *TencentCloudClient) UseSsmClient() *ssm.Client {
 		return me.ssmConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("ssm", 300)
 	me.ssmConn, _ = ssm.NewClient(me.Credential, me.Region, cpf)
-	me.ssmConn.WithHttpTransport(&LogRoundTripper{})
+	me.ssmConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.ssmConn
 }
@@ -712,9 +777,9 @@ func (me *TencentCloudClient) UseApiClient() *api.Client {
 	if me.apiConn != nil {
 		return me.apiConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("api", 300)
 	me.apiConn, _ = api.NewClient(me.Credential, me.Region, cpf)
-	me.apiConn.WithHttpTransport(&LogRoundTripper{})
+	me.apiConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.apiConn
 }
@@ -724,9 +789,9 @@ func (me *TencentCloudClient) UseEmrClient() *emr.Client {
 	if me.emrConn != nil {
 		return me.emrConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("emr", 300)
 	me.emrConn, _ = emr.NewClient(me.Credential, me.Region, cpf)
-	me.emrConn.WithHttpTransport(&LogRoundTripper{})
+	me.emrConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.emrConn
 }
@@ -736,9 +801,9 @@ func (me *TencentCloudClient) UseClsClient() *cls.Client {
 	if me.clsConn != nil {
 		return me.clsConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("cls", 300)
 	me.clsConn, _ = cls.NewClient(me.Credential, me.Region, cpf)
-	me.clsConn.WithHttpTransport(&LogRoundTripper{})
+	me.clsConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.clsConn
 }
@@ -748,9 +813,9 @@ func (me *TencentCloudClient) UseLighthouseClient() *lighthouse.Client {
 	if me.lighthouseConn != nil {
 		return me.lighthouseConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("lighthouse", 300)
 	me.lighthouseConn, _ = lighthouse.NewClient(me.Credential, me.Region, cpf)
-	me.lighthouseConn.WithHttpTransport(&LogRoundTripper{})
+	me.lighthouseConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.lighthouseConn
 }
@@ -760,9 +825,9 @@ func (me *TencentCloudClient) UseDnsPodClient() *dnspod.Client {
 	if me.dnsPodConn != nil {
 		return me.dnsPodConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("dnspod", 300)
 	me.dnsPodConn, _ = dnspod.NewClient(me.Credential, me.Region, cpf)
-	me.dnsPodConn.WithHttpTransport(&LogRoundTripper{})
+	me.dnsPodConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.dnsPodConn
 }
@@ -772,9 +837,9 @@ func (me *TencentCloudClient) UsePrivateDnsClient() *privatedns.Client {
 	if me.privateDnsConn != nil {
 		return me.privateDnsConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("privatedns", 300)
 	me.privateDnsConn, _ = privatedns.NewClient(me.Credential, me.Region, cpf)
-	me.privateDnsConn.WithHttpTransport(&LogRoundTripper{})
+	me.privateDnsConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.privateDnsConn
 }
@@ -784,9 +849,9 @@ func (me *TencentCloudClient) UseDomainClient() *domain.Client {
 	if me.domainConn != nil {
 		return me.domainConn
 	}
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("domain", 300)
 	me.domainConn, _ = domain.NewClient(me.Credential, me.Region, cpf)
-	me.domainConn.WithHttpTransport(&LogRoundTripper{})
+	me.domainConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.domainConn
 }
@@ -797,9 +862,9 @@ func (me *TencentCloudClient) UseAntiddosClient() *antiddos.Client {
 		return me.antiddosConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("antiddos", 300)
 	me.antiddosConn, _ = antiddos.NewClient(me.Credential, me.Region, cpf)
-	me.antiddosConn.WithHttpTransport(&LogRoundTripper{})
+	me.antiddosConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.antiddosConn
 }
@@ -810,9 +875,9 @@ func (me *TencentCloudClient) UseTemClient() *tem.Client {
 		return me.temConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("tem", 300)
 	me.temConn, _ = tem.NewClient(me.Credential, me.Region, cpf)
-	me.temConn.WithHttpTransport(&LogRoundTripper{})
+	me.temConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.temConn
 }
@@ -823,9 +888,9 @@ func (me *TencentCloudClient) UseTeoClient() *teo.Client {
 		return me.teoConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("teo", 300)
 	me.teoConn, _ = teo.NewClient(me.Credential, me.Region, cpf)
-	me.teoConn.WithHttpTransport(&LogRoundTripper{})
+	me.teoConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.teoConn
 }
@@ -836,9 +901,9 @@ func (me *TencentCloudClient) UseTcmClient() *tcm.Client {
 		return me.tcmConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("tcm", 300)
 	me.tcmConn, _ = tcm.NewClient(me.Credential, me.Region, cpf)
-	me.tcmConn.WithHttpTransport(&LogRoundTripper{})
+	me.tcmConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.tcmConn
 }
@@ -849,9 +914,9 @@ func (me *TencentCloudClient) UseCssClient() *css.Client {
 		return me.cssConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("live", 300)
 	me.cssConn, _ = css.NewClient(me.Credential, me.Region, cpf)
-	me.cssConn.WithHttpTransport(&LogRoundTripper{})
+	me.cssConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.cssConn
 }
@@ -862,9 +927,9 @@ func (me *TencentCloudClient) UseSesClient() *ses.Client {
 		return me.sesConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("ses", 300)
 	me.sesConn, _ = ses.NewClient(me.Credential, me.Region, cpf)
-	me.sesConn.WithHttpTransport(&LogRoundTripper{})
+	me.sesConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.sesConn
 }
@@ -875,9 +940,9 @@ func (me *TencentCloudClient) UseDcdbClient() *dcdb.Client {
 		return me.dcdbConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("dcdb", 300)
 	me.dcdbConn, _ = dcdb.NewClient(me.Credential, me.Region, cpf)
-	me.dcdbConn.WithHttpTransport(&LogRoundTripper{})
+	me.dcdbConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.dcdbConn
 }
@@ -888,9 +953,9 @@ func (me *TencentCloudClient) UseSmsClient() *sms.Client {
 		return me.smsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("sms", 300)
 	me.smsConn, _ = sms.NewClient(me.Credential, me.Region, cpf)
-	me.smsConn.WithHttpTransport(&LogRoundTripper{})
+	me.smsConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.smsConn
 }
@@ -901,9 +966,9 @@ func (me *TencentCloudClient) UseCatClient() *cat.Client {
 		return me.catConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("cat", 300)
 	me.catConn, _ = cat.NewClient(me.Credential, me.Region, cpf)
-	me.catConn.WithHttpTransport(&LogRoundTripper{})
+	me.catConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.catConn
 }
@@ -914,9 +979,9 @@ func (me *TencentCloudClient) UseMariadbClient() *mariadb.Client {
 		return me.mariadbConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("mariadb", 300)
 	me.mariadbConn, _ = mariadb.NewClient(me.Credential, me.Region, cpf)
-	me.mariadbConn.WithHttpTransport(&LogRoundTripper{})
+	me.mariadbConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.mariadbConn
 }
@@ -927,9 +992,9 @@ func (me *TencentCloudClient) UsePtsClient() *pts.Client {
 		return me.ptsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("pts", 300)
 	me.ptsConn, _ = pts.NewClient(me.Credential, me.Region, cpf)
-	me.ptsConn.WithHttpTransport(&LogRoundTripper{})
+	me.ptsConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.ptsConn
 }
@@ -940,9 +1005,9 @@ func (me *TencentCloudClient) UseTatClient() *tat.Client {
 		return me.tatConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("tat", 300)
 	me.tatConn, _ = tat.NewClient(me.Credential, me.Region, cpf)
-	me.tatConn.WithHttpTransport(&LogRoundTripper{})
+	me.tatConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.tatConn
 }
@@ -953,9 +1018,9 @@ func (me *TencentCloudClient) UseOrganizationClient() *organization.Client {
 		return me.organizationConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("organization", 300)
 	me.organizationConn, _ = organization.NewClient(me.Credential, me.Region, cpf)
-	me.organizationConn.WithHttpTransport(&LogRoundTripper{})
+	me.organizationConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.organizationConn
 }
@@ -966,9 +1031,9 @@ func (me *TencentCloudClient) UseTdcpgClient() *tdcpg.Client {
 		return me.tdcpgConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("tdcpg", 300)
 	me.tdcpgConn, _ = tdcpg.NewClient(me.Credential, me.Region, cpf)
-	me.tdcpgConn.WithHttpTransport(&LogRoundTripper{})
+	me.tdcpgConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.tdcpgConn
 }
@@ -979,10 +1044,10 @@ func (me *TencentCloudClient) UseDbbrainClient() *dbbrain.Client {
 		return me.dbbrainConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("dbbrain", 300)
 	cpf.Language = "zh-CN"
 	me.dbbrainConn, _ = dbbrain.NewClient(me.Credential, me.Region, cpf)
-	me.dbbrainConn.WithHttpTransport(&LogRoundTripper{})
+	me.dbbrainConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.dbbrainConn
 }
@@ -993,9 +1058,9 @@ func (me *TencentCloudClient) UseRumClient() *rum.Client {
 		return me.rumConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("rum", 300)
 	me.rumConn, _ = rum.NewClient(me.Credential, me.Region, cpf)
-	me.rumConn.WithHttpTransport(&LogRoundTripper{})
+	me.rumConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.rumConn
 }
@@ -1006,18 +1071,18 @@ func (me *TencentCloudClient) UseDtsClient() *dts.Client {
 		return me.dtsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("dts", 300)
 	me.dtsConn, _ = dts.NewClient(me.Credential, me.Region, cpf)
-	me.dtsConn.WithHttpTransport(&LogRoundTripper{})
+	me.dtsConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.dtsConn
 }
 
 // UseCosBatchClient returns ci client for service
 func (me *TencentCloudClient) UseCosBatchClient(uin string) *cos.Client {
-	u, _ := url.Parse(fmt.Sprintf("https://%s.cos-control.%s.myqcloud.com", uin, me.Region))
+	u := me.cosURL("cos-control", uin)
 
-	if me.cosBatchConn != nil && me.cosBatchConn.BaseURL.BatchURL == u {
+	if me.cosBatchConn != nil && me.cosBatchConn.BaseURL.BatchURL.String() == u.String() {
 		return me.cosBatchConn
 	}
 
@@ -1026,12 +1091,8 @@ func (me *TencentCloudClient) UseCosBatchClient(uin string) *cos.Client {
 	}
 
 	me.cosBatchConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: 100 * time.Second,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
+		Timeout:   100 * time.Second,
+		Transport: me.NewCosAuthorizationTransport(),
 	})
 
 	return me.cosBatchConn
@@ -1039,9 +1100,9 @@ func (me *TencentCloudClient) UseCosBatchClient(uin string) *cos.Client {
 
 // UseCiClient returns ci client for service
 func (me *TencentCloudClient) UseCiClient(bucket string) *cos.Client {
-	u, _ := url.Parse(fmt.Sprintf("https://%s.ci.%s.myqcloud.com", bucket, me.Region))
+	u := me.cosURL("ci", bucket)
 
-	if me.ciConn != nil && me.ciConn.BaseURL.CIURL == u {
+	if me.ciConn != nil && me.ciConn.BaseURL.CIURL.String() == u.String() {
 		return me.ciConn
 	}
 
@@ -1050,12 +1111,8 @@ func (me *TencentCloudClient) UseCiClient(bucket string) *cos.Client {
 	}
 
 	me.ciConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: 100 * time.Second,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
+		Timeout:   100 * time.Second,
+		Transport: me.NewCosAuthorizationTransport(),
 	})
 
 	return me.ciConn
@@ -1063,9 +1120,9 @@ func (me *TencentCloudClient) UseCiClient(bucket string) *cos.Client {
 
 // UsePicClient returns pic client for service
 func (me *TencentCloudClient) UsePicClient(bucket string) *cos.Client {
-	u, _ := url.Parse(fmt.Sprintf("https://%s.pic.%s.myqcloud.com", bucket, me.Region))
+	u := me.cosURL("pic", bucket)
 
-	if me.ciConn != nil && me.ciConn.BaseURL.CIURL == u {
+	if me.ciConn != nil && me.ciConn.BaseURL.CIURL.String() == u.String() {
 		return me.ciConn
 	}
 
@@ -1074,12 +1131,8 @@ func (me *TencentCloudClient) UsePicClient(bucket string) *cos.Client {
 	}
 
 	me.ciConn = cos.NewClient(baseUrl, &http.Client{
-		Timeout: 100 * time.Second,
-		Transport: &cos.AuthorizationTransport{
-			SecretID:     me.Credential.SecretId,
-			SecretKey:    me.Credential.SecretKey,
-			SessionToken: me.Credential.Token,
-		},
+		Timeout:   100 * time.Second,
+		Transport: me.NewCosAuthorizationTransport(),
 	})
 
 	return me.ciConn
@@ -1091,10 +1144,10 @@ func (me *TencentCloudClient) UseTsfClient() *tsf.Client {
 		return me.tsfConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("tsf", 300)
 	cpf.Language = "zh-CN"
 	me.tsfConn, _ = tsf.NewClient(me.Credential, me.Region, cpf)
-	me.tsfConn.WithHttpTransport(&LogRoundTripper{})
+	me.tsfConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.tsfConn
 }
@@ -1105,10 +1158,10 @@ func (me *TencentCloudClient) UseMpsClient() *mps.Client {
 		return me.mpsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("mps", 300)
 	cpf.Language = "zh-CN"
 	me.mpsConn, _ = mps.NewClient(me.Credential, me.Region, cpf)
-	me.mpsConn.WithHttpTransport(&LogRoundTripper{})
+	me.mpsConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.mpsConn
 }
@@ -1119,9 +1172,9 @@ func (me *TencentCloudClient) UseCwpClient() *cwp.Client {
 		return me.cwpConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("cwp", 300)
 	me.cwpConn, _ = cwp.NewClient(me.Credential, me.Region, cpf)
-	me.cwpConn.WithHttpTransport(&LogRoundTripper{})
+	me.cwpConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.cwpConn
 }
@@ -1132,10 +1185,10 @@ func (me *TencentCloudClient) UseChdfsClient() *chdfs.Client {
 		return me.chdfsConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("chdfs", 300)
 	cpf.Language = "zh-CN"
 	me.chdfsConn, _ = chdfs.NewClient(me.Credential, me.Region, cpf)
-	me.chdfsConn.WithHttpTransport(&LogRoundTripper{})
+	me.chdfsConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.chdfsConn
 }
@@ -1146,10 +1199,10 @@ func (me *TencentCloudClient) UseMdlClient() *mdl.Client {
 		return me.mdlConn
 	}
 
-	cpf := me.NewClientIntlProfile(300)
+	cpf := me.newServiceClientIntlProfile("mdl", 300)
 	cpf.Language = "zh-CN"
 	me.mdlConn, _ = mdl.NewClient(me.Credential, me.Region, cpf)
-	me.mdlConn.WithHttpTransport(&LogRoundTripper{})
+	me.mdlConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.mdlConn
 }
@@ -1160,10 +1213,10 @@ func (me *TencentCloudClient) UseApmClient() *apm.Client {
 		return me.apmConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("apm", 300)
 	cpf.Language = "zh-CN"
 	me.apmConn, _ = apm.NewClient(me.Credential, me.Region, cpf)
-	me.apmConn.WithHttpTransport(&LogRoundTripper{})
+	me.apmConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.apmConn
 }
@@ -1174,10 +1227,10 @@ func (me *TencentCloudClient) UseCiamClient() *ciam.Client {
 		return me.ciamConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("ciam", 300)
 	cpf.Language = "zh-CN"
 	me.ciamConn, _ = ciam.NewClient(me.Credential, me.Region, cpf)
-	me.ciamConn.WithHttpTransport(&LogRoundTripper{})
+	me.ciamConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.ciamConn
 }
@@ -1188,10 +1241,10 @@ func (me *TencentCloudClient) UseTseClient() *tse.Client {
 		return me.tseConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("tse", 300)
 	cpf.Language = "zh-CN"
 	me.tseConn, _ = tse.NewClient(me.Credential, me.Region, cpf)
-	me.tseConn.WithHttpTransport(&LogRoundTripper{})
+	me.tseConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.tseConn
 }
@@ -1202,10 +1255,10 @@ func (me *TencentCloudClient) UseCdwchClient() *cdwch.Client {
 		return me.cdwchConn
 	}
 
-	cpf := me.NewClientProfile(300)
+	cpf := me.newServiceClientProfile("cdwch", 300)
 	cpf.Language = "zh-CN"
 	me.cdwchConn, _ = cdwch.NewClient(me.Credential, me.Region, cpf)
-	me.cdwchConn.WithHttpTransport(&LogRoundTripper{})
+	me.cdwchConn.WithHttpTransport(me.newLogRoundTripper())
 
 	return me.cdwchConn
 }
@@ -0,0 +1,238 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+	"github.com/tencentyun/cos-go-sdk-v5"
+)
+
+const (
+	// DefaultMetadataEndpoint is the metadata service of CVM
+	DefaultMetadataEndpoint = "http://metadata.tencentyun.com/latest/meta-data"
+
+	// credentialRefreshWindow is how long before the expiration a temporary credential is refreshed
+	credentialRefreshWindow = 5 * time.Minute
+)
+
+// TemporaryCredential is a credential which is valid until ExpiredTime
+type TemporaryCredential struct {
+	SecretId    string
+	SecretKey   string
+	Token       string
+	ExpiredTime time.Time
+}
+
+// RefreshableCredential is a common.CredentialIface that fetches a new temporary credential before the current
+// one expires, so the long-running applies will not fail with an expired token.
+type RefreshableCredential struct {
+	source string
+	fetch  func() (*TemporaryCredential, error)
+	now    func() time.Time
+
+	mu        sync.Mutex
+	value     TemporaryCredential
+	fetchedAt time.Time
+}
+
+var _ common.CredentialIface = &RefreshableCredential{}
+
+// NewRefreshableCredential returns a credential which refreshes itself with fetch, source names where the credential
+// comes from and is used in the error messages. The first credential is fetched immediately.
+func NewRefreshableCredential(source string, fetch func() (*TemporaryCredential, error)) (*RefreshableCredential, error) {
+	me := &RefreshableCredential{
+		source: source,
+		fetch:  fetch,
+		now:    time.Now,
+	}
+	if err := me.refresh(); err != nil {
+		return nil, err
+	}
+	return me, nil
+}
+
+// GetSecretId, GetSecretKey and GetToken may return the parts of different credentials if it is refreshed between
+// the calls, use Snapshot to get them together.
+func (me *RefreshableCredential) GetSecretId() string {
+	return me.Snapshot().SecretId
+}
+
+func (me *RefreshableCredential) GetSecretKey() string {
+	return me.Snapshot().SecretKey
+}
+
+func (me *RefreshableCredential) GetToken() string {
+	return me.Snapshot().Token
+}
+
+// Source returns where the credential comes from
+func (me *RefreshableCredential) Source() string {
+	return me.source
+}
+
+// Snapshot returns the current credential, which is refreshed first if it expires soon
+func (me *RefreshableCredential) Snapshot() TemporaryCredential {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+
+	if me.needRefresh() {
+		if err := me.refresh(); err != nil {
+			// keep using the old one, it may still be valid for a while
+			log.Printf("[CRITAL] refresh credential from %s failed: %v", me.source, err)
+		}
+	}
+	return me.value
+}
+
+func (me *RefreshableCredential) needRefresh() bool {
+	if me.value.SecretId == "" || me.value.SecretKey == "" {
+		return true
+	}
+	if me.value.ExpiredTime.IsZero() {
+		return false
+	}
+	// a short-lived credential is refreshed when half of its lifetime passed
+	window := credentialRefreshWindow
+	if lifetime := me.value.ExpiredTime.Sub(me.fetchedAt); lifetime < 2*window {
+		window = lifetime / 2
+	}
+	return me.value.ExpiredTime.Add(-window).Before(me.now())
+}
+
+func (me *RefreshableCredential) refresh() error {
+	value, err := me.fetch()
+	if err != nil {
+		return fmt.Errorf("get credential from %s failed: %v", me.source, err)
+	}
+	if value.SecretId == "" || value.SecretKey == "" {
+		return fmt.Errorf("get credential from %s failed: empty secret id or secret key", me.source)
+	}
+	log.Printf("[DEBUG] credential from %s refreshed, expired at %s", me.source, value.ExpiredTime.Format(time.RFC3339))
+	me.value = *value
+	me.fetchedAt = me.now()
+	return nil
+}
+
+// NewCvmRoleCredential returns a credential of the CAM role bound to the CVM, it is fetched from the metadata service
+// at endpoint, use DefaultMetadataEndpoint if endpoint is empty.
+func NewCvmRoleCredential(endpoint, roleName string) (*RefreshableCredential, error) {
+	if endpoint == "" {
+		endpoint = DefaultMetadataEndpoint
+	}
+	url := fmt.Sprintf("%s/cam/security-credentials/%s", strings.TrimSuffix(endpoint, "/"), roleName)
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	fetch := func() (*TemporaryCredential, error) {
+		response, err := client.Get(url)
+		if err != nil {
+			return nil, err
+		}
+		defer response.Body.Close()
+
+		body, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+		if response.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("role %s is not bound to this instance", roleName)
+		}
+		if response.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status %d: %s", response.StatusCode, body)
+		}
+
+		var result struct {
+			TmpSecretId  string `json:"TmpSecretId"`
+			TmpSecretKey string `json:"TmpSecretKey"`
+			Token        string `json:"Token"`
+			ExpiredTime  int64  `json:"ExpiredTime"`
+			Code         string `json:"Code"`
+		}
+		if err := json.Unmarshal(body, &result); err != nil {
+			return nil, fmt.Errorf("unmarshal response failed: %v", err)
+		}
+		if result.Code != "Success" {
+			return nil, fmt.Errorf("unexpected code %s", result.Code)
+		}
+		return &TemporaryCredential{
+			SecretId:    result.TmpSecretId,
+			SecretKey:   result.TmpSecretKey,
+			Token:       result.Token,
+			ExpiredTime: time.Unix(result.ExpiredTime, 0),
+		}, nil
+	}
+
+	return NewRefreshableCredential(fmt.Sprintf("CVM metadata role `%s`", roleName), fetch)
+}
+
+// credentialSnapshot returns the secret id, secret key and token of credential together, the ones of a
+// RefreshableCredential are from the same credential
+func credentialSnapshot(credential common.CredentialIface) TemporaryCredential {
+	if refreshable, ok := credential.(*RefreshableCredential); ok {
+		return refreshable.Snapshot()
+	}
+	return TemporaryCredential{
+		SecretId:  credential.GetSecretId(),
+		SecretKey: credential.GetSecretKey(),
+		Token:     credential.GetToken(),
+	}
+}
+
+// NewCosAuthorizationTransport returns the cos authorization transport which always signs with the latest credential
+func (me *TencentCloudClient) NewCosAuthorizationTransport() http.RoundTripper {
+	return &cosAuthorizationTransport{
+		credential: me.Credential,
+		transport:  &cos.AuthorizationTransport{Transport: me.httpTransport()},
+		policy:     me.RetryPolicy,
+		limits:     me.RateLimits,
+	}
+}
+
+type cosAuthorizationTransport struct {
+	credential common.CredentialIface
+	transport  *cos.AuthorizationTransport
+	policy     *RetryPolicy
+	limits     *ratelimit.Limits
+}
+
+func (me *cosAuthorizationTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if err := me.limits.Wait(request.Context(), "cos", request.Method); err != nil {
+		return nil, err
+	}
+	value := credentialSnapshot(me.credential)
+	me.transport.SetCredential(value.SecretId, value.SecretKey, value.Token)
+	response, err := me.transport.RoundTrip(request)
+	if err == nil {
+		recordCosErrorRequest(response, me.policy)
+	}
+	return response, err
+}
+
+// awsCredentialProvider adapts common.CredentialIface to the aws credential provider used by the s3 client
+type awsCredentialProvider struct {
+	credential common.CredentialIface
+}
+
+func (me *awsCredentialProvider) Retrieve() (credentials.Value, error) {
+	value := credentialSnapshot(me.credential)
+	return credentials.Value{
+		AccessKeyID:     value.SecretId,
+		SecretAccessKey: value.SecretKey,
+		SessionToken:    value.Token,
+		ProviderName:    "TencentCloudProvider",
+	}, nil
+}
+
+// IsExpired always returns true, so every request gets the credential from the underlying one
+// which caches and refreshes itself.
+func (me *awsCredentialProvider) IsExpired() bool {
+	return true
+}
@@ -0,0 +1,158 @@
+package connectivity
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCvmRoleCredentialRefresh(t *testing.T) {
+	var calls int64
+	base := time.Now()
+	metadata := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cam/security-credentials/terraform-runner" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		n := atomic.AddInt64(&calls, 1)
+		_, _ = fmt.Fprintf(w, `{"TmpSecretId":"id-%d","TmpSecretKey":"key-%d","Token":"token-%d","ExpiredTime":%d,"Code":"Success"}`,
+			n, n, n, base.Add(time.Duration(n)*time.Hour).Unix())
+	}))
+	defer metadata.Close()
+
+	credential, err := NewCvmRoleCredential(metadata.URL+"/", "terraform-runner")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if credential.GetSecretId() != "id-1" || credential.GetSecretKey() != "key-1" || credential.GetToken() != "token-1" {
+		t.Fatalf("unexpected credential: %+v", credential.value)
+	}
+
+	// still valid, no refresh
+	credential.now = func() time.Time { return base.Add(30 * time.Minute) }
+	if credential.GetSecretId() != "id-1" {
+		t.Fatalf("unexpected refresh: %+v", credential.value)
+	}
+
+	// inside the refresh window
+	credential.now = func() time.Time { return base.Add(58 * time.Minute) }
+	if credential.GetSecretId() != "id-2" || credential.GetToken() != "token-2" {
+		t.Fatalf("credential not refreshed: %+v", credential.value)
+	}
+	if atomic.LoadInt64(&calls) != 2 {
+		t.Fatalf("expect 2 calls to metadata, got %d", calls)
+	}
+}
+
+func TestRefreshableCredentialShortLifetime(t *testing.T) {
+	var calls int
+	base := time.Now()
+	credential, err := NewRefreshableCredential("test", func() (*TemporaryCredential, error) {
+		calls++
+		return &TemporaryCredential{
+			SecretId:    fmt.Sprintf("id-%d", calls),
+			SecretKey:   "key",
+			ExpiredTime: base.Add(time.Duration(calls) * 4 * time.Minute),
+		}, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	credential.now = func() time.Time { return base.Add(time.Minute) }
+	if credential.GetSecretId() != "id-1" {
+		t.Fatalf("unexpected refresh: %+v", credential.value)
+	}
+	credential.now = func() time.Time { return base.Add(3 * time.Minute) }
+	if credential.GetSecretId() != "id-2" {
+		t.Fatalf("credential not refreshed: %+v", credential.value)
+	}
+}
+
+func TestRefreshableCredentialSnapshot(t *testing.T) {
+	var calls int64
+	credential, err := NewRefreshableCredential("test", func() (*TemporaryCredential, error) {
+		n := atomic.AddInt64(&calls, 1)
+		return &TemporaryCredential{
+			SecretId:    fmt.Sprintf("id-%d", n),
+			SecretKey:   fmt.Sprintf("key-%d", n),
+			Token:       fmt.Sprintf("token-%d", n),
+			ExpiredTime: time.Now().Add(time.Hour),
+		}, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// every read is inside the refresh window, so the credential is refreshed between the reads
+	credential.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	provider := &awsCredentialProvider{credential: credential}
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 20)
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				value := credentialSnapshot(credential)
+				n := strings.TrimPrefix(value.SecretId, "id-")
+				if value.SecretKey != "key-"+n || value.Token != "token-"+n {
+					errs <- fmt.Sprintf("torn snapshot: %+v", value)
+					return
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				value, _ := provider.Retrieve()
+				n := strings.TrimPrefix(value.AccessKeyID, "id-")
+				if value.SecretAccessKey != "key-"+n || value.SessionToken != "token-"+n {
+					errs <- fmt.Sprintf("torn aws credential: %+v", value)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+}
+
+func TestCvmRoleCredentialKeepOldOnFailure(t *testing.T) {
+	var fail int64
+	metadata := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt64(&fail) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"TmpSecretId":"id","TmpSecretKey":"key","Token":"token","ExpiredTime":%d,"Code":"Success"}`, time.Now().Add(time.Minute).Unix())
+	}))
+	defer metadata.Close()
+
+	credential, err := NewCvmRoleCredential(metadata.URL, "terraform-runner")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	atomic.StoreInt64(&fail, 1)
+	if credential.GetSecretId() != "id" {
+		t.Fatalf("expect the old credential is kept, got %+v", credential.value)
+	}
+}
+
+func TestCvmRoleCredentialNotBound(t *testing.T) {
+	metadata := httptest.NewServer(http.NotFoundHandler())
+	defer metadata.Close()
+
+	_, err := NewCvmRoleCredential(metadata.URL, "terraform-runner")
+	if err == nil || !strings.Contains(err.Error(), "role terraform-runner is not bound") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+package connectivity
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	intlProfile "github.com/tencentcloud/tencentcloud-sdk-go-intl-en/tencentcloud/common/profile"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
+)
+
+// EndpointProducts are the products whose endpoints can be overridden by Endpoints of TencentCloudClient,
+// the product of an API is the first label of its endpoint, like `cvm` of `cvm.tencentcloudapi.com`.
+var EndpointProducts = []string{
+	"antiddos",
+	"api",
+	"apigateway",
+	"apm",
+	"as",
+	"cam",
+	"cat",
+	"cbs",
+	"cdb",
+	"cdn",
+	"cdwch",
+	"cfs",
+	"chdfs",
+	"ciam",
+	"ckafka",
+	"clb",
+	"cloudaudit",
+	"cls",
+	"cvm",
+	"cwp",
+	"cynosdb",
+	"dayu",
+	"dbbrain",
+	"dc",
+	"dcdb",
+	"dnspod",
+	"domain",
+	"dts",
+	"emr",
+	"es",
+	"gaap",
+	"kms",
+	"lighthouse",
+	"live",
+	"mariadb",
+	"mdl",
+	"mongodb",
+	"monitor",
+	"mps",
+	"organization",
+	"postgres",
+	"privatedns",
+	"pts",
+	"redis",
+	"rum",
+	"scf",
+	"ses",
+	"sms",
+	"sqlserver",
+	"ssl",
+	"ssm",
+	"sts",
+	"tag",
+	"tat",
+	"tcaplusdb",
+	"tcm",
+	"tcr",
+	"tdcpg",
+	"tdmq",
+	"tem",
+	"teo",
+	"tke",
+	"tse",
+	"tsf",
+	"vod",
+	"vpc",
+	"wss",
+
+	// the cos-like products, whose endpoints are prefixed with the bucket or uin
+	"cos",
+	"ci",
+	"pic",
+	"cos-control",
+}
+
+// endpoint returns the overridden endpoint of product, the scheme is empty if the endpoint is only a host,
+// and the host is empty if the endpoint is not overridden.
+func (me *TencentCloudClient) endpoint(product string) (scheme, host string) {
+	endpoint := me.Endpoints[product]
+	if endpoint == "" {
+		return
+	}
+	if strings.Contains(endpoint, "://") {
+		if u, err := url.Parse(endpoint); err == nil {
+			return u.Scheme, u.Host
+		}
+	}
+	return "", strings.TrimSuffix(endpoint, "/")
+}
+
+// newServiceClientProfile returns a new ClientProfile with the endpoint of service overridden
+func (me *TencentCloudClient) newServiceClientProfile(service string, timeout int) *profile.ClientProfile {
+	cpf := me.NewClientProfile(timeout)
+	if scheme, host := me.endpoint(service); host != "" {
+		cpf.HttpProfile.Endpoint = host
+		if scheme != "" {
+			cpf.HttpProfile.Scheme = scheme
+		}
+	}
+	return cpf
+}
+
+// newServiceClientIntlProfile returns a new intl ClientProfile with the endpoint of service overridden
+func (me *TencentCloudClient) newServiceClientIntlProfile(service string, timeout int) *intlProfile.ClientProfile {
+	cpf := me.NewClientIntlProfile(timeout)
+	if scheme, host := me.endpoint(service); host != "" {
+		cpf.HttpProfile.Endpoint = host
+		if scheme != "" {
+			cpf.HttpProfile.Scheme = scheme
+		}
+	}
+	return cpf
+}
+
+// cosURL returns the url of the cos-like product, like `https://<prefix>.cos.<region>.myqcloud.com`,
+// prefix is the bucket or uin, and omitted if empty.
+func (me *TencentCloudClient) cosURL(product, prefix string) *url.URL {
+	scheme, host := "https", fmt.Sprintf("%s.%s.myqcloud.com", product, me.Region)
+	if overriddenScheme, overriddenHost := me.endpoint(product); overriddenHost != "" {
+		host = overriddenHost
+		if overriddenScheme != "" {
+			scheme = overriddenScheme
+		}
+	}
+	if prefix != "" {
+		host = prefix + "." + host
+	}
+	return &url.URL{Scheme: scheme, Host: host}
+}
@@ -0,0 +1,74 @@
+package connectivity
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+)
+
+func TestEndpointOverride(t *testing.T) {
+	var action, product string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		action = r.Header.Get("X-TC-Action")
+		product = requestProduct(r)
+		_, _ = w.Write([]byte(`{"Response":{"TotalCount":0,"InstanceSet":[],"RequestId":"req-1"}}`))
+	}))
+	defer server.Close()
+
+	client := &TencentCloudClient{
+		Credential: common.NewCredential("id", "key"),
+		Region:     "ap-guangzhou",
+		Protocol:   "HTTPS",
+		Endpoints:  map[string]string{"cvm": server.URL},
+	}
+	response, err := client.UseCvmClient().DescribeInstances(cvm.NewDescribeInstancesRequest())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *response.Response.RequestId != "req-1" || action != "DescribeInstances" || product != "cvm" {
+		t.Errorf("unexpected request %s of %s, response %s", action, product, response.ToJsonString())
+	}
+
+	// the other products are not overridden
+	if cpf := client.newServiceClientProfile("vpc", 300); cpf.HttpProfile.Endpoint != "" || cpf.HttpProfile.Scheme != "HTTPS" {
+		t.Errorf("unexpected vpc profile %+v", cpf.HttpProfile)
+	}
+}
+
+func TestCosURL(t *testing.T) {
+	client := &TencentCloudClient{
+		Region: "ap-guangzhou",
+		Endpoints: map[string]string{
+			"ci":          "ci.private.cloud",
+			"cos-control": "http://127.0.0.1:9000/",
+		},
+	}
+	cases := []struct {
+		product, prefix, expected string
+	}{
+		{"cos", "bucket-1250000000", "https://bucket-1250000000.cos.ap-guangzhou.myqcloud.com"},
+		{"cos", "", "https://cos.ap-guangzhou.myqcloud.com"},
+		{"ci", "bucket-1250000000", "https://bucket-1250000000.ci.private.cloud"},
+		{"cos-control", "1250000000", "http://1250000000.127.0.0.1:9000"},
+	}
+	for _, c := range cases {
+		if u := client.cosURL(c.product, c.prefix).String(); u != c.expected {
+			t.Errorf("%s: expected %s, got %s", c.product, c.expected, u)
+		}
+	}
+}
+
+func TestRequestProduct(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodPost, "https://127.0.0.1:8080/", strings.NewReader("{}"))
+	if product := requestProduct(request); product != "127" {
+		t.Errorf("expected the first label of host, got %s", product)
+	}
+	request.Header.Set("Authorization", "TC3-HMAC-SHA256 Credential=AKIDxxx/2023-01-01/tke/tc3_request, SignedHeaders=content-type;host, Signature=xxx")
+	if product := requestProduct(request); product != "tke" {
+		t.Errorf("expected the service of credential scope, got %s", product)
+	}
+}
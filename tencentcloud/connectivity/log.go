@@ -0,0 +1,117 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// RedactedValue replaces the values of the sensitive fields in the logs
+const RedactedValue = "******"
+
+// DefaultRedactedFields are the sensitive fields redacted from the logs, a field is redacted if its name
+// contains any of them case-insensitively, e.g. `password` matches both `Password` and `LoginSettings.Password`.
+var DefaultRedactedFields = []string{
+	"password",
+	"passwd",
+	"pwd",
+	"secretkey",
+	"privatekey",
+	"certkey",
+	"token",
+	"kubeconfig",
+}
+
+// LogConfig defines how the API requests and responses are logged
+type LogConfig struct {
+	// FullPayload logs the request and response bodies verbatim, it is only for debugging
+	FullPayload bool
+	// RedactedFields are the extra sensitive fields redacted from the logs, matched like DefaultRedactedFields
+	RedactedFields []string
+}
+
+var logConfig = struct {
+	sync.RWMutex
+	fullPayload    bool
+	redactedFields []string
+}{redactedFields: DefaultRedactedFields}
+
+// SetLogConfig changes the log config of all the API clients
+func SetLogConfig(config LogConfig) {
+	logConfig.Lock()
+	defer logConfig.Unlock()
+
+	logConfig.fullPayload = config.FullPayload
+	logConfig.redactedFields = append([]string{}, DefaultRedactedFields...)
+	for _, field := range config.RedactedFields {
+		if field = strings.ToLower(strings.TrimSpace(field)); field != "" {
+			logConfig.redactedFields = append(logConfig.redactedFields, field)
+		}
+	}
+}
+
+func isRedactedField(name string) bool {
+	name = strings.ToLower(name)
+	for _, field := range logConfig.redactedFields {
+		if strings.Contains(name, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// logPayload returns the body of request or response to be logged. A JSON body is kept as is so the log record
+// stays structured, a form body is decoded, and the sensitive fields of both are redacted unless FullPayload is set.
+func logPayload(body []byte) interface{} {
+	if len(body) == 0 {
+		return nil
+	}
+	logConfig.RLock()
+	defer logConfig.RUnlock()
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err == nil {
+		if logConfig.fullPayload {
+			return json.RawMessage(body)
+		}
+		return redact(value)
+	}
+	if logConfig.fullPayload {
+		return string(body)
+	}
+
+	if form, err := url.ParseQuery(string(body)); err == nil && len(form) > 0 {
+		values := make(map[string]interface{}, len(form))
+		for key, items := range form {
+			if isRedactedField(key) {
+				values[key] = RedactedValue
+			} else if len(items) == 1 {
+				values[key] = items[0]
+			} else {
+				values[key] = items
+			}
+		}
+		return values
+	}
+	// an unknown body may contain anything, only its size is logged
+	return map[string]int{"size": len(body)}
+}
+
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if isRedactedField(key) {
+				v[key] = RedactedValue
+			} else {
+				v[key] = redact(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redact(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,130 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RetryAllProducts is the product key of RetryableErrorCodes which applies to all products
+const RetryAllProducts = "*"
+
+// RetryPolicy defines how the failed requests are retried
+type RetryPolicy struct {
+	// MaxAttempts is the max attempts of a request, 0 means retrying until the timeout
+	MaxAttempts int
+	// MinBackoff and MaxBackoff bound the exponential backoff between two attempts
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// RetryableErrorCodes is the extra retryable error codes by product, like `cvm` or `cbs`
+	RetryableErrorCodes map[string][]string
+}
+
+// Configured returns whether the attempts or backoff of policy are set
+func (me *RetryPolicy) Configured() bool {
+	return me != nil && (me.MaxAttempts > 0 || me.MinBackoff > 0 || me.MaxBackoff > 0)
+}
+
+// Backoff returns the wait duration before the next attempt, attempt starts from 0.
+// It grows exponentially from MinBackoff to MaxBackoff with a random jitter of half the duration,
+// so the concurrent requests will not wake at the same time.
+func (me *RetryPolicy) Backoff(attempt int) time.Duration {
+	backoff := me.MinBackoff
+	if backoff <= 0 {
+		backoff = time.Second
+	}
+	for i := 0; i < attempt && (me.MaxBackoff <= 0 || backoff < me.MaxBackoff); i++ {
+		backoff *= 2
+	}
+	if me.MaxBackoff > 0 && backoff > me.MaxBackoff {
+		backoff = me.MaxBackoff
+	}
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// ErrorCodes returns the extra retryable error codes of product
+func (me *RetryPolicy) ErrorCodes(product string) []string {
+	if me == nil {
+		return nil
+	}
+	codes := me.RetryableErrorCodes[RetryAllProducts]
+	if product != "" && product != RetryAllProducts {
+		codes = append(codes[:len(codes):len(codes)], me.RetryableErrorCodes[product]...)
+	}
+	return codes
+}
+
+// maxErrorRequests is the max number of failed requests whose product are remembered
+const maxErrorRequests = 1024
+
+// errorRequest is the product of a failed request, and the retry policy of the client which sent it
+type errorRequest struct {
+	product string
+	policy  *RetryPolicy
+}
+
+var errorRequests = struct {
+	sync.Mutex
+	requests map[string]errorRequest
+	ids      []string
+}{requests: make(map[string]errorRequest)}
+
+// recordErrorRequest remembers the product of failed request and the retry policy of the client which sent it,
+// so they can be found by the RequestId of the error
+func recordErrorRequest(request *http.Request, body []byte, policy *RetryPolicy) {
+	if !bytes.Contains(body, []byte(`"Error"`)) {
+		return
+	}
+	var response struct {
+		Response struct {
+			RequestId string
+		}
+	}
+	if err := json.Unmarshal(body, &response); err != nil || response.Response.RequestId == "" {
+		return
+	}
+	rememberErrorRequest(response.Response.RequestId, errorRequest{product: requestProduct(request), policy: policy})
+}
+
+// recordCosErrorRequest remembers the failed COS request, whose RequestId is in the response header
+func recordCosErrorRequest(response *http.Response, policy *RetryPolicy) {
+	if response.StatusCode < http.StatusBadRequest {
+		return
+	}
+	if requestId := response.Header.Get("X-Cos-Request-Id"); requestId != "" {
+		rememberErrorRequest(requestId, errorRequest{product: "cos", policy: policy})
+	}
+}
+
+func rememberErrorRequest(requestId string, request errorRequest) {
+	errorRequests.Lock()
+	defer errorRequests.Unlock()
+	if _, ok := errorRequests.requests[requestId]; !ok {
+		if len(errorRequests.ids) >= maxErrorRequests {
+			delete(errorRequests.requests, errorRequests.ids[0])
+			errorRequests.ids = errorRequests.ids[1:]
+		}
+		errorRequests.ids = append(errorRequests.ids, requestId)
+	}
+	errorRequests.requests[requestId] = request
+}
+
+// RequestProduct returns the product of a failed request, like `cvm`, it returns empty if unknown
+func RequestProduct(requestId string) string {
+	errorRequests.Lock()
+	defer errorRequests.Unlock()
+	return errorRequests.requests[requestId].product
+}
+
+// RequestRetryPolicy returns the retry policy of the client which sent a failed request, it returns nil if unknown
+// or the client has no policy. Every provider configuration has its own client, so the requests of them are retried
+// with their own policies.
+func RequestRetryPolicy(requestId string) *RetryPolicy {
+	errorRequests.Lock()
+	defer errorRequests.Unlock()
+	return errorRequests.requests[requestId].policy
+}
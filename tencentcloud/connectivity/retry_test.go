@@ -0,0 +1,75 @@
+package connectivity
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{MinBackoff: time.Second, MaxBackoff: 10 * time.Second}
+	tests := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{0, 500 * time.Millisecond, time.Second},
+		{1, time.Second, 2 * time.Second},
+		{3, 4 * time.Second, 8 * time.Second},
+		{4, 5 * time.Second, 10 * time.Second},
+		{100, 5 * time.Second, 10 * time.Second},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 20; i++ {
+			if backoff := policy.Backoff(tt.attempt); backoff < tt.min || backoff > tt.max {
+				t.Errorf("attempt %d: backoff %s not in [%s, %s]", tt.attempt, backoff, tt.min, tt.max)
+			}
+		}
+	}
+}
+
+func TestRetryPolicyErrorCodes(t *testing.T) {
+	var policy *RetryPolicy
+	if codes := policy.ErrorCodes("cvm"); codes != nil {
+		t.Errorf("nil policy returns %v", codes)
+	}
+
+	policy = &RetryPolicy{RetryableErrorCodes: map[string][]string{
+		RetryAllProducts: {"InternalError"},
+		"cvm":            {"ResourceInsufficient"},
+	}}
+	if codes := policy.ErrorCodes("cvm"); !reflect.DeepEqual(codes, []string{"InternalError", "ResourceInsufficient"}) {
+		t.Errorf("unexpected cvm codes %v", codes)
+	}
+	if codes := policy.ErrorCodes("cbs"); !reflect.DeepEqual(codes, []string{"InternalError"}) {
+		t.Errorf("unexpected cbs codes %v", codes)
+	}
+	// the shared codes must not be changed by appending
+	if codes := policy.ErrorCodes(""); !reflect.DeepEqual(codes, []string{"InternalError"}) {
+		t.Errorf("unexpected codes %v", codes)
+	}
+}
+
+func TestRecordErrorRequest(t *testing.T) {
+	request, _ := http.NewRequest("POST", "https://cvm.tencentcloudapi.com/", nil)
+	policy := &RetryPolicy{MaxAttempts: 2}
+	recordErrorRequest(request, []byte(`{"Response":{"InstanceSet":[],"RequestId":"ok-request"}}`), policy)
+	recordErrorRequest(request, []byte(`{"Response":{"Error":{"Code":"ResourceInsufficient","Message":""},"RequestId":"error-request"}}`), policy)
+
+	if product := RequestProduct("ok-request"); product != "" {
+		t.Errorf("successful request recorded as %s", product)
+	}
+	if product := RequestProduct("error-request"); product != "cvm" {
+		t.Errorf("expect cvm, got %s", product)
+	}
+	if p := RequestRetryPolicy("error-request"); p != policy {
+		t.Errorf("expect the policy of client, got %+v", p)
+	}
+
+	response := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+	response.Header.Set("X-Cos-Request-Id", "cos-request")
+	recordCosErrorRequest(response, policy)
+	if product := RequestProduct("cos-request"); product != "cos" || RequestRetryPolicy("cos-request") != policy {
+		t.Errorf("unexpected cos request %s", product)
+	}
+}
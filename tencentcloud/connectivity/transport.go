@@ -2,13 +2,17 @@ package connectivity
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 const REQUEST_CLIENT = "TENCENTCLOUD_API_REQUEST_CLIENT"
@@ -22,45 +26,85 @@ func SetReqClient(name string) {
 	ReqClient = name
 }
 
+// LogRoundTripper logs every API request as a JSON record, with the sensitive fields redacted by LogConfig
 type LogRoundTripper struct {
+	// transport sends the requests, it is http.DefaultTransport if nil
+	transport http.RoundTripper
+	// ctx is the context of the requests, see TencentCloudClient.WithContext
+	ctx context.Context
+	// policy is the retry policy of the client, which is remembered with the failed requests
+	policy *RetryPolicy
+	// limits are the rate limits of the client, the default limits apply if nil
+	limits *ratelimit.Limits
+}
+
+// logRecord is the structured log of an API request
+type logRecord struct {
+	Product   string      `json:"product"`
+	Action    string      `json:"action"`
+	Region    string      `json:"region,omitempty"`
+	Host      string      `json:"host"`
+	RequestId string      `json:"request_id,omitempty"`
+	Retry     int         `json:"retry"`
+	LatencyMs int64       `json:"latency_ms"`
+	Status    int         `json:"status,omitempty"`
+	ErrorCode string      `json:"error_code,omitempty"`
+	Error     string      `json:"error,omitempty"`
+	Request   interface{} `json:"request,omitempty"`
+	Response  interface{} `json:"response,omitempty"`
 }
 
 func (me *LogRoundTripper) RoundTrip(request *http.Request) (response *http.Response, errRet error) {
 
+	request, cancel := withRequestContext(request, me.ctx)
+	defer cancel()
+
 	var inBytes, outBytes []byte
 
+	record := &logRecord{
+		Product: requestProduct(request),
+		Action:  requestHeader(request, "X-TC-Action"),
+		Region:  requestHeader(request, "X-TC-Region"),
+		Host:    request.Host,
+		Retry:   requestRetry(request),
+	}
+	if record.Host == "" {
+		record.Host = request.URL.Host
+	}
+
 	var start = time.Now()
 
-	defer func() { me.log(inBytes, outBytes, errRet, start) }()
+	defer func() { me.log(record, inBytes, outBytes, response, errRet, start) }()
 
 	bodyReader, errRet := request.GetBody()
 	if errRet != nil {
 		return
 	}
-	var headName = "X-TC-Action"
 
 	if envReqClient := os.Getenv(REQUEST_CLIENT); envReqClient != "" {
 		ReqClient = envReqClient
 	}
 
 	request.Header.Set("X-TC-RequestClient", ReqClient)
-	inBytes = []byte(fmt.Sprintf("%s, request: ", request.Header[headName]))
-	requestBody, errRet := ioutil.ReadAll(bodyReader)
-	if errRet != nil {
+
+	if errRet = me.limits.Wait(request.Context(), record.Product, record.Action); errRet != nil {
 		return
 	}
-	inBytes = append(inBytes, requestBody...)
-
-	headName = "X-TC-Region"
-	appendMessage := []byte(fmt.Sprintf(
-		", (host %+v, region:%+v)",
-		request.Header["Host"],
-		request.Header[headName],
-	))
+	// the latency excludes the time waiting for the rate limit
+	start = time.Now()
 
-	inBytes = append(inBytes, appendMessage...)
+	inBytes, errRet = ioutil.ReadAll(bodyReader)
+	if errRet != nil {
+		return
+	}
 
-	response, errRet = http.DefaultTransport.RoundTrip(request)
+	transport := me.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	outgoing := request.Clone(request.Context())
+	outgoing.Header.Del(retryHeader)
+	response, errRet = transport.RoundTrip(outgoing)
 	if errRet != nil {
 		return
 	}
@@ -69,44 +113,84 @@ func (me *LogRoundTripper) RoundTrip(request *http.Request) (response *http.Resp
 		return
 	}
 	response.Body = ioutil.NopCloser(bytes.NewBuffer(outBytes))
+	recordErrorRequest(request, outBytes, me.policy)
 	return
 }
 
-func (me *LogRoundTripper) log(in []byte, out []byte, err error, start time.Time) {
-	var buf bytes.Buffer
-	buf.WriteString("######")
+func (me *LogRoundTripper) log(record *logRecord, in []byte, out []byte, response *http.Response, err error, start time.Time) {
+	record.LatencyMs = time.Since(start).Milliseconds()
+	record.Request = logPayload(in)
+	record.Response = logPayload(out)
+	if response != nil {
+		record.Status = response.StatusCode
+	}
+
+	var result struct {
+		Response struct {
+			RequestId string
+			Error     struct {
+				Code string
+			}
+		}
+	}
+	if json.Unmarshal(out, &result) == nil {
+		record.RequestId = result.Response.RequestId
+		record.ErrorCode = result.Response.Error.Code
+	}
+
 	tag := "[DEBUG]"
 	if err != nil {
 		tag = "[CRITICAL]"
+		record.Error = err.Error()
+	} else if record.ErrorCode != "" {
+		tag = "[WARN]"
 	}
-	buf.WriteString(tag)
-	if len(in) > 0 {
-		buf.WriteString("tencentcloud-sdk-go: ")
-		buf.Write(in)
-	}
-	if len(out) > 0 {
-		buf.WriteString("; response:")
-		err := json.Compact(&buf, out)
-		if err != nil {
-			out := bytes.Replace(out,
-				[]byte("\n"),
-				[]byte(""),
-				-1)
-			out = bytes.Replace(out,
-				[]byte(" "),
-				[]byte(""),
-				-1)
-			buf.Write(out)
-		}
+
+	buf, marshalErr := json.Marshal(record)
+	if marshalErr != nil {
+		log.Printf("[CRITICAL] tencentcloud-sdk-go: marshal log of %s.%s failed: %v", record.Product, record.Action, marshalErr)
+		return
 	}
+	log.Printf("%s tencentcloud-sdk-go: %s", tag, buf)
+}
 
-	if err != nil {
-		buf.WriteString("; error:")
-		buf.WriteString(err.Error())
+// retryHeader carries how many times a request has been sent. The SDK resends the same request on the rate limit and
+// network failures, and the copies of the request made by http.Client and withRequestContext share its header, so
+// the count is kept across the attempts. It is removed from the request sent to the API.
+const retryHeader = "X-Terraform-Retry"
+
+// requestRetry returns how many times request has been sent before, and counts the current attempt
+func requestRetry(request *http.Request) int {
+	retry, _ := strconv.Atoi(request.Header.Get(retryHeader))
+	request.Header.Set(retryHeader, strconv.Itoa(retry+1))
+	return retry
+}
+
+// requestHeader returns the header key of request, the SDK sets the X-TC headers with the keys as they are, which
+// are not found by Header.Get
+func requestHeader(request *http.Request, key string) string {
+	if values := request.Header[key]; len(values) > 0 {
+		return values[0]
 	}
+	return request.Header.Get(key)
+}
 
-	costFormat := fmt.Sprintf(",cost %s", time.Since(start).String())
-	buf.WriteString(costFormat)
+// requestProduct returns the product of request, which is the service in the credential scope of the TC3 signature,
+// like `cvm` of `Credential=AKIDxxx/2023-01-01/cvm/tc3_request`, or the first label of the API endpoint if unsigned,
+// so the product is known even if the endpoint is overridden.
+func requestProduct(request *http.Request) string {
+	if authorization := request.Header.Get("Authorization"); strings.HasPrefix(authorization, "TC3-") {
+		if i := strings.Index(authorization, "Credential="); i >= 0 {
+			scope := strings.Split(strings.SplitN(authorization[i+len("Credential="):], ",", 2)[0], "/")
+			if len(scope) == 4 && scope[2] != "" {
+				return scope[2]
+			}
+		}
+	}
 
-	log.Println(buf.String())
+	host := request.Host
+	if host == "" {
+		host = request.URL.Host
+	}
+	return strings.Split(host, ".")[0]
 }
@@ -0,0 +1,151 @@
+package connectivity
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// TLSVersions are the valid values of TransportConfig.MinTLSVersion
+var TLSVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// TransportConfig defines the HTTP transport shared by all the API clients
+type TransportConfig struct {
+	// ProxyURL is the proxy of the requests like `http://host:port`, the proxy environment variables like `HTTPS_PROXY` are used if empty
+	ProxyURL string
+	// CABundleFile is the PEM file of the extra CA certificates trusted besides the system ones
+	CABundleFile string
+	// InsecureSkipVerify skips the verification of the server certificates, it is only for testing
+	InsecureSkipVerify bool
+	// MinTLSVersion is the min TLS version like `1.2`, the default of Go is used if empty
+	MinTLSVersion string
+	// ConnectTimeout and TLSHandshakeTimeout bound the time of establishing a connection, 0 means the default
+	ConnectTimeout      time.Duration
+	TLSHandshakeTimeout time.Duration
+}
+
+// NewTransport returns a http.Transport with config, the unset ones are the same as http.DefaultTransport
+func NewTransport(config TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyURL != "" {
+		proxy, err := url.Parse(config.ProxyURL)
+		if err != nil || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid proxy url %q", config.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: config.InsecureSkipVerify}
+	if config.CABundleFile != "" {
+		bundle, err := ioutil.ReadFile(config.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("read ca bundle file failed: %v", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(bundle) {
+			return nil, fmt.Errorf("no certificate found in ca bundle file %s", config.CABundleFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.MinTLSVersion != "" {
+		version, ok := TLSVersions[config.MinTLSVersion]
+		if !ok {
+			return nil, fmt.Errorf("invalid min tls version %q", config.MinTLSVersion)
+		}
+		tlsConfig.MinVersion = version
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if config.ConnectTimeout > 0 {
+		dialer := &net.Dialer{
+			Timeout:   config.ConnectTimeout,
+			KeepAlive: 30 * time.Second,
+		}
+		transport.DialContext = dialer.DialContext
+	}
+	if config.TLSHandshakeTimeout > 0 {
+		transport.TLSHandshakeTimeout = config.TLSHandshakeTimeout
+	}
+	return transport, nil
+}
+
+// baseTransport returns the transport of the requests, it is http.DefaultTransport if HTTPTransport is not set
+func (me *TencentCloudClient) baseTransport() http.RoundTripper {
+	if me.HTTPTransport != nil {
+		return me.HTTPTransport
+	}
+	return http.DefaultTransport
+}
+
+// httpTransport returns the transport of the requests which are not logged, like the ones of COS,
+// the requests are sent with the context of client
+func (me *TencentCloudClient) httpTransport() http.RoundTripper {
+	if me.ctx != nil {
+		return &contextRoundTripper{ctx: me.ctx, transport: me.baseTransport()}
+	}
+	return me.baseTransport()
+}
+
+// newLogRoundTripper returns the LogRoundTripper which sends the requests with the transport and context of client
+func (me *TencentCloudClient) newLogRoundTripper() *LogRoundTripper {
+	return &LogRoundTripper{transport: me.baseTransport(), ctx: me.ctx, policy: me.RetryPolicy, limits: me.RateLimits}
+}
+
+// contextRoundTripper sends the requests with ctx
+type contextRoundTripper struct {
+	ctx       context.Context
+	transport http.RoundTripper
+}
+
+func (me *contextRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	request, cancel := withRequestContext(request, me.ctx)
+	response, err := me.transport.RoundTrip(request)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	response.Body = &cancelBody{ReadCloser: response.Body, cancel: cancel}
+	return response, nil
+}
+
+// cancelBody cancels the context of request when the response body is closed
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (me *cancelBody) Close() error {
+	defer me.cancel()
+	return me.ReadCloser.Close()
+}
+
+// withRequestContext returns request which is canceled when ctx is done, and keeps the deadline of its own context,
+// like the one of http.Client.Timeout. The returned cancel must be called after the response is read.
+func withRequestContext(request *http.Request, ctx context.Context) (*http.Request, context.CancelFunc) {
+	if ctx == nil {
+		return request, func() {}
+	}
+	var cancel context.CancelFunc
+	if deadline, ok := request.Context().Deadline(); ok {
+		ctx, cancel = context.WithDeadline(ctx, deadline)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	return request.WithContext(ctx), cancel
+}
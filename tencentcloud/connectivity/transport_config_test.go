@@ -0,0 +1,96 @@
+package connectivity
+
+import (
+	"context"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+)
+
+func TestTransportCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Response":{"TotalCount":0,"InstanceSet":[],"RequestId":"req-1"}}`))
+	}))
+	defer server.Close()
+
+	caBundleFile := filepath.Join(t.TempDir(), "ca.pem")
+	bundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := ioutil.WriteFile(caBundleFile, bundle, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	newClient := func(config TransportConfig) *TencentCloudClient {
+		transport, err := NewTransport(config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return &TencentCloudClient{
+			Credential:    common.NewCredential("id", "key"),
+			Region:        "ap-guangzhou",
+			Endpoints:     map[string]string{"cvm": server.URL, "cos": server.URL},
+			HTTPTransport: transport,
+		}
+	}
+
+	if _, err := newClient(TransportConfig{}).UseCvmClient().DescribeInstances(cvm.NewDescribeInstancesRequest()); err == nil {
+		t.Errorf("expected error of the untrusted certificate")
+	}
+	for _, config := range []TransportConfig{{CABundleFile: caBundleFile}, {InsecureSkipVerify: true}} {
+		client := newClient(config)
+		if _, err := client.UseCvmClient().DescribeInstances(cvm.NewDescribeInstancesRequest()); err != nil {
+			t.Errorf("unexpected error of %+v: %v", config, err)
+		}
+		// the cos client shares the transport
+		if _, err := client.UseTencentCosClient("").Bucket.Head(context.TODO()); err != nil {
+			t.Errorf("unexpected cos error of %+v: %v", config, err)
+		}
+	}
+
+	if _, err := NewTransport(TransportConfig{CABundleFile: filepath.Join(t.TempDir(), "missing.pem")}); err == nil {
+		t.Errorf("expected error of the missing ca bundle file")
+	}
+	invalidFile := filepath.Join(t.TempDir(), "invalid.pem")
+	_ = ioutil.WriteFile(invalidFile, []byte("not a certificate"), 0600)
+	if _, err := NewTransport(TransportConfig{CABundleFile: invalidFile}); err == nil {
+		t.Errorf("expected error of the invalid ca bundle file")
+	}
+}
+
+func TestTransportProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		_, _ = w.Write([]byte(`{"Response":{"TotalCount":0,"InstanceSet":[],"RequestId":"req-1"}}`))
+	}))
+	defer proxy.Close()
+
+	transport, err := NewTransport(TransportConfig{ProxyURL: proxy.URL})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := &TencentCloudClient{
+		Credential:    common.NewCredential("id", "key"),
+		Region:        "ap-guangzhou",
+		Endpoints:     map[string]string{"cvm": "http://cvm.internal.example.com"},
+		HTTPTransport: transport,
+	}
+	if _, err := client.UseCvmClient().DescribeInstances(cvm.NewDescribeInstancesRequest()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if u, _ := url.Parse(proxied); u == nil || u.Host != "cvm.internal.example.com" {
+		t.Errorf("expected the request sent through proxy, got %q", proxied)
+	}
+
+	for _, config := range []TransportConfig{{ProxyURL: "127.0.0.1:3128"}, {MinTLSVersion: "1.4"}} {
+		if _, err := NewTransport(config); err == nil {
+			t.Errorf("expected error of %+v", config)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package connectivity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+)
+
+// the SDK sets the X-TC headers without canonicalizing the keys, the transport must still read the action of them
+func TestLogRoundTripperSDKRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Response":{"TotalCount":0,"InstanceSet":[],"RequestId":"req-1"}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	client := &TencentCloudClient{
+		Credential: common.NewCredential("id", "key"),
+		Region:     "ap-guangzhou",
+		Protocol:   "HTTPS",
+		Endpoints:  map[string]string{"cvm": server.URL},
+		RateLimits: ratelimit.NewLimits(map[string]int64{"cvm.DescribeInstances": 1}),
+	}
+	if _, err := client.UseCvmClient().DescribeInstances(cvm.NewDescribeInstancesRequest()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var record logRecord
+	line := strings.TrimSpace(buf.String())
+	if i := strings.Index(line, "{"); i < 0 || json.Unmarshal([]byte(line[i:]), &record) != nil {
+		t.Fatalf("unexpected log %q", line)
+	}
+	if record.Product != "cvm" || record.Action != "DescribeInstances" || record.Region != "ap-guangzhou" {
+		t.Errorf("unexpected record %+v", record)
+	}
+
+	// the limit of the action applies, the next request waits for 1s
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	_, err := client.WithContext(ctx).UseCvmClient().DescribeInstances(cvm.NewDescribeInstancesRequest())
+	if err == nil || !strings.Contains(err.Error(), "rate limit of cvm.DescribeInstances") {
+		t.Errorf("expected the request to be limited by cvm.DescribeInstances, got %v", err)
+	}
+
+	// the limits of another client are not shared
+	other := &TencentCloudClient{
+		Credential: client.Credential,
+		Region:     client.Region,
+		Protocol:   client.Protocol,
+		Endpoints:  client.Endpoints,
+		RateLimits: ratelimit.NewLimits(nil),
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	if _, err := other.WithContext(ctx).UseCvmClient().DescribeInstances(cvm.NewDescribeInstancesRequest()); err != nil {
+		t.Errorf("unexpected error of another client: %v", err)
+	}
+}
+
+// the SDK resends the same request, which is copied by http.Client with the timeout of the profile and by the context
+// of the client, the retries must still be counted
+func TestLogRoundTripperSDKRetry(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(retryHeader) != "" {
+			t.Errorf("unexpected header %s sent to the API", retryHeader)
+		}
+		attempts++
+		if attempts < 3 {
+			_, _ = w.Write([]byte(`{"Response":{"Error":{"Code":"RequestLimitExceeded","Message":"limited"},"RequestId":"req-1"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"Response":{"TotalCount":0,"InstanceSet":[],"RequestId":"req-2"}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	client := &TencentCloudClient{
+		Credential:  common.NewCredential("id", "key"),
+		Region:      "ap-guangzhou",
+		Protocol:    "HTTPS",
+		Endpoints:   map[string]string{"cvm": server.URL},
+		RetryPolicy: &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if _, err := client.WithContext(ctx).UseCvmClient().DescribeInstances(cvm.NewDescribeInstancesRequest()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var retries []int
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var record logRecord
+		if i := strings.Index(line, "{"); i < 0 || json.Unmarshal([]byte(line[i:]), &record) != nil {
+			continue
+		}
+		retries = append(retries, record.Retry)
+	}
+	if len(retries) != 3 || retries[0] != 0 || retries[1] != 1 || retries[2] != 2 {
+		t.Errorf("expected the retries 0, 1 and 2, got %v", retries)
+	}
+}
@@ -0,0 +1,250 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The CustomizeDiffFunc helpers below check the arguments of a resource at plan time, so the invalid combinations
+// fail in `terraform plan` instead of after a partial creation. They are composed with customdiff.All, e.g.
+//
+//	CustomizeDiff: customdiff.All(
+//		customDiffOnCreate(
+//			customDiffRequiredWhen("charge_type", []string{"PREPAID"}, "prepaid_period"),
+//		),
+//		customDiffForceNewIfDecrease("storage_size"),
+//	),
+//
+// An argument is set if it has a non-zero value or its value is unknown, and the checks on the value of an
+// unknown argument are skipped, the checks at apply time still cover them.
+
+// customDiffOnCreate returns a CustomizeDiffFunc which runs funcs only when the resource is to be created,
+// it is for the checks on the arguments which are not read back from the API.
+func customDiffOnCreate(funcs ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	check := customdiff.All(funcs...)
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() != "" {
+			return nil
+		}
+		return check(ctx, d, meta)
+	}
+}
+
+// customDiffRequiredWhen returns a CustomizeDiffFunc which requires keys to be set when the value of key is one of values
+func customDiffRequiredWhen(key string, values []string, keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !diffValueIn(d, key, values) {
+			return nil
+		}
+		var missing []string
+		for _, k := range keys {
+			if !diffIsSet(d, k) {
+				missing = append(missing, "`"+k+"`")
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("%s must be set when `%s` is %s", strings.Join(missing, ", "), key, describeValues(values))
+		}
+		return nil
+	}
+}
+
+// customDiffConflictsWhen returns a CustomizeDiffFunc which forbids keys to be set when the value of key is one of values
+func customDiffConflictsWhen(key string, values []string, keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !diffValueIn(d, key, values) {
+			return nil
+		}
+		if conflicts := diffSetKeys(d, keys); len(conflicts) > 0 {
+			return fmt.Errorf("%s can not be set when `%s` is %s", strings.Join(conflicts, ", "), key, describeValues(values))
+		}
+		return nil
+	}
+}
+
+// customDiffAllowedWhen returns a CustomizeDiffFunc which allows keys to be set only when the value of key is one of values.
+// The keys of an existing resource are only checked when they are changed, so the configurations which keep them
+// after key is changed still plan.
+func customDiffAllowedWhen(key string, values []string, keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown(key) || diffValueIn(d, key, values) {
+			return nil
+		}
+		var conflicts []string
+		for _, k := range keys {
+			if diffIsSet(d, k) && (d.Id() == "" || d.HasChange(k)) {
+				conflicts = append(conflicts, "`"+k+"`")
+			}
+		}
+		if len(conflicts) > 0 {
+			return fmt.Errorf("%s can only be set when `%s` is %s", strings.Join(conflicts, ", "), key, describeValues(values))
+		}
+		return nil
+	}
+}
+
+// customDiffRequiredTogether returns a CustomizeDiffFunc which requires keys to be all set or none set
+func customDiffRequiredTogether(keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if set := diffSetKeys(d, keys); len(set) > 0 && len(set) < len(keys) {
+			return fmt.Errorf("`%s` must be set together", strings.Join(keys, "`, `"))
+		}
+		return nil
+	}
+}
+
+// customDiffExactlyOneOf returns a CustomizeDiffFunc which requires exactly one of keys to be set
+func customDiffExactlyOneOf(keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if set := diffSetKeys(d, keys); len(set) != 1 {
+			return fmt.Errorf("exactly one of `%s` must be set", strings.Join(keys, "`, `"))
+		}
+		return nil
+	}
+}
+
+// customDiffConflictsWith returns a CustomizeDiffFunc which forbids keys to be set when key is set
+func customDiffConflictsWith(key string, keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !diffIsSet(d, key) {
+			return nil
+		}
+		if conflicts := diffSetKeys(d, keys); len(conflicts) > 0 {
+			return fmt.Errorf("%s can not be set with `%s`", strings.Join(conflicts, ", "), key)
+		}
+		return nil
+	}
+}
+
+// customDiffUpdatableWhen returns a CustomizeDiffFunc which allows keys to be changed only when the value of key is
+// one of values
+func customDiffUpdatableWhen(key string, values []string, keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" || !d.NewValueKnown(key) || diffValueIn(d, key, values) {
+			return nil
+		}
+		var changed []string
+		for _, k := range keys {
+			if d.HasChange(k) {
+				changed = append(changed, "`"+k+"`")
+			}
+		}
+		if len(changed) > 0 {
+			return fmt.Errorf("%s can only be changed when `%s` is %s", strings.Join(changed, ", "), key, describeValues(values))
+		}
+		return nil
+	}
+}
+
+// customDiffImmutable returns a CustomizeDiffFunc which forbids keys to be changed after the resource is created,
+// it is for the arguments which can neither be updated nor be ForceNew
+func customDiffImmutable(keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+		for _, k := range keys {
+			if d.HasChange(k) {
+				return fmt.Errorf("`%s` can not be changed after the resource is created", k)
+			}
+		}
+		return nil
+	}
+}
+
+// customDiffNoDecrease returns a CustomizeDiffFunc which forbids the number keys to decrease, like the size of a disk
+func customDiffNoDecrease(keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+		for _, k := range keys {
+			if !d.HasChange(k) || !d.NewValueKnown(k) {
+				continue
+			}
+			o, n := d.GetChange(k)
+			if n.(int) < o.(int) {
+				return fmt.Errorf("`%s` can not be decreased from %d to %d", k, o.(int), n.(int))
+			}
+		}
+		return nil
+	}
+}
+
+// customDiffForceNewIfDecrease returns a CustomizeDiffFunc which replaces the resource when any of the number keys
+// decreases, so the plan shows the replacement instead of failing at apply time. An unknown new value is not treated
+// as a decrease, since it would be read as zero.
+func customDiffForceNewIfDecrease(keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+		for _, k := range keys {
+			if !d.HasChange(k) || !d.NewValueKnown(k) {
+				continue
+			}
+			o, n := d.GetChange(k)
+			if n.(int) < o.(int) {
+				if err := d.ForceNew(k); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// diffIsSet returns whether key has a non-zero value or an unknown value
+func diffIsSet(d *schema.ResourceDiff, key string) bool {
+	if !d.NewValueKnown(key) {
+		return true
+	}
+	_, ok := d.GetOk(key)
+	return ok
+}
+
+// diffUnsetInConfig returns whether the top-level key is not set in the configuration, so its unknown value is
+// computed by the API rather than interpolated. It returns true if the configuration is not sent, like in SimpleDiff.
+func diffUnsetInConfig(d *schema.ResourceDiff, key string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(key) {
+		return true
+	}
+	return config.GetAttr(key).IsNull()
+}
+
+// diffSetKeys returns the quoted keys which are set
+func diffSetKeys(d *schema.ResourceDiff, keys []string) (set []string) {
+	for _, k := range keys {
+		if diffIsSet(d, k) {
+			set = append(set, "`"+k+"`")
+		}
+	}
+	return
+}
+
+// diffValueIn returns whether the value of key is known and one of values, the values are compared as strings,
+// so `[]string{"true"}` matches a bool and `[]string{"7"}` matches an int
+func diffValueIn(d *schema.ResourceDiff, key string, values []string) bool {
+	if !d.NewValueKnown(key) {
+		return false
+	}
+	value := fmt.Sprint(d.Get(key))
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func describeValues(values []string) string {
+	if len(values) == 1 {
+		return "`" + values[0] + "`"
+	}
+	return "one of `" + strings.Join(values, "`, `") + "`"
+}
@@ -0,0 +1,324 @@
+package tencentcloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+)
+
+// testUnknownValue is the value of an unknown argument in terraform.NewResourceConfigRaw, like an attribute of another
+// resource to be created
+const testUnknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func testCustomizeDiff(t *testing.T, r *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}, meta interface{}) (*terraform.InstanceDiff, error) {
+	t.Helper()
+	return r.SimpleDiff(context.TODO(), state, terraform.NewResourceConfigRaw(raw), meta)
+}
+
+func TestCustomizeDiffHelpers(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"charge_type":    {Type: schema.TypeString, Optional: true, Default: "POSTPAID"},
+			"prepaid_period": {Type: schema.TypeInt, Optional: true},
+			"network_type":   {Type: schema.TypeString, Optional: true},
+			"subnet_id":      {Type: schema.TypeString, Optional: true},
+			"vpc_id":         {Type: schema.TypeString, Optional: true},
+			"size":           {Type: schema.TypeInt, Optional: true},
+			"disk_size":      {Type: schema.TypeInt, Optional: true},
+			"bandwidth":      {Type: schema.TypeInt, Optional: true},
+		},
+		CustomizeDiff: customdiff.All(
+			customDiffOnCreate(
+				customDiffRequiredWhen("charge_type", []string{"PREPAID"}, "prepaid_period"),
+			),
+			customDiffAllowedWhen("charge_type", []string{"PREPAID"}, "prepaid_period"),
+			customDiffConflictsWhen("network_type", []string{"OPEN"}, "subnet_id"),
+			customDiffRequiredTogether("vpc_id", "subnet_id"),
+			customDiffUpdatableWhen("network_type", []string{"OPEN"}, "bandwidth"),
+			customDiffNoDecrease("disk_size"),
+			customDiffForceNewIfDecrease("size"),
+		),
+	}
+
+	for _, c := range []struct {
+		raw      map[string]interface{}
+		expected string
+	}{
+		{map[string]interface{}{"charge_type": "PREPAID", "prepaid_period": 1}, ""},
+		{map[string]interface{}{"charge_type": "PREPAID"}, "`prepaid_period` must be set when `charge_type` is `PREPAID`"},
+		{map[string]interface{}{"prepaid_period": 1}, "`prepaid_period` can only be set when `charge_type` is `PREPAID`"},
+		{map[string]interface{}{"network_type": "OPEN", "vpc_id": "vpc-1", "subnet_id": "subnet-1"}, "`subnet_id` can not be set when `network_type` is `OPEN`"},
+		{map[string]interface{}{"network_type": "INTERNAL", "vpc_id": "vpc-1"}, "`vpc_id`, `subnet_id` must be set together"},
+		{map[string]interface{}{"network_type": "INTERNAL", "vpc_id": "vpc-1", "subnet_id": "subnet-1"}, ""},
+	} {
+		_, err := testCustomizeDiff(t, r, nil, c.raw, nil)
+		if c.expected == "" && err != nil {
+			t.Errorf("%v: unexpected error: %v", c.raw, err)
+		}
+		if c.expected != "" && (err == nil || !strings.Contains(err.Error(), c.expected)) {
+			t.Errorf("%v: expected error %q, got %v", c.raw, c.expected, err)
+		}
+	}
+
+	state := &terraform.InstanceState{
+		ID: "ins-test",
+		Attributes: map[string]string{
+			"id":           "ins-test",
+			"charge_type":  "PREPAID",
+			"network_type": "INTERNAL",
+			"size":         "100",
+			"disk_size":    "100",
+			"bandwidth":    "10",
+		},
+	}
+	// prepaid_period is not read back, so it is not required any more
+	if _, err := testCustomizeDiff(t, r, state, map[string]interface{}{"charge_type": "PREPAID", "network_type": "INTERNAL", "size": 100, "disk_size": 100, "bandwidth": 10}, nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := testCustomizeDiff(t, r, state, map[string]interface{}{"charge_type": "PREPAID", "network_type": "INTERNAL", "size": 100, "disk_size": 100, "bandwidth": 20}, nil); err == nil || !strings.Contains(err.Error(), "`bandwidth` can only be changed when `network_type` is `OPEN`") {
+		t.Errorf("expected error of changing bandwidth, got %v", err)
+	}
+	if _, err := testCustomizeDiff(t, r, state, map[string]interface{}{"charge_type": "PREPAID", "network_type": "INTERNAL", "size": 100, "disk_size": 50, "bandwidth": 10}, nil); err == nil || !strings.Contains(err.Error(), "`disk_size` can not be decreased from 100 to 50") {
+		t.Errorf("expected error of decreasing disk_size, got %v", err)
+	}
+	diff, err := testCustomizeDiff(t, r, state, map[string]interface{}{"charge_type": "PREPAID", "network_type": "INTERNAL", "size": 50, "disk_size": 100, "bandwidth": 10}, nil)
+	if err != nil || !diff.RequiresNew() {
+		t.Errorf("expected replacement when size decreases, got %v: %v", diff, err)
+	}
+	diff, err = testCustomizeDiff(t, r, state, map[string]interface{}{"charge_type": "PREPAID", "network_type": "INTERNAL", "size": 200, "disk_size": 100, "bandwidth": 10}, nil)
+	if err != nil || diff.RequiresNew() {
+		t.Errorf("expected update when size increases, got %v: %v", diff, err)
+	}
+
+	// the configurations which keep prepaid_period after charge_type is changed still plan
+	state.Attributes["prepaid_period"] = "1"
+	if _, err := testCustomizeDiff(t, r, state, map[string]interface{}{"charge_type": "POSTPAID", "prepaid_period": 1, "network_type": "INTERNAL", "size": 100, "disk_size": 100, "bandwidth": 10}, nil); err != nil {
+		t.Errorf("unexpected error of the kept prepaid_period: %v", err)
+	}
+	if _, err := testCustomizeDiff(t, r, state, map[string]interface{}{"charge_type": "POSTPAID", "prepaid_period": 2, "network_type": "INTERNAL", "size": 100, "disk_size": 100, "bandwidth": 10}, nil); err == nil || !strings.Contains(err.Error(), "`prepaid_period` can only be set when `charge_type` is `PREPAID`") {
+		t.Errorf("expected error of changing prepaid_period, got %v", err)
+	}
+}
+
+func TestInstanceCustomizeDiff(t *testing.T) {
+	r := resourceTencentCloudInstance()
+	raw := map[string]interface{}{
+		"image_id":             "img-xxx",
+		"availability_zone":    "ap-guangzhou-3",
+		"instance_charge_type": CVM_CHARGE_TYPE_PREPAID,
+	}
+	if _, err := testCustomizeDiff(t, r, nil, raw, nil); err == nil || !strings.Contains(err.Error(), "`instance_charge_type_prepaid_period` must be set") {
+		t.Errorf("expected error of the missing prepaid period, got %v", err)
+	}
+	raw["instance_charge_type_prepaid_period"] = 1
+	if _, err := testCustomizeDiff(t, r, nil, raw, nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	raw["spot_max_price"] = "0.5"
+	if _, err := testCustomizeDiff(t, r, nil, raw, nil); err == nil || !strings.Contains(err.Error(), "`spot_max_price` can only be set when `instance_charge_type` is `SPOTPAID`") {
+		t.Errorf("expected error of the spot price, got %v", err)
+	}
+
+	// the prepaid period kept after the charge type is changed is not checked again
+	state := &terraform.InstanceState{
+		ID: "ins-test",
+		Attributes: map[string]string{
+			"id":                                  "ins-test",
+			"image_id":                            "img-xxx",
+			"availability_zone":                   "ap-guangzhou-3",
+			"instance_charge_type":                CVM_CHARGE_TYPE_PREPAID,
+			"instance_charge_type_prepaid_period": "1",
+		},
+	}
+	delete(raw, "spot_max_price")
+	raw["instance_charge_type"] = CVM_CHARGE_TYPE_POSTPAID
+	if _, err := testCustomizeDiff(t, r, state, raw, nil); err != nil {
+		t.Errorf("unexpected error of changing the charge type: %v", err)
+	}
+}
+
+func TestCbsStorageCustomizeDiff(t *testing.T) {
+	r := resourceTencentCloudCbsStorage()
+	state := &terraform.InstanceState{
+		ID: "disk-test",
+		Attributes: map[string]string{
+			"id":                "disk-test",
+			"storage_type":      "CLOUD_PREMIUM",
+			"storage_size":      "100",
+			"availability_zone": "ap-guangzhou-3",
+			"storage_name":      "tf-test",
+			"charge_type":       CBS_CHARGE_TYPE_POSTPAID,
+			"project_id":        "0",
+			"force_delete":      "false",
+		},
+	}
+	raw := map[string]interface{}{
+		"storage_type":      "CLOUD_PREMIUM",
+		"availability_zone": "ap-guangzhou-3",
+		"storage_name":      "tf-test",
+	}
+
+	for _, c := range []struct {
+		size        interface{}
+		requiresNew bool
+	}{
+		{50, true},
+		{200, false},
+		// the size interpolated from another resource is unknown, it must not be planned as a decrease to zero
+		{testUnknownValue, false},
+	} {
+		raw["storage_size"] = c.size
+		diff, err := testCustomizeDiff(t, r, state, raw, nil)
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", c.size, err)
+			continue
+		}
+		if diff.RequiresNew() != c.requiresNew {
+			t.Errorf("%v: expected replacement %v, got diff %v", c.size, c.requiresNew, diff)
+		}
+	}
+}
+
+func TestRedisInstanceCustomizeDiff(t *testing.T) {
+	r := resourceTencentCloudRedisInstance()
+	raw := map[string]interface{}{
+		"availability_zone": "ap-guangzhou-3",
+		"mem_size":          8192,
+		"password":          "test12345789",
+		"type_id":           REDIS_VERSION_MASTER_SLAVE_REDIS5,
+		"redis_shard_num":   3,
+	}
+	if _, err := testCustomizeDiff(t, r, nil, raw, nil); err == nil || !strings.Contains(err.Error(), "`redis_shard_num` can only be set for the cluster types") {
+		t.Errorf("expected error of the shard num, got %v", err)
+	}
+	raw["type_id"] = REDIS_VERSION_CLUSTER_REDIS5
+	if _, err := testCustomizeDiff(t, r, nil, raw, nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// an instance created with the deprecated `type` is kept when it is replaced with `type_id` in the config
+	state := &terraform.InstanceState{
+		ID: "crs-test",
+		Attributes: map[string]string{
+			"id":                 "crs-test",
+			"availability_zone":  "ap-guangzhou-3",
+			"mem_size":           "8192",
+			"type_id":            "8",
+			"type":               REDIS_NAMES[REDIS_VERSION_MASTER_SLAVE_REDIS5],
+			"redis_shard_num":    "1",
+			"redis_replicas_num": "1",
+			"charge_type":        REDIS_CHARGE_TYPE_POSTPAID,
+			"auto_renew_flag":    "0",
+			"port":               "6379",
+			"project_id":         "0",
+		},
+	}
+	for _, c := range []struct {
+		raw         map[string]interface{}
+		requiresNew bool
+	}{
+		{map[string]interface{}{"type": REDIS_NAMES[REDIS_VERSION_MASTER_SLAVE_REDIS5]}, false},
+		{map[string]interface{}{"type_id": REDIS_VERSION_MASTER_SLAVE_REDIS5}, false},
+		{map[string]interface{}{"type_id": REDIS_VERSION_CLUSTER_REDIS5}, true},
+		{map[string]interface{}{"type": REDIS_NAMES[REDIS_VERSION_CLUSTER_REDIS5]}, true},
+	} {
+		c.raw["availability_zone"] = "ap-guangzhou-3"
+		c.raw["mem_size"] = 8192
+		diff, err := testCustomizeDiff(t, r, state, c.raw, nil)
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", c.raw, err)
+			continue
+		}
+		if diff.RequiresNew() != c.requiresNew {
+			t.Errorf("%v: expected replacement %v, got diff %v", c.raw, c.requiresNew, diff)
+		}
+	}
+}
+
+func TestMysqlInstanceCustomizeDiff(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(`{"Response":{"DataResult":{"Configs":[` +
+			`{"Cpu":1,"Memory":1000,"VolumeMin":25,"VolumeMax":2000,"DeviceType":"UNIVERSAL"},` +
+			`{"Cpu":2,"Memory":4000,"VolumeMin":25,"VolumeMax":3000,"DeviceType":"UNIVERSAL"}` +
+			`],"Regions":[]},"RequestId":"req-1"}}`))
+	}))
+	defer server.Close()
+	meta := &TencentCloudClient{apiV3Conn: &connectivity.TencentCloudClient{
+		Credential: common.NewCredential("id", "key"),
+		Region:     "ap-guangzhou",
+		Endpoints:  map[string]string{"cdb": server.URL},
+	}}
+
+	r := resourceTencentCloudMysqlInstance()
+	for _, c := range []struct {
+		cpu, memSize, volumeSize int
+		expected                 string
+	}{
+		{2, 4000, 200, ""},
+		{0, 4000, 200, ""},
+		{1, 4000, 200, "`cpu` 1 does not match `mem_size` 4000, the supported `cpu` are 2"},
+		{2, 3000, 200, "`mem_size` 3000 is not supported"},
+		{1, 1000, 2500, "`volume_size` 2500 is out of the range [25, 2000]"},
+	} {
+		raw := map[string]interface{}{
+			"instance_name":     "test",
+			"availability_zone": "ap-guangzhou-3",
+			"mem_size":          c.memSize,
+			"volume_size":       c.volumeSize,
+		}
+		if c.cpu != 0 {
+			raw["cpu"] = c.cpu
+		}
+		_, err := testCustomizeDiff(t, r, nil, raw, meta)
+		if c.expected == "" && err != nil {
+			t.Errorf("%v: unexpected error: %v", raw, err)
+		}
+		if c.expected != "" && (err == nil || !strings.Contains(err.Error(), c.expected)) {
+			t.Errorf("%v: expected error %q, got %v", raw, c.expected, err)
+		}
+	}
+
+	// the sell configs are not described when the spec is unchanged or unknown
+	calls = 0
+	state := &terraform.InstanceState{
+		ID: "cdb-test",
+		Attributes: map[string]string{
+			"id":                "cdb-test",
+			"instance_name":     "test",
+			"availability_zone": "ap-guangzhou-3",
+			"mem_size":          "4000",
+			"cpu":               "2",
+			"volume_size":       "200",
+			"device_type":       "UNIVERSAL",
+		},
+	}
+	raw := map[string]interface{}{
+		"instance_name":     "test",
+		"availability_zone": "ap-guangzhou-3",
+		"mem_size":          4000,
+		"volume_size":       200,
+	}
+	if _, err := testCustomizeDiff(t, r, state, raw, meta); err != nil || atomic.LoadInt32(&calls) != 0 {
+		t.Errorf("expected no call of the unchanged spec, got %d: %v", calls, err)
+	}
+	raw["mem_size"] = testUnknownValue
+	if _, err := testCustomizeDiff(t, r, nil, raw, meta); err != nil || atomic.LoadInt32(&calls) != 0 {
+		t.Errorf("expected no call of the unknown spec, got %d: %v", calls, err)
+	}
+	raw["mem_size"] = 4000
+	raw["volume_size"] = 300
+	if _, err := testCustomizeDiff(t, r, state, raw, meta); err != nil || atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("expected 1 call of the changed spec, got %d: %v", calls, err)
+	}
+}
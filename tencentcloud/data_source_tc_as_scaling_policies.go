@@ -112,6 +112,31 @@ func dataSourceTencentCloudAsScalingPolicies() *schema.Resource {
 							Description: "Users need to be notified when an alarm is triggered.",
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
+						"scaling_policy_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the scaling policy, `SIMPLE` or `TARGET_TRACKING`. The adjustment and alarm attributes are only for the `SIMPLE` policies, and the target attributes are only for the `TARGET_TRACKING` policies.",
+						},
+						"predefined_metric_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The metric tracked by the target tracking policy.",
+						},
+						"target_value": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The target value of the metric of the target tracking policy.",
+						},
+						"estimated_instance_warmup": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The warmup time of the new instances in second of the target tracking policy.",
+						},
+						"disable_scale_in": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the target tracking policy only scales out.",
+						},
 					},
 				},
 			},
@@ -151,16 +176,24 @@ func dataSourceTencentCloudAsScalingPolicyRead(d *schema.ResourceData, meta inte
 		mapping := map[string]interface{}{
 			"scaling_group_id":            *scalingPolicy.AutoScalingGroupId,
 			"policy_name":                 *scalingPolicy.ScalingPolicyName,
-			"adjustment_type":             *scalingPolicy.AdjustmentType,
-			"adjustment_value":            *scalingPolicy.AdjustmentValue,
-			"comparison_operator":         *scalingPolicy.MetricAlarm.ComparisonOperator,
-			"metric_name":                 *scalingPolicy.MetricAlarm.MetricName,
-			"threshold":                   *scalingPolicy.MetricAlarm.Threshold,
-			"period":                      *scalingPolicy.MetricAlarm.Period,
-			"continuous_time":             *scalingPolicy.MetricAlarm.ContinuousTime,
-			"statistic":                   *scalingPolicy.MetricAlarm.Statistic,
-			"cooldown":                    *scalingPolicy.Cooldown,
+			"adjustment_type":             helper.PString(scalingPolicy.AdjustmentType),
+			"adjustment_value":            helper.PInt64(scalingPolicy.AdjustmentValue),
+			"cooldown":                    helper.PUint64(scalingPolicy.Cooldown),
 			"notification_user_group_ids": helper.StringsInterfaces(scalingPolicy.NotificationUserGroupIds),
+			"scaling_policy_type":         helper.PString(scalingPolicy.ScalingPolicyType),
+			"predefined_metric_type":      helper.PString(scalingPolicy.PredefinedMetricType),
+			"target_value":                helper.PUint64(scalingPolicy.TargetValue),
+			"estimated_instance_warmup":   helper.PUint64(scalingPolicy.EstimatedInstanceWarmup),
+			"disable_scale_in":            scalingPolicy.DisableScaleIn != nil && *scalingPolicy.DisableScaleIn,
+		}
+		// the target tracking policies have no alarm of their own
+		if scalingPolicy.MetricAlarm != nil {
+			mapping["comparison_operator"] = helper.PString(scalingPolicy.MetricAlarm.ComparisonOperator)
+			mapping["metric_name"] = helper.PString(scalingPolicy.MetricAlarm.MetricName)
+			mapping["threshold"] = helper.PUint64(scalingPolicy.MetricAlarm.Threshold)
+			mapping["period"] = helper.PUint64(scalingPolicy.MetricAlarm.Period)
+			mapping["continuous_time"] = helper.PUint64(scalingPolicy.MetricAlarm.ContinuousTime)
+			mapping["statistic"] = helper.PString(scalingPolicy.MetricAlarm.Statistic)
 		}
 		scalingPolicyList = append(scalingPolicyList, mapping)
 	}
@@ -18,7 +18,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	audit "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cloudaudit/v20190319"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func dataSourceTencentCloudAudits() *schema.Resource {
@@ -86,7 +85,6 @@ func dataSourceTencentCloudAuditsRead(d *schema.ResourceData, meta interface{})
 
 	var response *audit.ListAuditsResponse
 	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := meta.(*TencentCloudClient).apiV3Conn.UseAuditClient().ListAudits(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s %s fail, reason:%s\n", logId, request.GetAction(), e.Error())
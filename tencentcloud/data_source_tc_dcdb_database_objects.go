@@ -6,8 +6,8 @@ Example Usage
 ```hcl
 data "tencentcloud_dcdb_database_objects" "database_objects" {
   instance_id = "dcdbt-ow7t8lmc"
-  db_name = &lt;nil&gt;
-        }
+  db_name = "tf_test_db"
+}
 ```
 */
 package tencentcloud
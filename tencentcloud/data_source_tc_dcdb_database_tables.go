@@ -6,14 +6,8 @@ Example Usage
 ```hcl
 data "tencentcloud_dcdb_database_tables" "database_tables" {
   instance_id = "dcdbt-ow7t8lmc"
-  db_name = &lt;nil&gt;
-  table = &lt;nil&gt;
-  table = &lt;nil&gt;
-  cols {
-		col = &lt;nil&gt;
-		type = &lt;nil&gt;
-
-  }
+  db_name = "tf_test_db"
+  table = "tf_test_table"
 }
 ```
 */
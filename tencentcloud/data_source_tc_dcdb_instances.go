@@ -11,7 +11,6 @@ data "tencentcloud_dcdb_instances" "instances1" {
   project_ids = [0]
   excluster_type = 0
   is_filter_excluster = true
-  excluster_type = 0
   is_filter_vpc = true
   vpc_id = "your_vpc_id"
   subnet_id = "your_subnet_id"
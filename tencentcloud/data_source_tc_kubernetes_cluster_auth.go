@@ -0,0 +1,286 @@
+/*
+Use this data source to get the endpoint of a kubernetes cluster and a short-lived token of a service account, which configure the kubernetes and helm providers directly.
+
+The token is issued through the TokenRequest API of kubernetes with the credential which TKE issues to the CAM identity
+of the provider, so the CAM identity needs the permission to create the tokens of the service account, which is granted
+by `acquire_cluster_admin_role` of `tencentcloud_kubernetes_cluster`. The permissions of the kubernetes and helm providers
+are the ones bound to the service account. The endpoint of the internet or the intranet must be enabled, by
+`cluster_internet` or `cluster_intranet` of `tencentcloud_kubernetes_cluster`, or by `tencentcloud_kubernetes_cluster_endpoint`.
+
+The token is issued again on every read, so it expires in `expiration_seconds` after each plan or apply.
+
+Example Usage
+
+```hcl
+data "tencentcloud_kubernetes_cluster_auth" "auth" {
+  cluster_id      = "cls-kzilgv5m"
+  is_extranet     = true
+  namespace       = "kube-system"
+  service_account = "terraform"
+}
+
+provider "kubernetes" {
+  host                   = data.tencentcloud_kubernetes_cluster_auth.auth.host
+  cluster_ca_certificate = data.tencentcloud_kubernetes_cluster_auth.auth.cluster_ca_certificate
+  token                  = data.tencentcloud_kubernetes_cluster_auth.auth.token
+}
+
+provider "helm" {
+  kubernetes {
+    host                   = data.tencentcloud_kubernetes_cluster_auth.auth.host
+    cluster_ca_certificate = data.tencentcloud_kubernetes_cluster_auth.auth.cluster_ca_certificate
+    token                  = data.tencentcloud_kubernetes_cluster_auth.auth.token
+  }
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v2"
+)
+
+func dataSourceTencentCloudKubernetesClusterAuth() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudKubernetesClusterAuthRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Required:    true,
+				Type:        schema.TypeString,
+				Description: "ID of the cluster.",
+			},
+			"is_extranet": {
+				Optional:    true,
+				Type:        schema.TypeBool,
+				Default:     false,
+				Description: "Whether to get the endpoint of the internet, the endpoint of the intranet is returned by default.",
+			},
+			"namespace": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Default:     "default",
+				Description: "Namespace of the service account.",
+			},
+			"service_account": {
+				Required:    true,
+				Type:        schema.TypeString,
+				Description: "Name of the service account whose token is issued.",
+			},
+			"expiration_seconds": {
+				Optional:     true,
+				Type:         schema.TypeInt,
+				Default:      3600,
+				ValidateFunc: validateIntegerMin(600),
+				Description:  "The seconds in which the token expires, the minimum is 600. The API server may issue a token of a shorter lifetime.",
+			},
+			"host": {
+				Computed:    true,
+				Type:        schema.TypeString,
+				Description: "The address of the kubernetes API server, like `https://cls-kzilgv5m.ccs.tencent-cloud.com`.",
+			},
+			"cluster_ca_certificate": {
+				Computed:    true,
+				Type:        schema.TypeString,
+				Description: "PEM-encoded CA certificate of the kubernetes API server.",
+			},
+			"username": {
+				Computed:    true,
+				Type:        schema.TypeString,
+				Description: "The user of the token in the cluster, like `system:serviceaccount:kube-system:terraform`.",
+			},
+			"token": {
+				Computed:    true,
+				Sensitive:   true,
+				Type:        schema.TypeString,
+				Description: "The bearer token of the service account.",
+			},
+			"expiration_time": {
+				Computed:    true,
+				Type:        schema.TypeString,
+				Description: "The expiration time of the token in RFC3339.",
+			},
+			"result_output_file": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Description: "Used to save results, the token is not saved.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudKubernetesClusterAuthRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_kubernetes_cluster_auth.read")()
+	defer inconsistentCheck(d, meta)()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	var (
+		clusterId         = d.Get("cluster_id").(string)
+		isExtranet        = d.Get("is_extranet").(bool)
+		namespace         = d.Get("namespace").(string)
+		serviceAccount    = d.Get("service_account").(string)
+		expirationSeconds = d.Get("expiration_seconds").(int)
+		kubeconfig        string
+	)
+
+	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		config, e := service.DescribeClusterConfig(ctx, clusterId, isExtranet)
+		if e != nil {
+			return retryError(e)
+		}
+		kubeconfig = config
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	endpoint := "intranet"
+	if isExtranet {
+		endpoint = "internet"
+	}
+	// the kubeconfig is empty if the endpoint is not enabled
+	if kubeconfig == "" {
+		return fmt.Errorf("the %s endpoint of cluster %s is not enabled", endpoint, clusterId)
+	}
+	auth, err := parseKubeconfigAuth(kubeconfig)
+	if err != nil {
+		log.Printf("[CRITAL]%s parse kubeconfig of cluster [%s] failed, reason:%+v", logId, clusterId, err)
+		return fmt.Errorf("parse kubeconfig of cluster %s failed: %v", clusterId, err)
+	}
+	if auth.host == "" {
+		return fmt.Errorf("the %s endpoint of cluster %s is not enabled", endpoint, clusterId)
+	}
+
+	// the credential of the kubeconfig lives long, it only issues the token and is never returned
+	client, err := service.newKubernetesClient(auth)
+	if err != nil {
+		return err
+	}
+	token, expirationTime, err := client.createServiceAccountToken(namespace, serviceAccount, expirationSeconds)
+	if err != nil {
+		log.Printf("[CRITAL]%s issue token of service account [%s/%s] failed, reason:%+v", logId, namespace, serviceAccount, err)
+		return fmt.Errorf("issue token of service account %s/%s of cluster %s failed: %v", namespace, serviceAccount, clusterId, err)
+	}
+	username := fmt.Sprintf("system:serviceaccount:%s:%s", namespace, serviceAccount)
+
+	_ = d.Set("host", auth.host)
+	_ = d.Set("cluster_ca_certificate", auth.clusterCaCertificate)
+	_ = d.Set("username", username)
+	_ = d.Set("token", token)
+	_ = d.Set("expiration_time", expirationTime)
+
+	d.SetId(clusterId + FILED_SP + namespace + FILED_SP + serviceAccount)
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		result := map[string]interface{}{
+			"host":                   auth.host,
+			"cluster_ca_certificate": auth.clusterCaCertificate,
+			"username":               username,
+			"expiration_time":        expirationTime,
+		}
+		if e := writeToFile(output.(string), result); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// kubeconfigAuth is the endpoint and the credential of the current context of a kubeconfig, the credential is only
+// used to call the API server by the provider
+type kubeconfigAuth struct {
+	host                 string
+	clusterCaCertificate string
+	username             string
+	token                string
+	clientCertificate    string
+	clientKey            string
+}
+
+type kubeconfig struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			Token                 string `yaml:"token"`
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+// parseKubeconfigAuth returns the endpoint and the credential of the current context, or the first one if the
+// current context is not set
+func parseKubeconfigAuth(content string) (*kubeconfigAuth, error) {
+	var config kubeconfig
+	if err := yaml.Unmarshal([]byte(content), &config); err != nil {
+		return nil, err
+	}
+	if len(config.Contexts) == 0 {
+		return nil, fmt.Errorf("no context in the kubeconfig")
+	}
+
+	current := config.Contexts[0].Context
+	for _, c := range config.Contexts {
+		if c.Name == config.CurrentContext {
+			current = c.Context
+		}
+	}
+
+	auth := &kubeconfigAuth{username: current.User}
+	for _, c := range config.Clusters {
+		if c.Name != current.Cluster {
+			continue
+		}
+		ca, err := base64.StdEncoding.DecodeString(c.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return nil, fmt.Errorf("decode certificate-authority-data of cluster %s failed: %v", c.Name, err)
+		}
+		auth.host = c.Cluster.Server
+		auth.clusterCaCertificate = string(ca)
+	}
+	for _, u := range config.Users {
+		if u.Name != current.User {
+			continue
+		}
+		cert, err := base64.StdEncoding.DecodeString(u.User.ClientCertificateData)
+		if err != nil {
+			return nil, fmt.Errorf("decode client-certificate-data of user %s failed: %v", u.Name, err)
+		}
+		key, err := base64.StdEncoding.DecodeString(u.User.ClientKeyData)
+		if err != nil {
+			return nil, fmt.Errorf("decode client-key-data of user %s failed: %v", u.Name, err)
+		}
+		auth.token = u.User.Token
+		auth.clientCertificate = string(cert)
+		auth.clientKey = string(key)
+	}
+	if auth.token == "" && auth.clientCertificate == "" {
+		return nil, fmt.Errorf("no credential of user %s in the kubeconfig", current.User)
+	}
+	return auth, nil
+}
@@ -0,0 +1,210 @@
+package tencentcloud
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/mockapi"
+)
+
+func TestAccTencentCloudKubernetesClusterAuthDataSource_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesClusterAuthDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_kubernetes_cluster_auth.auth"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_kubernetes_cluster_auth.auth", "host"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_kubernetes_cluster_auth.auth", "cluster_ca_certificate"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_kubernetes_cluster_auth.auth", "token"),
+				),
+			},
+		},
+	})
+}
+
+const testAccKubernetesClusterAuthDataSource = `
+data "tencentcloud_kubernetes_cluster_auth" "auth" {
+  cluster_id      = "` + defaultTkeClusterId + `"
+  is_extranet     = true
+  namespace       = "kube-system"
+  service_account = "default"
+}
+`
+
+func TestParseKubeconfigAuth(t *testing.T) {
+	cert, key := testKubeconfigCertificate(t)
+
+	auth, err := parseKubeconfigAuth(testKubeconfig("https://cls-1.ccs.tencent-cloud.com", "", cert, key))
+	if err != nil {
+		t.Fatalf("parse kubeconfig failed: %v", err)
+	}
+	if auth.host != "https://cls-1.ccs.tencent-cloud.com" || auth.clusterCaCertificate != "ca" || auth.username != "100000000001" {
+		t.Errorf("unexpected endpoint %+v", auth)
+	}
+	if auth.clientCertificate != cert || auth.clientKey != key || auth.token != "" {
+		t.Errorf("unexpected credential %+v", auth)
+	}
+
+	auth, err = parseKubeconfigAuth(testKubeconfig("https://cls-1.ccs.tencent-cloud.com", "token-1", "", ""))
+	if err != nil {
+		t.Fatalf("parse kubeconfig failed: %v", err)
+	}
+	if auth.token != "token-1" || auth.clientCertificate != "" {
+		t.Errorf("unexpected credential %+v", auth)
+	}
+
+	for _, invalid := range []string{
+		"",
+		"kind: Config",
+		testKubeconfig("https://cls-1.ccs.tencent-cloud.com", "", "", ""),
+	} {
+		if _, err := parseKubeconfigAuth(invalid); err == nil {
+			t.Errorf("expected error of kubeconfig %q", invalid)
+		}
+	}
+}
+
+func TestKubernetesClusterAuthDataSourceRead(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	meta := testMockapiMeta(server)
+	meta.apiV3Conn.Endpoints["tke"] = server.URL
+
+	kubernetes := newTestKubernetesAPI()
+	defer kubernetes.server.Close()
+	// trust the certificate of the fake API server
+	meta.apiV3Conn.HTTPTransport = kubernetes.server.Client().Transport
+	kubernetes.addServiceAccount("kube-system", "terraform")
+
+	server.Handle("tke", "DescribeClusterKubeconfig", func(request *mockapi.Request) (interface{}, error) {
+		var params tke.DescribeClusterKubeconfigRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		if params.IsExtranet == nil || !*params.IsExtranet {
+			// the endpoint of the intranet is not enabled, so the kubeconfig is empty
+			return map[string]interface{}{"Kubeconfig": ""}, nil
+		}
+		return map[string]interface{}{"Kubeconfig": kubernetes.kubeconfig()}, nil
+	})
+
+	r := Provider().DataSourcesMap["tencentcloud_kubernetes_cluster_auth"]
+	d := r.TestResourceData()
+	_ = d.Set("cluster_id", "cls-1")
+	_ = d.Set("is_extranet", true)
+	_ = d.Set("namespace", "kube-system")
+	_ = d.Set("service_account", "terraform")
+	_ = d.Set("expiration_seconds", 1800)
+	if diags := r.ReadWithoutTimeout(context.TODO(), d, meta); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+	if d.Id() != "cls-1"+FILED_SP+"kube-system"+FILED_SP+"terraform" || d.Get("host") != kubernetes.server.URL {
+		t.Errorf("unexpected state %v", d.State())
+	}
+	// the token of the kubeconfig is never returned
+	if d.Get("token") != "sa-token-terraform" || d.Get("username") != "system:serviceaccount:kube-system:terraform" ||
+		d.Get("expiration_time") != "2026-01-01T01:00:00Z" {
+		t.Errorf("unexpected token %v", d.State())
+	}
+	if seconds := kubernetes.serviceAccounts["kube-system/terraform"]; seconds != 1800 {
+		t.Errorf("expected the token expires in 1800 seconds, got %d", seconds)
+	}
+
+	d = r.TestResourceData()
+	_ = d.Set("cluster_id", "cls-1")
+	_ = d.Set("is_extranet", true)
+	_ = d.Set("service_account", "missing")
+	if diags := r.ReadWithoutTimeout(context.TODO(), d, meta); !diags.HasError() ||
+		!strings.Contains(diags[0].Summary, `serviceaccounts "missing" not found`) {
+		t.Errorf("expected error of the missing service account, got %v", diags)
+	}
+
+	d = r.TestResourceData()
+	_ = d.Set("cluster_id", "cls-1")
+	_ = d.Set("service_account", "terraform")
+	if diags := r.ReadWithoutTimeout(context.TODO(), d, meta); !diags.HasError() ||
+		!strings.Contains(diags[0].Summary, "intranet endpoint of cluster cls-1 is not enabled") {
+		t.Errorf("expected error of the intranet endpoint, got %v", diags)
+	}
+}
+
+// testKubeconfigCertificate returns a PEM-encoded self-signed client certificate and its key
+func testKubeconfigCertificate(t *testing.T) (string, string) {
+	t.Helper()
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key failed: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "100000000001"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatalf("create certificate failed: %v", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("marshal key failed: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+// testKubeconfig returns a kubeconfig like the one of TKE, whose current context is not the first one
+func testKubeconfig(server, token, cert, key string) string {
+	encode := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+	return fmt.Sprintf(`apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: %s
+    server: https://other.ccs.tencent-cloud.com
+  name: other
+- cluster:
+    certificate-authority-data: %s
+    server: %q
+  name: cls-1
+contexts:
+- context:
+    cluster: other
+    user: other
+  name: other-context
+- context:
+    cluster: cls-1
+    user: "100000000001"
+  name: cls-1-100000000001-context-default
+current-context: cls-1-100000000001-context-default
+kind: Config
+preferences: {}
+users:
+- name: other
+  user:
+    token: other-token
+- name: "100000000001"
+  user:
+    token: %q
+    client-certificate-data: %s
+    client-key-data: %s
+`, encode("other-ca"), encode("ca"), server, token, encode(cert), encode(key))
+}
@@ -50,7 +50,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func dataSourceTencentMonitorData() *schema.Resource {
@@ -159,7 +158,6 @@ func dataSourceTencentMonitorDataRead(d *schema.ResourceData, meta interface{})
 	}
 
 	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if response, err = monitorService.client.UseMonitorClient().GetMonitorData(request); err != nil {
 			return retryError(err, InternalError)
 		}
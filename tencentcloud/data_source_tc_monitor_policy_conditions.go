@@ -20,7 +20,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func dataSourceTencentMonitorPolicyConditions() *schema.Resource {
@@ -259,7 +258,6 @@ func dataSourceTencentMonitorPolicyConditionRead(d *schema.ResourceData, meta in
 	request.Module = helper.String("monitor")
 
 	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if response, err = monitorService.client.UseMonitorClient().DescribePolicyConditionList(request); err != nil {
 			return retryError(err, InternalError)
 		}
@@ -20,7 +20,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func dataSourceTencentMonitorProductNamespace() *schema.Resource {
@@ -92,7 +91,6 @@ func dataSourceTencentMonitorProductNamespaceRead(d *schema.ResourceData, meta i
 		}
 
 		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			if response, err = monitorService.client.UseMonitorClient().DescribeProductList(request); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -6,7 +6,7 @@ Example Usage
 ```hcl
 data "tencentcloud_tcmq_subscribe" "subscribe" {
   topic_name = "topic_name"
-  subscription_name = "subscription_name";
+  subscription_name = "subscription_name"
 }
 ```
 */
@@ -7,7 +7,7 @@ Example Usage
 data "tencentcloud_tsf_application_config" "application_config" {
   application_id = "app-123456"
   config_id = "config-123456"
-  config_id_list =
+  config_id_list = ["config-123456"]
   config_name = "test-config"
   config_version = "1.0"
 }
@@ -19,7 +19,6 @@ import (
 
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -75,7 +74,6 @@ func datasourceTencentCloudUserInfoRead(d *schema.ResourceData, meta interface{}
 	logId = getLogId(ctx)
 	request := cam.NewGetUserAppIdRequest()
 
-	ratelimit.Check(request.GetAction())
 	response, err := client.UseCamClient().GetUserAppId(request)
 
 	if err != nil {
@@ -130,6 +130,27 @@ var SCALING_GROUP_STATISTIC = []string{
 	SCALING_GROUP_STATISTIC_MINIMUM,
 }
 
+const (
+	SCALING_POLICY_TYPE_SIMPLE          = "SIMPLE"
+	SCALING_POLICY_TYPE_TARGET_TRACKING = "TARGET_TRACKING"
+)
+
+const (
+	SCALING_POLICY_PREDEFINED_METRIC_TYPE_CPU_UTILIZATION = "ASG_AVG_CPU_UTILIZATION"
+	SCALING_POLICY_PREDEFINED_METRIC_TYPE_LAN_TRAFFIC_OUT = "ASG_AVG_LAN_TRAFFIC_OUT"
+	SCALING_POLICY_PREDEFINED_METRIC_TYPE_LAN_TRAFFIC_IN  = "ASG_AVG_LAN_TRAFFIC_IN"
+	SCALING_POLICY_PREDEFINED_METRIC_TYPE_WAN_TRAFFIC_OUT = "ASG_AVG_WAN_TRAFFIC_OUT"
+	SCALING_POLICY_PREDEFINED_METRIC_TYPE_WAN_TRAFFIC_IN  = "ASG_AVG_WAN_TRAFFIC_IN"
+)
+
+var SCALING_POLICY_PREDEFINED_METRIC_TYPE = []string{
+	SCALING_POLICY_PREDEFINED_METRIC_TYPE_CPU_UTILIZATION,
+	SCALING_POLICY_PREDEFINED_METRIC_TYPE_LAN_TRAFFIC_OUT,
+	SCALING_POLICY_PREDEFINED_METRIC_TYPE_LAN_TRAFFIC_IN,
+	SCALING_POLICY_PREDEFINED_METRIC_TYPE_WAN_TRAFFIC_OUT,
+	SCALING_POLICY_PREDEFINED_METRIC_TYPE_WAN_TRAFFIC_IN,
+}
+
 const (
 	SCALING_GROUP_NOTIFICATION_TYPE_SCALE_OUT_SUCCESS = "SCALE_OUT_SUCCESSFUL"
 	SCALING_GROUP_NOTIFICATION_TYPE_SCALE_OUT_FAILED  = "SCALE_OUT_FAILED"
@@ -166,6 +187,19 @@ const (
 	SCALING_GROUP_NOT_IN_ACTIVITY_STATUS = "NOT_IN_ACTIVITY"
 )
 
+const (
+	SCALING_GROUP_INSTANCE_CREATION_TYPE_AUTO      = "AUTO_CREATION"
+	SCALING_GROUP_INSTANCE_STATE_IN_SERVICE        = "IN_SERVICE"
+	SCALING_GROUP_INSTANCE_STATE_CREATION_FAILED   = "CREATION_FAILED"
+	SCALING_GROUP_INSTANCE_REFRESH_LAUNCH_FIRST    = "LAUNCH_BEFORE_TERMINATE"
+	SCALING_GROUP_INSTANCE_REFRESH_TERMINATE_FIRST = "TERMINATE_BEFORE_LAUNCH"
+)
+
+var SCALING_GROUP_INSTANCE_REFRESH_STRATEGIES = []string{
+	SCALING_GROUP_INSTANCE_REFRESH_LAUNCH_FIRST,
+	SCALING_GROUP_INSTANCE_REFRESH_TERMINATE_FIRST,
+}
+
 const (
 	MultiZoneSubnetPolicyPriority = "PRIORITY"
 	MultiZoneSubnetPolicyEquality = "EQUALITY"
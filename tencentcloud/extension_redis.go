@@ -10,8 +10,23 @@ const (
 	REDIS_VERSION_CLUSTER_REDIS       = 7
 	REDIS_VERSION_MASTER_SLAVE_REDIS5 = 8
 	REDIS_VERSION_CLUSTER_REDIS5      = 9
+	REDIS_VERSION_MASTER_SLAVE_REDIS6 = 15
+	REDIS_VERSION_CLUSTER_REDIS6      = 16
+	REDIS_VERSION_MASTER_SLAVE_REDIS7 = 17
+	REDIS_VERSION_CLUSTER_REDIS7      = 18
 )
 
+// REDIS_NON_CLUSTER_TYPE_IDS are the types which have only one shard
+var REDIS_NON_CLUSTER_TYPE_IDS = []int64{
+	REDIS_VERSION_MASTER_SLAVE_REDIS,
+	REDIS_VERSION_MASTER_SLAVE_CKV,
+	REDIS_VERSION_STANDALONE_REDIS,
+	REDIS_VERSION_MASTER_SLAVE_REDIS4,
+	REDIS_VERSION_MASTER_SLAVE_REDIS5,
+	REDIS_VERSION_MASTER_SLAVE_REDIS6,
+	REDIS_VERSION_MASTER_SLAVE_REDIS7,
+}
+
 var REDIS_NAMES = map[int64]string{
 	REDIS_VERSION_MASTER_SLAVE_REDIS:  "master_slave_redis",
 	REDIS_VERSION_MASTER_SLAVE_CKV:    "master_slave_ckv",
@@ -56,6 +56,13 @@ const (
 	TKE_ROLE_WORKER      = "WORKER"
 )
 
+const (
+	TKE_NODE_UPGRADE_TYPE_IN_PLACE = "in_place"
+	TKE_NODE_UPGRADE_TYPE_RESET    = "reset"
+)
+
+var TKE_NODE_UPGRADE_TYPES = []string{TKE_NODE_UPGRADE_TYPE_IN_PLACE, TKE_NODE_UPGRADE_TYPE_RESET}
+
 var TKE_INSTANCE_CHARGE_TYPE = []string{CVM_CHARGE_TYPE_PREPAID, CVM_CHARGE_TYPE_POSTPAID}
 
 const (
@@ -1,6 +1,12 @@
 package helper
 
-import "strings"
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 const connect = "#"
 
@@ -11,3 +17,97 @@ func IdFormat(s ...string) string {
 func IdParse(s string) []string {
 	return strings.Split(s, connect)
 }
+
+// IdSchema declares the parts of the composite id of a resource, like `<clb_id>#<listener_id>#<rule_id>`,
+// the id is formatted and parsed by it, and documented as its String
+type IdSchema struct {
+	parts     []string
+	separator string
+	// optional is the number of the trailing parts which can be omitted
+	optional int
+}
+
+// NewIdSchema returns the schema of the ids of the parts joined by `#`, like IdFormat does
+func NewIdSchema(parts ...string) *IdSchema {
+	return &IdSchema{parts: parts, separator: connect}
+}
+
+// WithSeparator returns the schema of the ids of the same parts joined by separator
+func (me *IdSchema) WithSeparator(separator string) *IdSchema {
+	return &IdSchema{parts: me.parts, separator: separator, optional: me.optional}
+}
+
+// WithOptional returns the schema of the ids with the trailing parts which can be omitted,
+// like `<mysql_id>#<account_name>[#<host>]`
+func (me *IdSchema) WithOptional(parts ...string) *IdSchema {
+	return &IdSchema{
+		parts:     append(append([]string(nil), me.parts...), parts...),
+		separator: me.separator,
+		optional:  me.optional + len(parts),
+	}
+}
+
+// Parts returns the names of the parts
+func (me *IdSchema) Parts() []string {
+	return append([]string(nil), me.parts...)
+}
+
+// String returns the format of the ids, like `<clb_id>#<listener_id>`
+func (me *IdSchema) String() string {
+	required := len(me.parts) - me.optional
+	parts := make([]string, 0, required)
+	for _, part := range me.parts[:required] {
+		parts = append(parts, "<"+part+">")
+	}
+	format := strings.Join(parts, me.separator)
+	for _, part := range me.parts[required:] {
+		format += "[" + me.separator + "<" + part + ">"
+	}
+	return format + strings.Repeat("]", me.optional)
+}
+
+// Format joins the values of the parts into the id
+func (me *IdSchema) Format(values ...string) string {
+	return strings.Join(values, me.separator)
+}
+
+// Parse returns the values of the parts of id, which must have all the parts and no empty one,
+// the omitted optional parts are returned as empty
+func (me *IdSchema) Parse(id string) ([]string, error) {
+	values := strings.Split(id, me.separator)
+	if len(values) < len(me.parts)-me.optional || len(values) > len(me.parts) {
+		return nil, fmt.Errorf("invalid id `%s`, expected `%s`", id, me)
+	}
+	for i, value := range values {
+		if value == "" {
+			return nil, fmt.Errorf("invalid id `%s`, %s is empty, expected `%s`", id, me.parts[i], me)
+		}
+	}
+	return append(values, make([]string, len(me.parts)-len(values))...), nil
+}
+
+// ParseOrLast parses id like Parse, but also accepts the legacy id of the last part only, whose other parts are
+// returned as empty. It is for the resources whose ids were the ids of the last part before they were composite.
+func (me *IdSchema) ParseOrLast(id string) ([]string, error) {
+	if id != "" && me.optional == 0 && len(me.parts) > 1 && !strings.Contains(id, me.separator) {
+		values := make([]string, len(me.parts))
+		values[len(values)-1] = id
+		return values, nil
+	}
+	return me.Parse(id)
+}
+
+// Importer returns the importer which checks the import id before next, a nil next passes the id through
+func (me *IdSchema) Importer(next schema.StateFunc) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if _, err := me.Parse(d.Id()); err != nil {
+				return nil, err
+			}
+			if next == nil {
+				return []*schema.ResourceData{d}, nil
+			}
+			return next(d, meta)
+		},
+	}
+}
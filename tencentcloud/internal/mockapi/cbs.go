@@ -0,0 +1,306 @@
+package mockapi
+
+import (
+	"strconv"
+
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+)
+
+const (
+	diskStateUnattached = "UNATTACHED"
+	diskStateAttached   = "ATTACHED"
+	diskStateToRecycle  = "TORECYCLE"
+)
+
+// cbsFake is the stateful fake of the cloud disks, the terminated disks are kept in the recycle bin until they are
+// terminated again
+type cbsFake struct {
+	server  *Server
+	disks   map[string]*cbs.Disk
+	regions map[string]string
+}
+
+func registerCbs(s *Server) {
+	me := &cbsFake{
+		server:  s,
+		disks:   make(map[string]*cbs.Disk),
+		regions: make(map[string]string),
+	}
+	s.cbs = me
+	s.Handle("cbs", "CreateDisks", me.createDisks)
+	s.Handle("cbs", "DescribeDisks", me.describeDisks)
+	s.Handle("cbs", "ModifyDiskAttributes", me.modifyDiskAttributes)
+	s.Handle("cbs", "ResizeDisk", me.resizeDisk)
+	s.Handle("cbs", "AttachDisks", me.attachDisks)
+	s.Handle("cbs", "DetachDisks", me.detachDisks)
+	s.Handle("cbs", "TerminateDisks", me.terminateDisks)
+}
+
+// newDisk adds a disk, it is also called by the CVM fake for the disks of the instances
+func (me *cbsFake) newDisk(region string, disk *cbs.Disk) string {
+	id := me.server.NewId("disk")
+	disk.DiskId = stringPtr(id)
+	disk.CreateTime = stringPtr(now())
+	if disk.DiskState == nil {
+		disk.DiskState = stringPtr(diskStateUnattached)
+	}
+	if disk.DiskChargeType == nil {
+		disk.DiskChargeType = stringPtr("POSTPAID_BY_HOUR")
+	}
+	defaultString(&disk.DiskName, "")
+	defaultString(&disk.DiskUsage, "DATA_DISK")
+	defaultString(&disk.RenewFlag, "NOTIFY_AND_MANUAL_RENEW")
+	defaultString(&disk.AttachMode, "")
+	defaultString(&disk.InstanceId, "")
+	defaultString(&disk.DiskType, "CLOUD_PREMIUM")
+	defaultString(&disk.DeadlineTime, "")
+	if disk.Placement.ProjectId == nil {
+		disk.Placement.ProjectId = uint64Ptr(0)
+	}
+	if disk.ThroughputPerformance == nil {
+		disk.ThroughputPerformance = uint64Ptr(0)
+	}
+	if disk.Encrypt == nil {
+		disk.Encrypt = boolPtr(false)
+	}
+	if disk.DeleteWithInstance == nil {
+		disk.DeleteWithInstance = boolPtr(false)
+	}
+	disk.Attached = boolPtr(stringValue(disk.InstanceId) != "")
+	disk.Portable = boolPtr(stringValue(disk.DiskUsage) == "DATA_DISK")
+	disk.Shareable = boolPtr(false)
+	disk.InstanceIdList = []*string{}
+	disk.AutoSnapshotPolicyIds = []*string{}
+	disk.DiskBackupQuota = uint64Ptr(0)
+	disk.DiskBackupCount = uint64Ptr(0)
+	me.disks[id] = disk
+	me.regions[id] = region
+	return id
+}
+
+func (me *cbsFake) createDisks(request *Request) (interface{}, error) {
+	params := cbs.NewCreateDisksRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	if params.Placement == nil || stringValue(params.Placement.Zone) == "" {
+		return nil, NewError("MissingParameter", "the Placement.Zone is required")
+	}
+	if params.DiskSize == nil && params.SnapshotId == nil {
+		return nil, NewError("MissingParameter", "the DiskSize is required")
+	}
+	count := 1
+	if params.DiskCount != nil {
+		count = int(*params.DiskCount)
+	}
+	ids := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		placement := *params.Placement
+		id := me.newDisk(request.Region, &cbs.Disk{
+			DiskName:              params.DiskName,
+			DiskType:              params.DiskType,
+			DiskSize:              params.DiskSize,
+			DiskChargeType:        params.DiskChargeType,
+			Placement:             &placement,
+			Encrypt:               boolPtr(stringValue(params.Encrypt) == "ENCRYPT"),
+			ThroughputPerformance: params.ThroughputPerformance,
+		})
+		if params.DiskBackupQuota != nil {
+			me.disks[id].DiskBackupQuota = params.DiskBackupQuota
+		}
+		tags := make(map[string]string, len(params.Tags))
+		for _, t := range params.Tags {
+			tags[stringValue(t.Key)] = stringValue(t.Value)
+		}
+		me.server.setResourceTags("cvm", request.Region, "volume", id, tags)
+		ids = append(ids, id)
+	}
+	return map[string]interface{}{"DiskIdSet": ids}, nil
+}
+
+func (me *cbsFake) describeDisks(request *Request) (interface{}, error) {
+	params := cbs.NewDescribeDisksRequest()
+	var f filters
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	if err := request.Bind(&f); err != nil {
+		return nil, err
+	}
+
+	ids := stringValues(params.DiskIds)
+	result := make([]*cbs.Disk, 0)
+	for _, id := range sortedIds(mapKeys(me.disks)) {
+		disk := me.disks[id]
+		if me.regions[id] != request.Region || (len(ids) > 0 && !containsAny([]string{id}, ids)) {
+			continue
+		}
+		fields := map[string][]string{
+			"disk-id":          {id},
+			"disk-name":        {stringValue(disk.DiskName)},
+			"disk-state":       {stringValue(disk.DiskState)},
+			"disk-usage":       {stringValue(disk.DiskUsage)},
+			"disk-type":        {stringValue(disk.DiskType)},
+			"disk-charge-type": {stringValue(disk.DiskChargeType)},
+			"instance-id":      {stringValue(disk.InstanceId)},
+			"zone":             {stringValue(disk.Placement.Zone)},
+			"project-id":       {strconv.FormatUint(*disk.Placement.ProjectId, 10)},
+			"portable":         {boolString(*disk.Portable)},
+		}
+		if !f.match(fields, me.server.resourceTags("cvm", request.Region, "volume", id)) {
+			continue
+		}
+		result = append(result, me.diskWithTags(disk))
+	}
+	start, end := page(len(result), params.Offset, params.Limit)
+	return map[string]interface{}{"TotalCount": len(result), "DiskSet": result[start:end]}, nil
+}
+
+func (me *cbsFake) diskWithTags(disk *cbs.Disk) *cbs.Disk {
+	result := *disk
+	tags := me.server.resourceTags("cvm", me.regions[*disk.DiskId], "volume", *disk.DiskId)
+	result.Tags = make([]*cbs.Tag, 0, len(tags))
+	for _, k := range sortedIds(mapKeys(tags)) {
+		result.Tags = append(result.Tags, &cbs.Tag{Key: stringPtr(k), Value: stringPtr(tags[k])})
+	}
+	return &result
+}
+
+// disk returns the disk of id, which is not in the recycle bin
+func (me *cbsFake) disk(id string) (*cbs.Disk, error) {
+	disk, ok := me.disks[id]
+	if !ok {
+		return nil, NewError("InvalidDiskId.NotFound", "the disk %s is not found", id)
+	}
+	if stringValue(disk.DiskState) == diskStateToRecycle {
+		return nil, NewError("InvalidDiskState", "the disk %s is in the recycle bin", id)
+	}
+	return disk, nil
+}
+
+func (me *cbsFake) modifyDiskAttributes(request *Request) (interface{}, error) {
+	params := cbs.NewModifyDiskAttributesRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	for _, id := range stringValues(params.DiskIds) {
+		disk, err := me.disk(id)
+		if err != nil {
+			return nil, err
+		}
+		if params.DiskName != nil {
+			disk.DiskName = params.DiskName
+		}
+		if params.ProjectId != nil {
+			disk.Placement.ProjectId = params.ProjectId
+		}
+		if params.DeleteWithInstance != nil {
+			disk.DeleteWithInstance = params.DeleteWithInstance
+		}
+	}
+	return struct{}{}, nil
+}
+
+func (me *cbsFake) resizeDisk(request *Request) (interface{}, error) {
+	params := cbs.NewResizeDiskRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	disk, err := me.disk(stringValue(params.DiskId))
+	if err != nil {
+		return nil, err
+	}
+	if params.DiskSize == nil || *params.DiskSize <= *disk.DiskSize {
+		return nil, NewError("InvalidParameterValue", "the disk size can only be increased")
+	}
+	disk.DiskSize = params.DiskSize
+	return struct{}{}, nil
+}
+
+func (me *cbsFake) attachDisks(request *Request) (interface{}, error) {
+	params := cbs.NewAttachDisksRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	instanceId := stringValue(params.InstanceId)
+	if _, ok := me.server.cvm.instances[instanceId]; !ok {
+		return nil, NewError("InvalidInstanceId.NotFound", "the instance %s is not found", instanceId)
+	}
+	for _, id := range stringValues(params.DiskIds) {
+		disk, err := me.disk(id)
+		if err != nil {
+			return nil, err
+		}
+		if *disk.Attached {
+			return nil, NewError("InvalidDisk.Busy", "the disk %s is attached to %s", id, stringValue(disk.InstanceId))
+		}
+	}
+	for _, id := range stringValues(params.DiskIds) {
+		disk := me.disks[id]
+		disk.InstanceId = stringPtr(instanceId)
+		disk.Attached = boolPtr(true)
+		disk.DiskState = stringPtr(diskStateAttached)
+		if params.DeleteWithInstance != nil {
+			disk.DeleteWithInstance = params.DeleteWithInstance
+		}
+	}
+	return struct{}{}, nil
+}
+
+func (me *cbsFake) detachDisks(request *Request) (interface{}, error) {
+	params := cbs.NewDetachDisksRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	for _, id := range stringValues(params.DiskIds) {
+		disk, err := me.disk(id)
+		if err != nil {
+			return nil, err
+		}
+		if !*disk.Attached {
+			return nil, NewError("InvalidDisk.NotAttached", "the disk %s is not attached", id)
+		}
+	}
+	for _, id := range stringValues(params.DiskIds) {
+		me.detach(me.disks[id])
+	}
+	return struct{}{}, nil
+}
+
+func (me *cbsFake) detach(disk *cbs.Disk) {
+	disk.InstanceId = stringPtr("")
+	disk.Attached = boolPtr(false)
+	disk.DiskState = stringPtr(diskStateUnattached)
+}
+
+func (me *cbsFake) terminateDisks(request *Request) (interface{}, error) {
+	params := cbs.NewTerminateDisksRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	for _, id := range stringValues(params.DiskIds) {
+		disk, ok := me.disks[id]
+		if !ok {
+			return nil, NewError("InvalidDiskId.NotFound", "the disk %s is not found", id)
+		}
+		if *disk.Attached {
+			return nil, NewError("InvalidDisk.Busy", "the disk %s is attached to %s", id, stringValue(disk.InstanceId))
+		}
+	}
+	for _, id := range stringValues(params.DiskIds) {
+		me.terminate(id)
+	}
+	return struct{}{}, nil
+}
+
+// terminate moves the disk to the recycle bin, or deletes it if it is already there
+func (me *cbsFake) terminate(id string) {
+	disk := me.disks[id]
+	if stringValue(disk.DiskState) != diskStateToRecycle {
+		disk.DiskState = stringPtr(diskStateToRecycle)
+		return
+	}
+	delete(me.disks, id)
+	me.server.setResourceTags("cvm", me.regions[id], "volume", id, nil)
+	delete(me.regions, id)
+}
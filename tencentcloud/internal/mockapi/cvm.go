@@ -0,0 +1,528 @@
+package mockapi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strconv"
+
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+)
+
+const (
+	instanceStateRunning  = "RUNNING"
+	instanceStateStopped  = "STOPPED"
+	instanceStateShutdown = "SHUTDOWN"
+)
+
+// publicImages are the images returned by DescribeImages, the instances can be launched with any image id
+var publicImages = []*cvm.Image{
+	{
+		ImageId:      stringPtr("img-mockapi0"),
+		OsName:       stringPtr("TencentOS Server 3.1 (TK4)"),
+		ImageType:    stringPtr("PUBLIC_IMAGE"),
+		ImageName:    stringPtr("TencentOS Server 3.1 (TK4)"),
+		ImageSize:    int64Ptr(50),
+		Architecture: stringPtr("x86_64"),
+		ImageState:   stringPtr("NORMAL"),
+		Platform:     stringPtr("TencentOS"),
+	},
+	{
+		ImageId:      stringPtr("img-mockapi1"),
+		OsName:       stringPtr("Ubuntu Server 22.04 LTS 64bit"),
+		ImageType:    stringPtr("PUBLIC_IMAGE"),
+		ImageName:    stringPtr("Ubuntu Server 22.04 LTS 64bit"),
+		ImageSize:    int64Ptr(20),
+		Architecture: stringPtr("x86_64"),
+		ImageState:   stringPtr("NORMAL"),
+		Platform:     stringPtr("Ubuntu"),
+	},
+}
+
+// cvmFake is the stateful fake of the instances, the operations finish immediately. The terminated prepaid
+// instances are kept in the recycle bin until they are terminated again.
+type cvmFake struct {
+	server    *Server
+	instances map[string]*cvm.Instance
+	regions   map[string]string
+	addresses map[string]uint32
+}
+
+func registerCvm(s *Server) {
+	me := &cvmFake{
+		server:    s,
+		instances: make(map[string]*cvm.Instance),
+		regions:   make(map[string]string),
+		addresses: make(map[string]uint32),
+	}
+	s.cvm = me
+	s.Handle("cvm", "DescribeImages", me.describeImages)
+	s.Handle("cvm", "RunInstances", me.runInstances)
+	s.Handle("cvm", "DescribeInstances", me.describeInstances)
+	s.Handle("cvm", "ModifyInstancesAttribute", me.modifyInstancesAttribute)
+	s.Handle("cvm", "ModifyInstancesProject", me.modifyInstancesProject)
+	s.Handle("cvm", "ResetInstancesType", me.resetInstancesType)
+	s.Handle("cvm", "StartInstances", me.startInstances)
+	s.Handle("cvm", "StopInstances", me.stopInstances)
+	s.Handle("cvm", "TerminateInstances", me.terminateInstances)
+}
+
+// subnetInstance returns the id of an instance in the subnet
+func (me *Server) subnetInstance(subnetId string) string {
+	for id, instance := range me.cvm.instances {
+		if stringValue(instance.VirtualPrivateCloud.SubnetId) == subnetId {
+			return id
+		}
+	}
+	return ""
+}
+
+// securityGroupInstance returns the id of an instance bound to the security group
+func (me *Server) securityGroupInstance(securityGroupId string) string {
+	for id, instance := range me.cvm.instances {
+		if containsAny(stringValues(instance.SecurityGroupIds), []string{securityGroupId}) {
+			return id
+		}
+	}
+	return ""
+}
+
+func (me *cvmFake) describeImages(request *Request) (interface{}, error) {
+	params := cvm.NewDescribeImagesRequest()
+	var f filters
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	if err := request.Bind(&f); err != nil {
+		return nil, err
+	}
+	ids := stringValues(params.ImageIds)
+	result := make([]*cvm.Image, 0)
+	for _, image := range publicImages {
+		if len(ids) > 0 && !containsAny([]string{*image.ImageId}, ids) {
+			continue
+		}
+		fields := map[string][]string{
+			"image-id":   {*image.ImageId},
+			"image-type": {*image.ImageType},
+			"image-name": {*image.ImageName},
+			"platform":   {*image.Platform},
+		}
+		if f.match(fields, nil) {
+			result = append(result, image)
+		}
+	}
+	start, end := page(len(result), params.Offset, params.Limit)
+	return map[string]interface{}{"TotalCount": len(result), "ImageSet": result[start:end]}, nil
+}
+
+// privateIp returns a new private ip in the subnet
+func (me *cvmFake) privateIp(subnetId string) string {
+	subnet, ok := me.server.vpc.subnets[subnetId]
+	if !ok {
+		return fmt.Sprintf("10.0.0.%d", len(me.instances)+2)
+	}
+	_, ipNet, _ := net.ParseCIDR(stringValue(subnet.CidrBlock))
+	me.addresses[subnetId]++
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, binary.BigEndian.Uint32(ipNet.IP.To4())+me.addresses[subnetId]+1)
+	return ip.String()
+}
+
+func (me *cvmFake) runInstances(request *Request) (interface{}, error) {
+	params := cvm.NewRunInstancesRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	if params.Placement == nil || stringValue(params.Placement.Zone) == "" {
+		return nil, NewError("MissingParameter", "the Placement.Zone is required")
+	}
+	if stringValue(params.ImageId) == "" {
+		return nil, NewError("MissingParameter", "the ImageId is required")
+	}
+	if params.VirtualPrivateCloud != nil {
+		vpcId, subnetId := stringValue(params.VirtualPrivateCloud.VpcId), stringValue(params.VirtualPrivateCloud.SubnetId)
+		subnet, ok := me.server.vpc.subnets[subnetId]
+		if !ok || stringValue(subnet.VpcId) != vpcId {
+			return nil, NewError("InvalidParameterValue.VpcIdNotMatchSubnetId", "the subnet %s is not found in the VPC %s", subnetId, vpcId)
+		}
+		if stringValue(subnet.Zone) != stringValue(params.Placement.Zone) {
+			return nil, NewError("InvalidParameterValue.VpcIdZoneIdNotMatch", "the subnet %s is not in the zone %s", subnetId, stringValue(params.Placement.Zone))
+		}
+	}
+	for _, id := range stringValues(params.SecurityGroupIds) {
+		if _, ok := me.server.vpc.securityGroups[id]; !ok {
+			return nil, NewError("InvalidSecurityGroupId.NotFound", "the security group %s is not found", id)
+		}
+	}
+
+	count := 1
+	if params.InstanceCount != nil {
+		count = int(*params.InstanceCount)
+	}
+	ids := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		ids = append(ids, me.newInstance(request.Region, params))
+	}
+	return map[string]interface{}{"InstanceIdSet": ids}, nil
+}
+
+func (me *cvmFake) newInstance(region string, params *cvm.RunInstancesRequest) string {
+	id := me.server.NewId("ins")
+	chargeType := stringValue(params.InstanceChargeType)
+	if chargeType == "" {
+		chargeType = "POSTPAID_BY_HOUR"
+	}
+	projectId := int64(0)
+	if params.Placement.ProjectId != nil {
+		projectId = *params.Placement.ProjectId
+	}
+	instance := &cvm.Instance{
+		InstanceId:            stringPtr(id),
+		InstanceName:          params.InstanceName,
+		InstanceType:          params.InstanceType,
+		InstanceChargeType:    stringPtr(chargeType),
+		InstanceState:         stringPtr(instanceStateRunning),
+		Placement:             &cvm.Placement{Zone: params.Placement.Zone, ProjectId: int64Ptr(projectId)},
+		ImageId:               params.ImageId,
+		CPU:                   int64Ptr(1),
+		Memory:                int64Ptr(1),
+		RestrictState:         stringPtr("NORMAL"),
+		RenewFlag:             stringPtr("NOTIFY_AND_MANUAL_RENEW"),
+		CreatedTime:           stringPtr(now()),
+		ExpiredTime:           stringPtr(""),
+		OsName:                stringPtr(""),
+		SecurityGroupIds:      params.SecurityGroupIds,
+		LatestOperation:       stringPtr("RunInstances"),
+		LatestOperationState:  stringPtr("SUCCESS"),
+		StopChargingMode:      stringPtr("NOT_APPLICABLE"),
+		Uuid:                  stringPtr(id),
+		CamRoleName:           params.CamRoleName,
+		DisableApiTermination: boolPtr(params.DisableApiTermination != nil && *params.DisableApiTermination),
+		PrivateIpAddresses:    []*string{},
+		PublicIpAddresses:     []*string{},
+		DataDisks:             []*cvm.DataDisk{},
+		LoginSettings:         &cvm.LoginSettings{KeyIds: []*string{}},
+		InternetAccessible: &cvm.InternetAccessible{
+			InternetChargeType:      stringPtr("TRAFFIC_POSTPAID_BY_HOUR"),
+			InternetMaxBandwidthOut: int64Ptr(0),
+		},
+		VirtualPrivateCloud: &cvm.VirtualPrivateCloud{VpcId: stringPtr(""), SubnetId: stringPtr("")},
+	}
+	if instance.InstanceName == nil {
+		instance.InstanceName = stringPtr("Unnamed")
+	}
+	if instance.SecurityGroupIds == nil {
+		instance.SecurityGroupIds = []*string{}
+	}
+	for _, image := range publicImages {
+		if *image.ImageId == *params.ImageId {
+			instance.OsName = image.OsName
+		}
+	}
+	if params.LoginSettings != nil {
+		if params.LoginSettings.KeyIds != nil {
+			instance.LoginSettings.KeyIds = params.LoginSettings.KeyIds
+		}
+		instance.LoginSettings.KeepImageLogin = params.LoginSettings.KeepImageLogin
+	}
+
+	if vpcParams := params.VirtualPrivateCloud; vpcParams != nil {
+		instance.VirtualPrivateCloud = &cvm.VirtualPrivateCloud{VpcId: vpcParams.VpcId, SubnetId: vpcParams.SubnetId}
+		if len(vpcParams.PrivateIpAddresses) > 0 {
+			instance.PrivateIpAddresses = vpcParams.PrivateIpAddresses
+		} else {
+			instance.PrivateIpAddresses = []*string{stringPtr(me.privateIp(stringValue(vpcParams.SubnetId)))}
+		}
+	}
+	if internet := params.InternetAccessible; internet != nil {
+		if internet.InternetChargeType != nil {
+			instance.InternetAccessible.InternetChargeType = internet.InternetChargeType
+		}
+		if internet.InternetMaxBandwidthOut != nil {
+			instance.InternetAccessible.InternetMaxBandwidthOut = internet.InternetMaxBandwidthOut
+		}
+		if *instance.InternetAccessible.InternetMaxBandwidthOut > 0 && (internet.PublicIpAssigned == nil || *internet.PublicIpAssigned) {
+			instance.PublicIpAddresses = []*string{stringPtr(fmt.Sprintf("203.0.113.%d", me.server.ids["ins"]%254+1))}
+		}
+	}
+
+	systemDisk := &cvm.SystemDisk{DiskType: stringPtr("CLOUD_PREMIUM"), DiskSize: int64Ptr(50)}
+	if params.SystemDisk != nil {
+		if params.SystemDisk.DiskType != nil {
+			systemDisk.DiskType = params.SystemDisk.DiskType
+		}
+		if params.SystemDisk.DiskSize != nil {
+			systemDisk.DiskSize = params.SystemDisk.DiskSize
+		}
+	}
+	systemDisk.DiskId = stringPtr(me.server.cbs.newDisk(region, &cbs.Disk{
+		DiskType:           systemDisk.DiskType,
+		DiskSize:           uint64Ptr(uint64(*systemDisk.DiskSize)),
+		DiskUsage:          stringPtr("SYSTEM_DISK"),
+		DiskChargeType:     stringPtr(chargeType),
+		DiskState:          stringPtr(diskStateAttached),
+		InstanceId:         stringPtr(id),
+		DeleteWithInstance: boolPtr(true),
+		Placement:          &cbs.Placement{Zone: params.Placement.Zone},
+	}))
+	instance.SystemDisk = systemDisk
+
+	for _, dataDisk := range params.DataDisks {
+		disk := &cvm.DataDisk{
+			DiskType:              dataDisk.DiskType,
+			DiskSize:              dataDisk.DiskSize,
+			SnapshotId:            dataDisk.SnapshotId,
+			DeleteWithInstance:    boolPtr(dataDisk.DeleteWithInstance == nil || *dataDisk.DeleteWithInstance),
+			Encrypt:               boolPtr(dataDisk.Encrypt != nil && *dataDisk.Encrypt),
+			ThroughputPerformance: int64Ptr(0),
+		}
+		if disk.DiskType == nil {
+			disk.DiskType = stringPtr("CLOUD_PREMIUM")
+		}
+		if dataDisk.ThroughputPerformance != nil {
+			disk.ThroughputPerformance = dataDisk.ThroughputPerformance
+		}
+		disk.DiskId = stringPtr(me.server.cbs.newDisk(region, &cbs.Disk{
+			DiskType:              disk.DiskType,
+			DiskSize:              uint64Ptr(uint64(*disk.DiskSize)),
+			DiskChargeType:        stringPtr(chargeType),
+			DiskState:             stringPtr(diskStateAttached),
+			InstanceId:            stringPtr(id),
+			DeleteWithInstance:    disk.DeleteWithInstance,
+			Encrypt:               disk.Encrypt,
+			ThroughputPerformance: uint64Ptr(uint64(*disk.ThroughputPerformance)),
+			Placement:             &cbs.Placement{Zone: params.Placement.Zone},
+		}))
+		instance.DataDisks = append(instance.DataDisks, disk)
+	}
+
+	tags := make(map[string]string)
+	for _, spec := range params.TagSpecification {
+		if stringValue(spec.ResourceType) != "instance" {
+			continue
+		}
+		for _, t := range spec.Tags {
+			tags[stringValue(t.Key)] = stringValue(t.Value)
+		}
+	}
+	me.server.setResourceTags("cvm", region, "instance", id, tags)
+
+	me.instances[id] = instance
+	me.regions[id] = region
+	return id
+}
+
+func (me *cvmFake) describeInstances(request *Request) (interface{}, error) {
+	params := cvm.NewDescribeInstancesRequest()
+	var f filters
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	if err := request.Bind(&f); err != nil {
+		return nil, err
+	}
+
+	ids := stringValues(params.InstanceIds)
+	result := make([]*cvm.Instance, 0)
+	for _, id := range sortedIds(mapKeys(me.instances)) {
+		instance := me.instances[id]
+		if me.regions[id] != request.Region || (len(ids) > 0 && !containsAny([]string{id}, ids)) {
+			continue
+		}
+		fields := map[string][]string{
+			"instance-id":          {id},
+			"instance-name":        {stringValue(instance.InstanceName)},
+			"instance-type":        {stringValue(instance.InstanceType)},
+			"instance-charge-type": {stringValue(instance.InstanceChargeType)},
+			"instance-state":       {stringValue(instance.InstanceState)},
+			"zone":                 {stringValue(instance.Placement.Zone)},
+			"project-id":           {strconv.FormatInt(*instance.Placement.ProjectId, 10)},
+			"vpc-id":               {stringValue(instance.VirtualPrivateCloud.VpcId)},
+			"subnet-id":            {stringValue(instance.VirtualPrivateCloud.SubnetId)},
+			"private-ip-address":   stringValues(instance.PrivateIpAddresses),
+			"security-group-id":    stringValues(instance.SecurityGroupIds),
+		}
+		tags := me.server.resourceTags("cvm", request.Region, "instance", id)
+		if !f.match(fields, tags) {
+			continue
+		}
+		result = append(result, me.instanceWithTags(instance, tags))
+	}
+	start, end := page(len(result), params.Offset, params.Limit)
+	return map[string]interface{}{"TotalCount": len(result), "InstanceSet": result[start:end]}, nil
+}
+
+func (me *cvmFake) instanceWithTags(instance *cvm.Instance, tags map[string]string) *cvm.Instance {
+	result := *instance
+	result.Tags = make([]*cvm.Tag, 0, len(tags))
+	for _, k := range sortedIds(mapKeys(tags)) {
+		result.Tags = append(result.Tags, &cvm.Tag{Key: stringPtr(k), Value: stringPtr(tags[k])})
+	}
+	// the sizes of the data disks are changed by ResizeDisk of CBS
+	result.DataDisks = make([]*cvm.DataDisk, 0, len(instance.DataDisks))
+	for _, dataDisk := range instance.DataDisks {
+		disk := *dataDisk
+		if cbsDisk, ok := me.server.cbs.disks[stringValue(disk.DiskId)]; ok {
+			disk.DiskSize = int64Ptr(int64(*cbsDisk.DiskSize))
+		}
+		result.DataDisks = append(result.DataDisks, &disk)
+	}
+	return &result
+}
+
+// instancesOf returns the instances of the request, which are not in the recycle bin
+func (me *cvmFake) instancesOf(ids []*string) ([]*cvm.Instance, error) {
+	instances := make([]*cvm.Instance, 0, len(ids))
+	for _, id := range stringValues(ids) {
+		instance, ok := me.instances[id]
+		if !ok {
+			return nil, NewError("InvalidInstanceId.NotFound", "the instance %s is not found", id)
+		}
+		if stringValue(instance.InstanceState) == instanceStateShutdown {
+			return nil, NewError("UnsupportedOperation.InstanceStateShutdown", "the instance %s is in the recycle bin", id)
+		}
+		instances = append(instances, instance)
+	}
+	return instances, nil
+}
+
+func (me *cvmFake) modifyInstancesAttribute(request *Request) (interface{}, error) {
+	params := cvm.NewModifyInstancesAttributeRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	instances, err := me.instancesOf(params.InstanceIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range stringValues(params.SecurityGroups) {
+		if _, ok := me.server.vpc.securityGroups[id]; !ok {
+			return nil, NewError("InvalidSecurityGroupId.NotFound", "the security group %s is not found", id)
+		}
+	}
+	for _, instance := range instances {
+		if params.InstanceName != nil {
+			instance.InstanceName = params.InstanceName
+		}
+		if params.SecurityGroups != nil {
+			instance.SecurityGroupIds = params.SecurityGroups
+		}
+		if params.CamRoleName != nil {
+			instance.CamRoleName = params.CamRoleName
+		}
+		if params.DisableApiTermination != nil {
+			instance.DisableApiTermination = params.DisableApiTermination
+		}
+	}
+	return struct{}{}, nil
+}
+
+func (me *cvmFake) modifyInstancesProject(request *Request) (interface{}, error) {
+	params := cvm.NewModifyInstancesProjectRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	instances, err := me.instancesOf(params.InstanceIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		instance.Placement.ProjectId = params.ProjectId
+	}
+	return struct{}{}, nil
+}
+
+func (me *cvmFake) resetInstancesType(request *Request) (interface{}, error) {
+	params := cvm.NewResetInstancesTypeRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	instances, err := me.instancesOf(params.InstanceIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		if stringValue(instance.InstanceState) != instanceStateStopped && (params.ForceStop == nil || !*params.ForceStop) {
+			return nil, NewError("InvalidInstance.NotSupported", "the instance %s must be stopped", *instance.InstanceId)
+		}
+		instance.InstanceType = params.InstanceType
+	}
+	return struct{}{}, nil
+}
+
+func (me *cvmFake) startInstances(request *Request) (interface{}, error) {
+	params := cvm.NewStartInstancesRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	return me.changeState(params.InstanceIds, "StartInstances", instanceStateRunning)
+}
+
+func (me *cvmFake) stopInstances(request *Request) (interface{}, error) {
+	params := cvm.NewStopInstancesRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	return me.changeState(params.InstanceIds, "StopInstances", instanceStateStopped)
+}
+
+func (me *cvmFake) changeState(ids []*string, operation, state string) (interface{}, error) {
+	instances, err := me.instancesOf(ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		instance.InstanceState = stringPtr(state)
+		instance.LatestOperation = stringPtr(operation)
+		instance.LatestOperationState = stringPtr("SUCCESS")
+	}
+	return struct{}{}, nil
+}
+
+func (me *cvmFake) terminateInstances(request *Request) (interface{}, error) {
+	params := cvm.NewTerminateInstancesRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	for _, id := range stringValues(params.InstanceIds) {
+		instance, ok := me.instances[id]
+		if !ok {
+			return nil, NewError("InvalidInstanceId.NotFound", "the instance %s is not found", id)
+		}
+		if instance.DisableApiTermination != nil && *instance.DisableApiTermination {
+			return nil, NewError("OperationDenied.InstanceOperationInProgress", "the termination of the instance %s is disabled", id)
+		}
+	}
+	for _, id := range stringValues(params.InstanceIds) {
+		instance := me.instances[id]
+		if stringValue(instance.InstanceChargeType) == "PREPAID" && stringValue(instance.InstanceState) != instanceStateShutdown {
+			instance.InstanceState = stringPtr(instanceStateShutdown)
+			instance.LatestOperation = stringPtr("TerminateInstances")
+			instance.LatestOperationState = stringPtr("SUCCESS")
+			continue
+		}
+		me.delete(id)
+	}
+	return struct{}{}, nil
+}
+
+// delete removes the instance, the disks are deleted with it or detached
+func (me *cvmFake) delete(id string) {
+	for diskId, disk := range me.server.cbs.disks {
+		if stringValue(disk.InstanceId) != id {
+			continue
+		}
+		if *disk.DeleteWithInstance {
+			delete(me.server.cbs.disks, diskId)
+			me.server.setResourceTags("cvm", me.regions[id], "volume", diskId, nil)
+			delete(me.server.cbs.regions, diskId)
+		} else {
+			me.server.cbs.detach(disk)
+		}
+	}
+	delete(me.instances, id)
+	me.server.setResourceTags("cvm", me.regions[id], "instance", id, nil)
+	delete(me.regions, id)
+}
@@ -0,0 +1,166 @@
+package mockapi
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// filter is the Filter of the Describe actions, which is decoded the same way for all products
+type filter struct {
+	Name   string
+	Values []string
+}
+
+// filters are the Filters of a Describe request
+type filters struct {
+	Filters []filter
+}
+
+// match returns whether all the filters match the fields of the resource, a filter matches when one of its values
+// is one of the values of the field. The filters `tag-key` and `tag:<key>` match tags.
+func (me filters) match(fields map[string][]string, tags map[string]string) bool {
+	for _, f := range me.Filters {
+		var values []string
+		switch {
+		case f.Name == "tag-key":
+			for k := range tags {
+				values = append(values, k)
+			}
+		case strings.HasPrefix(f.Name, "tag:"):
+			if v, ok := tags[strings.TrimPrefix(f.Name, "tag:")]; ok {
+				values = []string{v}
+			}
+		default:
+			var ok bool
+			if values, ok = fields[f.Name]; !ok {
+				// the filters not supported by the fakes are ignored
+				continue
+			}
+		}
+		if !containsAny(values, f.Values) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAny(values, expected []string) bool {
+	for _, v := range values {
+		for _, e := range expected {
+			if v == e {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mapKeys returns the keys of the map m whose keys are strings
+func mapKeys(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	result := make([]string, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, k.String())
+	}
+	return result
+}
+
+// sortedIds returns the ids of the resources in the order of creation
+func sortedIds(ids []string) []string {
+	sort.Strings(ids)
+	return ids
+}
+
+// page returns the range of the page of total items, offset and limit are the strings or the numbers of the request
+func page(total int, offset, limit interface{}) (start, end int) {
+	start = pageNumber(offset, 0)
+	size := pageNumber(limit, 20)
+	if start > total {
+		start = total
+	}
+	end = start + size
+	if end > total {
+		end = total
+	}
+	return
+}
+
+func pageNumber(v interface{}, defaultValue int) int {
+	var n int
+	switch value := v.(type) {
+	case *string:
+		if value == nil {
+			return defaultValue
+		}
+		var err error
+		if n, err = strconv.Atoi(*value); err != nil {
+			return defaultValue
+		}
+	case *int64:
+		if value == nil {
+			return defaultValue
+		}
+		n = int(*value)
+	case *uint64:
+		if value == nil {
+			return defaultValue
+		}
+		n = int(*value)
+	default:
+		return defaultValue
+	}
+	if n < 0 {
+		return defaultValue
+	}
+	return n
+}
+
+// now returns the time in the format of the API responses
+func now() string {
+	return time.Now().Format("2006-01-02 15:04:05")
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func stringValues(ss []*string) []string {
+	values := make([]string, 0, len(ss))
+	for _, s := range ss {
+		values = append(values, stringValue(s))
+	}
+	return values
+}
+
+func boolString(b bool) string {
+	return strconv.FormatBool(b)
+}
+
+// defaultString sets the field to value if it is nil
+func defaultString(field **string, value string) {
+	if *field == nil {
+		*field = &value
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func uint64Ptr(i uint64) *uint64 {
+	return &i
+}
@@ -0,0 +1,253 @@
+// Package mockapi is a fake TencentCloud API server for running the acceptance tests offline. The requests are
+// authenticated with TC3-HMAC-SHA256 and dispatched to the handlers registered by product and action, and the
+// stateful fakes of VPC, CVM, CBS and tag are registered by default.
+//
+// The server is used by pointing the `endpoints` of the provider to URL, like `cvm = "http://127.0.0.1:8080"`.
+package mockapi
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// SecretId and SecretKey are the default credential accepted by the server
+	SecretId  = "AKIDmockapi00000000000000000000000000"
+	SecretKey = "mockapi0000000000000000000000000"
+
+	signAlgorithm = "TC3-HMAC-SHA256"
+)
+
+// Products are the products served by the fakes registered by default
+var Products = []string{"cbs", "cvm", "tag", "vpc"}
+
+// HandlerFunc handles the request of an action, the result is the Response of the API without RequestId.
+// An *Error is returned as the error of the API, and the other errors as InternalError.
+type HandlerFunc func(request *Request) (interface{}, error)
+
+// Request is an authenticated API request
+type Request struct {
+	Product  string
+	Action   string
+	Version  string
+	Region   string
+	SecretId string
+	Body     []byte
+}
+
+// Bind decodes the parameters of the request into v, which is usually the request type of the SDK
+func (me *Request) Bind(v interface{}) error {
+	if len(me.Body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(me.Body, v); err != nil {
+		return NewError("InvalidParameter", "decode the parameters of %s failed: %v", me.Action, err)
+	}
+	return nil
+}
+
+// Error is the error of an API
+type Error struct {
+	Code    string
+	Message string
+}
+
+// NewError returns the error of code with the formatted message
+func NewError(code, format string, args ...interface{}) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func (me *Error) Error() string {
+	return fmt.Sprintf("[%s] %s", me.Code, me.Message)
+}
+
+// Server is the fake API server, the handlers are called one at a time, so the fakes need no locks
+type Server struct {
+	URL string
+
+	server    *httptest.Server
+	secretKey map[string]string
+	handlers  map[string]HandlerFunc
+	tags      map[string]map[string]string
+	vpc       *vpcFake
+	cvm       *cvmFake
+	cbs       *cbsFake
+
+	mutex    sync.Mutex
+	requests int
+	ids      map[string]int
+	calls    map[string]int
+}
+
+// NewServer starts a server which accepts the default credential and serves the default fakes, it should be closed
+// after use
+func NewServer() *Server {
+	me := &Server{
+		secretKey: map[string]string{SecretId: SecretKey},
+		handlers:  make(map[string]HandlerFunc),
+		ids:       make(map[string]int),
+		calls:     make(map[string]int),
+	}
+	registerVpc(me)
+	registerCvm(me)
+	registerCbs(me)
+	registerTag(me)
+	me.server = httptest.NewServer(me)
+	me.URL = me.server.URL
+	return me
+}
+
+// Close shuts down the server
+func (me *Server) Close() {
+	me.server.Close()
+}
+
+// AddCredential makes the server accept the credential
+func (me *Server) AddCredential(secretId, secretKey string) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.secretKey[secretId] = secretKey
+}
+
+// Handle registers the handler of action of product, it replaces the handler registered before
+func (me *Server) Handle(product, action string, handler HandlerFunc) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.handlers[product+"."+action] = handler
+}
+
+// Calls returns how many times action of product has been called
+func (me *Server) Calls(product, action string) int {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	return me.calls[product+"."+action]
+}
+
+// NewId returns a new resource id with prefix, like `vpc-00000001`. It is called by the handlers.
+func (me *Server) NewId(prefix string) string {
+	me.ids[prefix]++
+	return fmt.Sprintf("%s-%08x", prefix, me.ids[prefix])
+}
+
+func (me *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		me.writeResponse(w, nil, NewError("InvalidParameter", "read the request body failed: %v", err))
+		return
+	}
+
+	request, err := me.authenticate(r, body)
+	if err != nil {
+		me.writeResponse(w, nil, err)
+		return
+	}
+	me.calls[request.Product+"."+request.Action]++
+
+	handler, ok := me.handlers[request.Product+"."+request.Action]
+	if !ok {
+		log.Printf("[WARN] mockapi: action %s of %s is not implemented", request.Action, request.Product)
+		me.writeResponse(w, nil, NewError("InvalidAction", "the action %s of %s is not implemented by mockapi", request.Action, request.Product))
+		return
+	}
+	result, err := handler(request)
+	me.writeResponse(w, result, err)
+}
+
+var authorizationRegexp = regexp.MustCompile(`^` + signAlgorithm + ` Credential=([^/]+)/(\d{4}-\d{2}-\d{2})/([^/]+)/tc3_request, SignedHeaders=([^,]+), Signature=([0-9a-f]+)$`)
+
+// authenticate checks the TC3-HMAC-SHA256 signature of r, which is signed the same way as the SDK
+func (me *Server) authenticate(r *http.Request, body []byte) (*Request, error) {
+	if r.Method != http.MethodPost {
+		return nil, NewError("UnsupportedOperation", "only the POST requests are supported by mockapi")
+	}
+	match := authorizationRegexp.FindStringSubmatch(r.Header.Get("Authorization"))
+	if match == nil {
+		return nil, NewError("AuthFailure.SignatureFailure", "the Authorization header is invalid")
+	}
+	secretId, date, product, signedHeaders, signature := match[1], match[2], match[3], match[4], match[5]
+	secretKey, ok := me.secretKey[secretId]
+	if !ok {
+		return nil, NewError("AuthFailure.SecretIdNotFound", "the SecretId %s is not found", secretId)
+	}
+	if signedHeaders != "content-type;host" {
+		return nil, NewError("AuthFailure.SignatureFailure", "the signed headers %s are not supported", signedHeaders)
+	}
+	timestamp := r.Header.Get("X-TC-Timestamp")
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || time.Unix(unix, 0).UTC().Format("2006-01-02") != date {
+		return nil, NewError("AuthFailure.InvalidAuthorization", "the X-TC-Timestamp %s does not match the date %s", timestamp, date)
+	}
+
+	hashedPayload := sha256hex(string(body))
+	if r.Header.Get("X-TC-Content-SHA256") == "UNSIGNED-PAYLOAD" {
+		hashedPayload = sha256hex("UNSIGNED-PAYLOAD")
+	}
+	canonicalRequest := fmt.Sprintf("%s\n%s\n%s\ncontent-type:%s\nhost:%s\n\n%s\n%s",
+		r.Method, "/", "", r.Header.Get("Content-Type"), r.Host, signedHeaders, hashedPayload)
+	stringToSign := fmt.Sprintf("%s\n%s\n%s/%s/tc3_request\n%s", signAlgorithm, timestamp, date, product, sha256hex(canonicalRequest))
+	key := hmacsha256(hmacsha256(hmacsha256([]byte("TC3"+secretKey), date), product), "tc3_request")
+	if expected := hex.EncodeToString(hmacsha256(key, stringToSign)); !hmac.Equal([]byte(expected), []byte(signature)) {
+		return nil, NewError("AuthFailure.SignatureFailure", "the signature of the request is not correct")
+	}
+
+	return &Request{
+		Product:  product,
+		Action:   r.Header.Get("X-TC-Action"),
+		Version:  r.Header.Get("X-TC-Version"),
+		Region:   r.Header.Get("X-TC-Region"),
+		SecretId: secretId,
+		Body:     body,
+	}, nil
+}
+
+// writeResponse writes result, or err, in the envelope of the API responses
+func (me *Server) writeResponse(w http.ResponseWriter, result interface{}, err error) {
+	me.requests++
+	requestId := fmt.Sprintf("00000000-0000-0000-0000-%012x", me.requests)
+
+	response := make(map[string]interface{})
+	if err != nil {
+		apiErr, ok := err.(*Error)
+		if !ok {
+			apiErr = NewError("InternalError", "%v", err)
+		}
+		response["Error"] = map[string]string{"Code": apiErr.Code, "Message": apiErr.Message}
+	} else if result != nil {
+		b, err := json.Marshal(result)
+		if err == nil {
+			err = json.Unmarshal(b, &response)
+		}
+		if err != nil {
+			response = map[string]interface{}{"Error": map[string]string{"Code": "InternalError", "Message": err.Error()}}
+		}
+	}
+	response["RequestId"] = requestId
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"Response": response})
+}
+
+func sha256hex(s string) string {
+	b := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(b[:])
+}
+
+func hmacsha256(key []byte, s string) []byte {
+	hashed := hmac.New(sha256.New, key)
+	hashed.Write([]byte(s))
+	return hashed.Sum(nil)
+}
@@ -0,0 +1,115 @@
+package mockapi
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+)
+
+func newVpcClient(t *testing.T, server *Server, secretId, secretKey string) *vpc.Client {
+	cpf := profile.NewClientProfile()
+	cpf.HttpProfile.Scheme = "HTTP"
+	cpf.HttpProfile.Endpoint = strings.TrimPrefix(server.URL, "http://")
+	client, err := vpc.NewClient(common.NewCredential(secretId, secretKey), "ap-guangzhou", cpf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func errorCode(err error) string {
+	if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
+		return e.Code
+	}
+	return ""
+}
+
+func TestServerAuthenticate(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	if _, err := newVpcClient(t, server, SecretId, SecretKey).DescribeVpcs(vpc.NewDescribeVpcsRequest()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := newVpcClient(t, server, SecretId, "wrong").DescribeVpcs(vpc.NewDescribeVpcsRequest()); errorCode(err) != "AuthFailure.SignatureFailure" {
+		t.Errorf("expected the signature failure, got %v", err)
+	}
+	if _, err := newVpcClient(t, server, "AKIDunknown", SecretKey).DescribeVpcs(vpc.NewDescribeVpcsRequest()); errorCode(err) != "AuthFailure.SecretIdNotFound" {
+		t.Errorf("expected the unknown secret id, got %v", err)
+	}
+
+	server.AddCredential("AKIDother", "other")
+	if _, err := newVpcClient(t, server, "AKIDother", "other").DescribeVpcs(vpc.NewDescribeVpcsRequest()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if calls := server.Calls("vpc", "DescribeVpcs"); calls != 2 {
+		t.Errorf("expected 2 authenticated calls, got %d", calls)
+	}
+}
+
+func TestServerHandle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newVpcClient(t, server, SecretId, SecretKey)
+
+	if _, err := client.DescribeVpcEndPoint(vpc.NewDescribeVpcEndPointRequest()); errorCode(err) != "InvalidAction" {
+		t.Errorf("expected the invalid action, got %v", err)
+	}
+
+	server.Handle("vpc", "DescribeVpcs", func(request *Request) (interface{}, error) {
+		if request.Region != "ap-guangzhou" || request.Version != "2017-03-12" {
+			t.Errorf("unexpected request %+v", request)
+		}
+		return nil, NewError("LimitExceeded", "too many requests")
+	})
+	if _, err := client.DescribeVpcs(vpc.NewDescribeVpcsRequest()); errorCode(err) != "LimitExceeded" {
+		t.Errorf("expected the error of the handler, got %v", err)
+	}
+}
+
+func TestServerVpc(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newVpcClient(t, server, SecretId, SecretKey)
+
+	createVpc := vpc.NewCreateVpcRequest()
+	createVpc.VpcName = common.StringPtr("test")
+	createVpc.CidrBlock = common.StringPtr("10.0.0.0/16")
+	vpcResponse, err := client.CreateVpc(createVpc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vpcId := vpcResponse.Response.Vpc.VpcId
+
+	createSubnet := vpc.NewCreateSubnetRequest()
+	createSubnet.VpcId = vpcId
+	createSubnet.SubnetName = common.StringPtr("test")
+	createSubnet.Zone = common.StringPtr("ap-guangzhou-3")
+	for cidr, code := range map[string]string{
+		"10.1.0.0/24": "InvalidParameterValue.SubnetRange",
+		"10.0.1.0/24": "",
+		"10.0.1.0/25": "InvalidParameterValue.SubnetConflict",
+	} {
+		createSubnet.CidrBlock = common.StringPtr(cidr)
+		if _, err := client.CreateSubnet(createSubnet); errorCode(err) != code {
+			t.Errorf("expected %q of %s, got %v", code, cidr, err)
+		}
+	}
+
+	deleteVpc := vpc.NewDeleteVpcRequest()
+	deleteVpc.VpcId = vpcId
+	if _, err := client.DeleteVpc(deleteVpc); errorCode(err) != "ResourceInUse" {
+		t.Errorf("expected the vpc in use, got %v", err)
+	}
+
+	describe := vpc.NewDescribeVpcsRequest()
+	describe.VpcIds = []*string{vpcId}
+	response, err := client.DescribeVpcs(describe)
+	if err != nil || len(response.Response.VpcSet) != 1 || *response.Response.VpcSet[0].VpcName != "test" {
+		t.Errorf("unexpected vpcs %v: %v", response, err)
+	}
+}
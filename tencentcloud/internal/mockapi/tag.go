@@ -0,0 +1,145 @@
+package mockapi
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	tag "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
+)
+
+// tagKey is the key of the tags of a resource, it is the six-segment resource name without the uin
+func tagKey(service, region, prefix, id string) string {
+	return fmt.Sprintf("%s:%s:%s/%s", service, region, prefix, id)
+}
+
+var resourceNameRegexp = regexp.MustCompile(`^qcs::([^:]+):([^:]*):[^:]*:([^/]+)/(.+)$`)
+
+// resourceTags returns the tags of the resource, the map returned can not be changed
+func (me *Server) resourceTags(service, region, prefix, id string) map[string]string {
+	return me.tags[tagKey(service, region, prefix, id)]
+}
+
+// setResourceTags replaces the tags of the resource, which are deleted if tags is empty
+func (me *Server) setResourceTags(service, region, prefix, id string, tags map[string]string) {
+	if me.tags == nil {
+		me.tags = make(map[string]map[string]string)
+	}
+	key := tagKey(service, region, prefix, id)
+	if len(tags) == 0 {
+		delete(me.tags, key)
+		return
+	}
+	me.tags[key] = tags
+}
+
+func registerTag(s *Server) {
+	s.Handle("tag", "ModifyResourceTags", func(request *Request) (interface{}, error) {
+		params := tag.NewModifyResourceTagsRequest()
+		if err := request.Bind(params); err != nil {
+			return nil, err
+		}
+		match := resourceNameRegexp.FindStringSubmatch(stringValue(params.Resource))
+		if match == nil {
+			return nil, NewError("InvalidParameter.ResourceName", "the resource %s is invalid", stringValue(params.Resource))
+		}
+		tags := make(map[string]string)
+		for k, v := range s.resourceTags(match[1], match[2], match[3], match[4]) {
+			tags[k] = v
+		}
+		for _, t := range params.ReplaceTags {
+			tags[stringValue(t.TagKey)] = stringValue(t.TagValue)
+		}
+		for _, t := range params.DeleteTags {
+			delete(tags, stringValue(t.TagKey))
+		}
+		s.setResourceTags(match[1], match[2], match[3], match[4], tags)
+		return struct{}{}, nil
+	})
+
+	s.Handle("tag", "DescribeResourceTagsByResourceIds", func(request *Request) (interface{}, error) {
+		params := tag.NewDescribeResourceTagsByResourceIdsRequest()
+		if err := request.Bind(params); err != nil {
+			return nil, err
+		}
+		result := make([]*tag.TagResource, 0)
+		for _, id := range stringValues(params.ResourceIds) {
+			tags := s.resourceTags(stringValue(params.ServiceType), stringValue(params.ResourceRegion), stringValue(params.ResourcePrefix), id)
+			keys := make([]string, 0, len(tags))
+			for k := range tags {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				result = append(result, &tag.TagResource{
+					TagKey:      stringPtr(k),
+					TagValue:    stringPtr(tags[k]),
+					ResourceId:  stringPtr(id),
+					ServiceType: params.ServiceType,
+				})
+			}
+		}
+		start, end := page(len(result), params.Offset, params.Limit)
+		return map[string]interface{}{
+			"TotalCount": len(result),
+			"Offset":     start,
+			"Limit":      end - start,
+			"Tags":       result[start:end],
+		}, nil
+	})
+	s.Handle("tag", "GetResources", func(request *Request) (interface{}, error) {
+		params := tag.NewGetResourcesRequest()
+		if err := request.Bind(params); err != nil {
+			return nil, err
+		}
+		result := make([]*tag.ResourceTagMapping, 0)
+		for _, key := range sortedIds(mapKeys(s.tags)) {
+			tags := s.tags[key]
+			if !matchTagFilters(tags, params.TagFilters) {
+				continue
+			}
+			match := tagKeyRegexp.FindStringSubmatch(key)
+			mapping := &tag.ResourceTagMapping{
+				Resource: stringPtr(fmt.Sprintf("qcs::%s:%s:uin/:%s/%s", match[1], match[2], match[3], match[4])),
+			}
+			for _, k := range sortedIds(mapKeys(tags)) {
+				mapping.Tags = append(mapping.Tags, &tag.Tag{TagKey: stringPtr(k), TagValue: stringPtr(tags[k])})
+			}
+			result = append(result, mapping)
+		}
+		// the token is the offset of the next page
+		start, end := page(len(result), params.PaginationToken, params.MaxResults)
+		token := ""
+		if end < len(result) {
+			token = strconv.Itoa(end)
+		}
+		return map[string]interface{}{
+			"PaginationToken":        token,
+			"ResourceTagMappingList": result[start:end],
+		}, nil
+	})
+}
+
+var tagKeyRegexp = regexp.MustCompile(`^([^:]+):([^:]*):([^/]+)/(.+)$`)
+
+// matchTagFilters returns whether tags has all the keys of the filters, with one of the values if any
+func matchTagFilters(tags map[string]string, filters []*tag.TagFilter) bool {
+	for _, filter := range filters {
+		value, ok := tags[stringValue(filter.TagKey)]
+		if !ok {
+			return false
+		}
+		if len(filter.TagValue) == 0 {
+			continue
+		}
+		matched := false
+		for _, v := range stringValues(filter.TagValue) {
+			matched = matched || v == value
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
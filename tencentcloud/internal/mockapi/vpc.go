@@ -0,0 +1,509 @@
+package mockapi
+
+import (
+	"net"
+
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+)
+
+// vpcFake is the stateful fake of the VPCs, subnets, route tables and security groups
+type vpcFake struct {
+	server         *Server
+	vpcs           map[string]*vpc.Vpc
+	subnets        map[string]*vpc.Subnet
+	routeTables    map[string]*vpc.RouteTable
+	securityGroups map[string]*vpc.SecurityGroup
+	regions        map[string]string
+}
+
+func registerVpc(s *Server) {
+	me := &vpcFake{
+		server:         s,
+		vpcs:           make(map[string]*vpc.Vpc),
+		subnets:        make(map[string]*vpc.Subnet),
+		routeTables:    make(map[string]*vpc.RouteTable),
+		securityGroups: make(map[string]*vpc.SecurityGroup),
+		regions:        make(map[string]string),
+	}
+	s.vpc = me
+	s.Handle("vpc", "CreateVpc", me.createVpc)
+	s.Handle("vpc", "DescribeVpcs", me.describeVpcs)
+	s.Handle("vpc", "ModifyVpcAttribute", me.modifyVpcAttribute)
+	s.Handle("vpc", "DeleteVpc", me.deleteVpc)
+	s.Handle("vpc", "DescribeAssistantCidr", me.describeAssistantCidr)
+	s.Handle("vpc", "DescribeRouteTables", me.describeRouteTables)
+	s.Handle("vpc", "CreateSubnet", me.createSubnet)
+	s.Handle("vpc", "DescribeSubnets", me.describeSubnets)
+	s.Handle("vpc", "ModifySubnetAttribute", me.modifySubnetAttribute)
+	s.Handle("vpc", "DeleteSubnet", me.deleteSubnet)
+	s.Handle("vpc", "CreateSecurityGroup", me.createSecurityGroup)
+	s.Handle("vpc", "DescribeSecurityGroups", me.describeSecurityGroups)
+	s.Handle("vpc", "ModifySecurityGroupAttribute", me.modifySecurityGroupAttribute)
+	s.Handle("vpc", "DeleteSecurityGroup", me.deleteSecurityGroup)
+	s.Handle("vpc", "DescribeSecurityGroupAssociationStatistics", me.describeSecurityGroupAssociationStatistics)
+}
+
+// tags returns the tags of the resource as the TagSet of the responses
+func (me *vpcFake) tags(prefix, id string) []*vpc.Tag {
+	tags := me.server.resourceTags("vpc", me.regions[id], prefix, id)
+	tagSet := make([]*vpc.Tag, 0, len(tags))
+	for _, k := range sortedIds(mapKeys(tags)) {
+		tagSet = append(tagSet, &vpc.Tag{Key: stringPtr(k), Value: stringPtr(tags[k])})
+	}
+	return tagSet
+}
+
+func (me *vpcFake) setTags(region, prefix, id string, tags []*vpc.Tag) {
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		m[stringValue(t.Key)] = stringValue(t.Value)
+	}
+	me.server.setResourceTags("vpc", region, prefix, id, m)
+}
+
+func (me *vpcFake) createVpc(request *Request) (interface{}, error) {
+	params := vpc.NewCreateVpcRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	if _, _, err := net.ParseCIDR(stringValue(params.CidrBlock)); err != nil {
+		return nil, NewError("InvalidParameterValue.Malformed", "the CidrBlock %s is invalid", stringValue(params.CidrBlock))
+	}
+
+	id := me.server.NewId("vpc")
+	item := &vpc.Vpc{
+		VpcId:            stringPtr(id),
+		VpcName:          params.VpcName,
+		CidrBlock:        params.CidrBlock,
+		IsDefault:        boolPtr(false),
+		EnableMulticast:  boolPtr(stringValue(params.EnableMulticast) == "true"),
+		CreatedTime:      stringPtr(now()),
+		DnsServerSet:     params.DnsServers,
+		DomainName:       params.DomainName,
+		DhcpOptionsId:    stringPtr(""),
+		EnableDhcp:       boolPtr(true),
+		Ipv6CidrBlock:    stringPtr(""),
+		AssistantCidrSet: []*vpc.AssistantCidr{},
+	}
+	if item.DnsServerSet == nil {
+		item.DnsServerSet = []*string{stringPtr("183.60.83.19"), stringPtr("183.60.82.98")}
+	}
+	me.vpcs[id] = item
+	me.regions[id] = request.Region
+	me.setTags(request.Region, "vpc", id, params.Tags)
+
+	// the default route table of the VPC
+	routeTableId := me.server.NewId("rtb")
+	me.routeTables[routeTableId] = &vpc.RouteTable{
+		VpcId:           stringPtr(id),
+		RouteTableId:    stringPtr(routeTableId),
+		RouteTableName:  stringPtr("default"),
+		AssociationSet:  []*vpc.RouteTableAssociation{},
+		RouteSet:        []*vpc.Route{},
+		Main:            boolPtr(true),
+		CreatedTime:     stringPtr(now()),
+		LocalCidrForCcn: []*vpc.CidrForCcn{},
+	}
+	me.regions[routeTableId] = request.Region
+
+	return map[string]interface{}{"Vpc": me.vpcWithTags(item)}, nil
+}
+
+func (me *vpcFake) vpcWithTags(item *vpc.Vpc) *vpc.Vpc {
+	result := *item
+	result.TagSet = me.tags("vpc", stringValue(item.VpcId))
+	return &result
+}
+
+func (me *vpcFake) describeVpcs(request *Request) (interface{}, error) {
+	params := vpc.NewDescribeVpcsRequest()
+	var f filters
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	if err := request.Bind(&f); err != nil {
+		return nil, err
+	}
+
+	ids := stringValues(params.VpcIds)
+	result := make([]*vpc.Vpc, 0)
+	for _, id := range sortedIds(mapKeys(me.vpcs)) {
+		item := me.vpcs[id]
+		if me.regions[id] != request.Region || (len(ids) > 0 && !containsAny([]string{id}, ids)) {
+			continue
+		}
+		fields := map[string][]string{
+			"vpc-id":     {id},
+			"vpc-name":   {stringValue(item.VpcName)},
+			"cidr-block": {stringValue(item.CidrBlock)},
+			"is-default": {boolString(*item.IsDefault)},
+		}
+		if !f.match(fields, me.server.resourceTags("vpc", request.Region, "vpc", id)) {
+			continue
+		}
+		result = append(result, me.vpcWithTags(item))
+	}
+	start, end := page(len(result), params.Offset, params.Limit)
+	return map[string]interface{}{"TotalCount": len(result), "VpcSet": result[start:end]}, nil
+}
+
+func (me *vpcFake) modifyVpcAttribute(request *Request) (interface{}, error) {
+	params := vpc.NewModifyVpcAttributeRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	item, ok := me.vpcs[stringValue(params.VpcId)]
+	if !ok {
+		return nil, NewError("ResourceNotFound", "the VPC %s is not found", stringValue(params.VpcId))
+	}
+	if params.VpcName != nil {
+		item.VpcName = params.VpcName
+	}
+	if params.EnableMulticast != nil {
+		item.EnableMulticast = boolPtr(*params.EnableMulticast == "true")
+	}
+	if params.DnsServers != nil {
+		item.DnsServerSet = params.DnsServers
+	}
+	if params.DomainName != nil {
+		item.DomainName = params.DomainName
+	}
+	return struct{}{}, nil
+}
+
+func (me *vpcFake) deleteVpc(request *Request) (interface{}, error) {
+	params := vpc.NewDeleteVpcRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	id := stringValue(params.VpcId)
+	if _, ok := me.vpcs[id]; !ok {
+		return nil, NewError("ResourceNotFound", "the VPC %s is not found", id)
+	}
+	for _, subnet := range me.subnets {
+		if stringValue(subnet.VpcId) == id {
+			return nil, NewError("ResourceInUse", "the VPC %s still has the subnet %s", id, stringValue(subnet.SubnetId))
+		}
+	}
+	for routeTableId, routeTable := range me.routeTables {
+		if stringValue(routeTable.VpcId) == id {
+			delete(me.routeTables, routeTableId)
+			delete(me.regions, routeTableId)
+		}
+	}
+	delete(me.vpcs, id)
+	me.server.setResourceTags("vpc", me.regions[id], "vpc", id, nil)
+	delete(me.regions, id)
+	return struct{}{}, nil
+}
+
+func (me *vpcFake) describeAssistantCidr(request *Request) (interface{}, error) {
+	return map[string]interface{}{"TotalCount": 0, "AssistantCidrSet": []*vpc.AssistantCidr{}}, nil
+}
+
+func (me *vpcFake) describeRouteTables(request *Request) (interface{}, error) {
+	params := vpc.NewDescribeRouteTablesRequest()
+	var f filters
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	if err := request.Bind(&f); err != nil {
+		return nil, err
+	}
+
+	ids := stringValues(params.RouteTableIds)
+	result := make([]*vpc.RouteTable, 0)
+	for _, id := range sortedIds(mapKeys(me.routeTables)) {
+		item := me.routeTables[id]
+		if me.regions[id] != request.Region || (len(ids) > 0 && !containsAny([]string{id}, ids)) {
+			continue
+		}
+		fields := map[string][]string{
+			"route-table-id":   {id},
+			"route-table-name": {stringValue(item.RouteTableName)},
+			"vpc-id":           {stringValue(item.VpcId)},
+			"association.main": {boolString(*item.Main)},
+		}
+		if !f.match(fields, me.server.resourceTags("vpc", request.Region, "rtb", id)) {
+			continue
+		}
+		routeTable := *item
+		routeTable.AssociationSet = []*vpc.RouteTableAssociation{}
+		for _, subnet := range me.subnets {
+			if stringValue(subnet.RouteTableId) == id {
+				routeTable.AssociationSet = append(routeTable.AssociationSet, &vpc.RouteTableAssociation{
+					SubnetId:     subnet.SubnetId,
+					RouteTableId: stringPtr(id),
+				})
+			}
+		}
+		routeTable.TagSet = me.tags("rtb", id)
+		result = append(result, &routeTable)
+	}
+	start, end := page(len(result), params.Offset, params.Limit)
+	return map[string]interface{}{"TotalCount": len(result), "RouteTableSet": result[start:end]}, nil
+}
+
+func (me *vpcFake) createSubnet(request *Request) (interface{}, error) {
+	params := vpc.NewCreateSubnetRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	vpcId := stringValue(params.VpcId)
+	vpcItem, ok := me.vpcs[vpcId]
+	if !ok {
+		return nil, NewError("ResourceNotFound", "the VPC %s is not found", vpcId)
+	}
+	_, vpcNet, _ := net.ParseCIDR(stringValue(vpcItem.CidrBlock))
+	ip, subnetNet, err := net.ParseCIDR(stringValue(params.CidrBlock))
+	if err != nil || !vpcNet.Contains(ip) {
+		return nil, NewError("InvalidParameterValue.SubnetRange", "the CidrBlock %s is not in the VPC %s", stringValue(params.CidrBlock), vpcId)
+	}
+	for _, subnet := range me.subnets {
+		if stringValue(subnet.VpcId) != vpcId {
+			continue
+		}
+		_, other, _ := net.ParseCIDR(stringValue(subnet.CidrBlock))
+		if other.Contains(subnetNet.IP) || subnetNet.Contains(other.IP) {
+			return nil, NewError("InvalidParameterValue.SubnetConflict", "the CidrBlock %s conflicts with the subnet %s", stringValue(params.CidrBlock), stringValue(subnet.SubnetId))
+		}
+	}
+	var routeTableId string
+	for id, routeTable := range me.routeTables {
+		if stringValue(routeTable.VpcId) == vpcId && *routeTable.Main {
+			routeTableId = id
+		}
+	}
+
+	ones, bits := subnetNet.Mask.Size()
+	total := uint64(1)<<uint(bits-ones) - 3
+	id := me.server.NewId("subnet")
+	item := &vpc.Subnet{
+		VpcId:                   stringPtr(vpcId),
+		SubnetId:                stringPtr(id),
+		SubnetName:              params.SubnetName,
+		CidrBlock:               stringPtr(subnetNet.String()),
+		IsDefault:               boolPtr(false),
+		EnableBroadcast:         boolPtr(false),
+		Zone:                    params.Zone,
+		RouteTableId:            stringPtr(routeTableId),
+		CreatedTime:             stringPtr(now()),
+		AvailableIpAddressCount: uint64Ptr(total),
+		TotalIpAddressCount:     uint64Ptr(total),
+		Ipv6CidrBlock:           stringPtr(""),
+		NetworkAclId:            stringPtr(""),
+		IsRemoteVpcSnat:         boolPtr(false),
+		CdcId:                   stringPtr(""),
+		IsCdcSubnet:             int64Ptr(0),
+	}
+	me.subnets[id] = item
+	me.regions[id] = request.Region
+	me.setTags(request.Region, "subnet", id, params.Tags)
+	return map[string]interface{}{"Subnet": me.subnetWithTags(item)}, nil
+}
+
+func (me *vpcFake) subnetWithTags(item *vpc.Subnet) *vpc.Subnet {
+	result := *item
+	result.TagSet = me.tags("subnet", stringValue(item.SubnetId))
+	return &result
+}
+
+func (me *vpcFake) describeSubnets(request *Request) (interface{}, error) {
+	params := vpc.NewDescribeSubnetsRequest()
+	var f filters
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	if err := request.Bind(&f); err != nil {
+		return nil, err
+	}
+
+	ids := stringValues(params.SubnetIds)
+	result := make([]*vpc.Subnet, 0)
+	for _, id := range sortedIds(mapKeys(me.subnets)) {
+		item := me.subnets[id]
+		if me.regions[id] != request.Region || (len(ids) > 0 && !containsAny([]string{id}, ids)) {
+			continue
+		}
+		fields := map[string][]string{
+			"subnet-id":          {id},
+			"subnet-name":        {stringValue(item.SubnetName)},
+			"vpc-id":             {stringValue(item.VpcId)},
+			"cidr-block":         {stringValue(item.CidrBlock)},
+			"zone":               {stringValue(item.Zone)},
+			"is-default":         {boolString(*item.IsDefault)},
+			"is-remote-vpc-snat": {boolString(*item.IsRemoteVpcSnat)},
+		}
+		if !f.match(fields, me.server.resourceTags("vpc", request.Region, "subnet", id)) {
+			continue
+		}
+		result = append(result, me.subnetWithTags(item))
+	}
+	start, end := page(len(result), params.Offset, params.Limit)
+	return map[string]interface{}{"TotalCount": len(result), "SubnetSet": result[start:end]}, nil
+}
+
+func (me *vpcFake) modifySubnetAttribute(request *Request) (interface{}, error) {
+	params := vpc.NewModifySubnetAttributeRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	item, ok := me.subnets[stringValue(params.SubnetId)]
+	if !ok {
+		return nil, NewError("ResourceNotFound", "the subnet %s is not found", stringValue(params.SubnetId))
+	}
+	if params.SubnetName != nil {
+		item.SubnetName = params.SubnetName
+	}
+	if params.EnableBroadcast != nil {
+		item.EnableBroadcast = boolPtr(*params.EnableBroadcast == "true")
+	}
+	return struct{}{}, nil
+}
+
+func (me *vpcFake) deleteSubnet(request *Request) (interface{}, error) {
+	params := vpc.NewDeleteSubnetRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	id := stringValue(params.SubnetId)
+	if _, ok := me.subnets[id]; !ok {
+		return nil, NewError("ResourceNotFound", "the subnet %s is not found", id)
+	}
+	if instanceId := me.server.subnetInstance(id); instanceId != "" {
+		return nil, NewError("ResourceInUse", "the subnet %s is used by %s", id, instanceId)
+	}
+	delete(me.subnets, id)
+	me.server.setResourceTags("vpc", me.regions[id], "subnet", id, nil)
+	delete(me.regions, id)
+	return struct{}{}, nil
+}
+
+func (me *vpcFake) createSecurityGroup(request *Request) (interface{}, error) {
+	params := vpc.NewCreateSecurityGroupRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	projectId := stringValue(params.ProjectId)
+	if projectId == "" {
+		projectId = "0"
+	}
+	id := me.server.NewId("sg")
+	item := &vpc.SecurityGroup{
+		SecurityGroupId:   stringPtr(id),
+		SecurityGroupName: params.GroupName,
+		SecurityGroupDesc: params.GroupDescription,
+		ProjectId:         stringPtr(projectId),
+		IsDefault:         boolPtr(false),
+		CreatedTime:       stringPtr(now()),
+		UpdateTime:        stringPtr(now()),
+	}
+	me.securityGroups[id] = item
+	me.regions[id] = request.Region
+	me.setTags(request.Region, "sg", id, params.Tags)
+	return map[string]interface{}{"SecurityGroup": me.securityGroupWithTags(item)}, nil
+}
+
+func (me *vpcFake) securityGroupWithTags(item *vpc.SecurityGroup) *vpc.SecurityGroup {
+	result := *item
+	result.TagSet = me.tags("sg", stringValue(item.SecurityGroupId))
+	return &result
+}
+
+func (me *vpcFake) describeSecurityGroups(request *Request) (interface{}, error) {
+	params := vpc.NewDescribeSecurityGroupsRequest()
+	var f filters
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	if err := request.Bind(&f); err != nil {
+		return nil, err
+	}
+
+	ids := stringValues(params.SecurityGroupIds)
+	for _, id := range ids {
+		if _, ok := me.securityGroups[id]; !ok || me.regions[id] != request.Region {
+			return nil, NewError("ResourceNotFound", "the security group %s is not found", id)
+		}
+	}
+	result := make([]*vpc.SecurityGroup, 0)
+	for _, id := range sortedIds(mapKeys(me.securityGroups)) {
+		item := me.securityGroups[id]
+		if me.regions[id] != request.Region || (len(ids) > 0 && !containsAny([]string{id}, ids)) {
+			continue
+		}
+		fields := map[string][]string{
+			"security-group-id":   {id},
+			"security-group-name": {stringValue(item.SecurityGroupName)},
+			"project-id":          {stringValue(item.ProjectId)},
+		}
+		if !f.match(fields, me.server.resourceTags("vpc", request.Region, "sg", id)) {
+			continue
+		}
+		result = append(result, me.securityGroupWithTags(item))
+	}
+	start, end := page(len(result), params.Offset, params.Limit)
+	return map[string]interface{}{"TotalCount": len(result), "SecurityGroupSet": result[start:end]}, nil
+}
+
+func (me *vpcFake) modifySecurityGroupAttribute(request *Request) (interface{}, error) {
+	params := vpc.NewModifySecurityGroupAttributeRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	item, ok := me.securityGroups[stringValue(params.SecurityGroupId)]
+	if !ok {
+		return nil, NewError("ResourceNotFound", "the security group %s is not found", stringValue(params.SecurityGroupId))
+	}
+	if params.GroupName != nil {
+		item.SecurityGroupName = params.GroupName
+	}
+	if params.GroupDescription != nil {
+		item.SecurityGroupDesc = params.GroupDescription
+	}
+	item.UpdateTime = stringPtr(now())
+	return struct{}{}, nil
+}
+
+func (me *vpcFake) deleteSecurityGroup(request *Request) (interface{}, error) {
+	params := vpc.NewDeleteSecurityGroupRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	id := stringValue(params.SecurityGroupId)
+	if _, ok := me.securityGroups[id]; !ok {
+		return nil, NewError("ResourceNotFound", "the security group %s is not found", id)
+	}
+	if instanceId := me.server.securityGroupInstance(id); instanceId != "" {
+		return nil, NewError("ResourceInUse", "the security group %s is used by %s", id, instanceId)
+	}
+	delete(me.securityGroups, id)
+	me.server.setResourceTags("vpc", me.regions[id], "sg", id, nil)
+	delete(me.regions, id)
+	return struct{}{}, nil
+}
+
+func (me *vpcFake) describeSecurityGroupAssociationStatistics(request *Request) (interface{}, error) {
+	params := vpc.NewDescribeSecurityGroupAssociationStatisticsRequest()
+	if err := request.Bind(params); err != nil {
+		return nil, err
+	}
+	result := make([]*vpc.SecurityGroupAssociationStatistics, 0)
+	for _, id := range stringValues(params.SecurityGroupIds) {
+		var count uint64
+		if me.server.securityGroupInstance(id) != "" {
+			count = 1
+		}
+		result = append(result, &vpc.SecurityGroupAssociationStatistics{
+			SecurityGroupId:    stringPtr(id),
+			CVM:                uint64Ptr(count),
+			CDB:                uint64Ptr(0),
+			ENI:                uint64Ptr(0),
+			SG:                 uint64Ptr(0),
+			CLB:                uint64Ptr(0),
+			InstanceStatistics: []*vpc.InstanceStatistic{},
+			TotalCount:         uint64Ptr(count),
+		})
+	}
+	return map[string]interface{}{"SecurityGroupAssociationStatisticsSet": result}, nil
+}
@@ -0,0 +1,203 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/mockapi"
+)
+
+// testAccMockapiProviderConfig returns the provider block which points the products of the fakes to server
+func testAccMockapiProviderConfig(server *mockapi.Server) string {
+	endpoints := make([]string, 0, len(mockapi.Products))
+	for _, product := range mockapi.Products {
+		endpoints = append(endpoints, fmt.Sprintf("    %s = %q", product, server.URL))
+	}
+	return fmt.Sprintf(`
+provider "tencentcloud" {
+  secret_id  = %q
+  secret_key = %q
+  region     = "ap-guangzhou"
+
+  endpoints {
+%s
+  }
+}
+`, mockapi.SecretId, mockapi.SecretKey, strings.Join(endpoints, "\n"))
+}
+
+// testMockapiMeta returns the client of the provider which calls server
+func testMockapiMeta(server *mockapi.Server) *TencentCloudClient {
+	endpoints := make(map[string]string, len(mockapi.Products))
+	for _, product := range mockapi.Products {
+		endpoints[product] = server.URL
+	}
+	return &TencentCloudClient{apiV3Conn: &connectivity.TencentCloudClient{
+		Credential: common.NewCredential(mockapi.SecretId, mockapi.SecretKey),
+		Region:     "ap-guangzhou",
+		Endpoints:  endpoints,
+	}}
+}
+
+// testMockapiApply plans and applies config of the resource name, a nil config destroys the resource
+func testMockapiApply(t *testing.T, meta *TencentCloudClient, name string, state *terraform.InstanceState, config map[string]interface{}) *terraform.InstanceState {
+	t.Helper()
+	r := Provider().ResourcesMap[name]
+	diff := &terraform.InstanceDiff{Destroy: true}
+	if config != nil {
+		var err error
+		if diff, err = r.SimpleDiff(context.TODO(), state, terraform.NewResourceConfigRaw(config), meta); err != nil {
+			t.Fatalf("plan %s failed: %v", name, err)
+		}
+	}
+	newState, diags := r.Apply(context.TODO(), state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("apply %s failed: %v", name, diags)
+	}
+	if config != nil && (newState == nil || newState.ID == "") {
+		t.Fatalf("%s is not created", name)
+	}
+	return newState
+}
+
+func TestMockapiResourcesLifecycle(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	meta := testMockapiMeta(server)
+
+	vpc := testMockapiApply(t, meta, "tencentcloud_vpc", nil, map[string]interface{}{
+		"name":       "mockapi-vpc",
+		"cidr_block": "10.0.0.0/16",
+		"tags":       map[string]interface{}{"test": "mockapi"},
+	})
+	vpc = testMockapiApply(t, meta, "tencentcloud_vpc", vpc, map[string]interface{}{
+		"name":       "mockapi-vpc-updated",
+		"cidr_block": "10.0.0.0/16",
+		"tags":       map[string]interface{}{"test": "updated"},
+	})
+	if vpc.Attributes["name"] != "mockapi-vpc-updated" || vpc.Attributes["tags.test"] != "updated" {
+		t.Errorf("unexpected vpc %v", vpc.Attributes)
+	}
+
+	subnet := testMockapiApply(t, meta, "tencentcloud_subnet", nil, map[string]interface{}{
+		"name":              "mockapi-subnet",
+		"vpc_id":            vpc.ID,
+		"cidr_block":        "10.0.1.0/24",
+		"availability_zone": "ap-guangzhou-3",
+	})
+	sg := testMockapiApply(t, meta, "tencentcloud_security_group", nil, map[string]interface{}{
+		"name":        "mockapi-sg",
+		"description": "mockapi",
+	})
+	disk := testMockapiApply(t, meta, "tencentcloud_cbs_storage", nil, map[string]interface{}{
+		"storage_name":      "mockapi-disk",
+		"storage_type":      "CLOUD_PREMIUM",
+		"storage_size":      50,
+		"availability_zone": "ap-guangzhou-3",
+		"force_delete":      true,
+	})
+	instance := testMockapiApply(t, meta, "tencentcloud_instance", nil, map[string]interface{}{
+		"instance_name":           "mockapi-instance",
+		"availability_zone":       "ap-guangzhou-3",
+		"image_id":                "img-mockapi0",
+		"instance_type":           "S5.MEDIUM2",
+		"vpc_id":                  vpc.ID,
+		"subnet_id":               subnet.ID,
+		"orderly_security_groups": []interface{}{sg.ID},
+		"system_disk_type":        "CLOUD_PREMIUM",
+		"data_disks": []interface{}{
+			map[string]interface{}{"data_disk_type": "CLOUD_PREMIUM", "data_disk_size": 50},
+		},
+		"tags": map[string]interface{}{"test": "mockapi"},
+	})
+	if instance.Attributes["instance_status"] != CVM_STATUS_RUNNING || instance.Attributes["private_ip"] == "" ||
+		instance.Attributes["data_disks.0.data_disk_id"] == "" || instance.Attributes["tags.test"] != "mockapi" {
+		t.Errorf("unexpected instance %v", instance.Attributes)
+	}
+
+	for _, r := range []struct {
+		name  string
+		state *terraform.InstanceState
+	}{
+		{"tencentcloud_instance", instance},
+		{"tencentcloud_cbs_storage", disk},
+		{"tencentcloud_security_group", sg},
+		{"tencentcloud_subnet", subnet},
+		{"tencentcloud_vpc", vpc},
+	} {
+		testMockapiApply(t, meta, r.name, r.state, nil)
+		state, diags := Provider().ResourcesMap[r.name].RefreshWithoutUpgrade(context.TODO(), r.state, meta)
+		if diags.HasError() || (state != nil && state.ID != "") {
+			t.Errorf("%s %s is not deleted: %v", r.name, r.state.ID, diags)
+		}
+	}
+}
+
+func TestAccTencentCloudMockapiVpc_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMockapiProviderConfig(server) + testAccMockapiVpc,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tencentcloud_vpc.vpc", "cidr_block", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr("tencentcloud_subnet.subnet", "cidr_block", "10.0.1.0/24"),
+					resource.TestCheckResourceAttrSet("tencentcloud_instance.instance", "private_ip"),
+					resource.TestCheckResourceAttr("tencentcloud_instance.instance", "instance_status", CVM_STATUS_RUNNING),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_storage.disk", "storage_size", "50"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_vpc.vpc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccMockapiVpc = `
+resource "tencentcloud_vpc" "vpc" {
+  name       = "mockapi-vpc"
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "tencentcloud_subnet" "subnet" {
+  name              = "mockapi-subnet"
+  vpc_id            = tencentcloud_vpc.vpc.id
+  cidr_block        = "10.0.1.0/24"
+  availability_zone = "ap-guangzhou-3"
+}
+
+resource "tencentcloud_security_group" "sg" {
+  name = "mockapi-sg"
+}
+
+resource "tencentcloud_instance" "instance" {
+  instance_name           = "mockapi-instance"
+  availability_zone       = "ap-guangzhou-3"
+  image_id                = "img-mockapi0"
+  instance_type           = "S5.MEDIUM2"
+  vpc_id                  = tencentcloud_vpc.vpc.id
+  subnet_id               = tencentcloud_subnet.subnet.id
+  orderly_security_groups = [tencentcloud_security_group.sg.id]
+  system_disk_type        = "CLOUD_PREMIUM"
+}
+
+resource "tencentcloud_cbs_storage" "disk" {
+  storage_name      = "mockapi-disk"
+  storage_type      = "CLOUD_PREMIUM"
+  storage_size      = 50
+  availability_zone = "ap-guangzhou-3"
+  force_delete      = true
+}
+`
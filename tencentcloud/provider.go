@@ -141,6 +141,7 @@ Auto Scaling(AS)
 	tencentcloud_as_scaling_group_status
     tencentcloud_as_attachment
     tencentcloud_as_scaling_policy
+    tencentcloud_as_target_tracking_policy
     tencentcloud_as_schedule
     tencentcloud_as_lifecycle_hook
     tencentcloud_as_notification
@@ -546,6 +547,7 @@ Tencent Kubernetes Engine(TKE)
     tencentcloud_kubernetes_cluster_common_names
 	tencentcloud_kubernetes_available_cluster_versions
 	tencentcloud_kubernetes_cluster_authentication_options
+	tencentcloud_kubernetes_cluster_auth
 
   Resource
     tencentcloud_kubernetes_cluster
@@ -1585,9 +1587,16 @@ ClickHouse(CDWCH)
 package tencentcloud
 
 import (
+	"encoding/json"
+	"fmt"
+	"log"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
@@ -1607,41 +1616,74 @@ const (
 	PROVIDER_ASSUME_ROLE_ARN              = "TENCENTCLOUD_ASSUME_ROLE_ARN"
 	PROVIDER_ASSUME_ROLE_SESSION_NAME     = "TENCENTCLOUD_ASSUME_ROLE_SESSION_NAME"
 	PROVIDER_ASSUME_ROLE_SESSION_DURATION = "TENCENTCLOUD_ASSUME_ROLE_SESSION_DURATION"
+	PROVIDER_ASSUME_ROLE_EXTERNAL_ID      = "TENCENTCLOUD_ASSUME_ROLE_EXTERNAL_ID"
+	PROVIDER_SHARED_CREDENTIALS_DIR       = "TENCENTCLOUD_SHARED_CREDENTIALS_DIR"
+	PROVIDER_PROFILE                      = "TENCENTCLOUD_PROFILE"
+	PROVIDER_CAM_ROLE_NAME                = "TENCENTCLOUD_CAM_ROLE_NAME"
+	PROVIDER_METADATA_ENDPOINT            = "TENCENTCLOUD_METADATA_ENDPOINT"
+	PROVIDER_LOG_FULL_PAYLOAD             = "TENCENTCLOUD_LOG_FULL_PAYLOAD"
+	PROVIDER_CA_BUNDLE_FILE               = "TENCENTCLOUD_CA_BUNDLE_FILE"
+)
+
+const (
+	DEFAULT_SHARED_CREDENTIALS_DIR = "~/.tccli"
+	DEFAULT_PROFILE                = "default"
+)
+
+// sources of the provider credential settings, in order of precedence
+const (
+	PROVIDER_SOURCE_CONFIG  = "provider config"
+	PROVIDER_SOURCE_ENV     = "environment variable"
+	PROVIDER_SOURCE_PROFILE = "shared credentials profile"
 )
 
 type TencentCloudClient struct {
-	apiV3Conn *connectivity.TencentCloudClient
+	apiV3Conn   *connectivity.TencentCloudClient
+	defaultTags map[string]string
+	ignoreTags  *ignoreTagsConfig
 }
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"secret_id": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_SECRET_ID, nil),
-				Description: "This is the TencentCloud access key. It must be provided, but it can also be sourced from the `TENCENTCLOUD_SECRET_ID` environment variable.",
+				Optional:    true,
+				Description: "This is the TencentCloud access key. It must be provided, but it can also be sourced from the `TENCENTCLOUD_SECRET_ID` environment variable or the shared credentials profile.",
 			},
 			"secret_key": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_SECRET_KEY, nil),
-				Description: "This is the TencentCloud secret key. It must be provided, but it can also be sourced from the `TENCENTCLOUD_SECRET_KEY` environment variable.",
+				Optional:    true,
+				Description: "This is the TencentCloud secret key. It must be provided, but it can also be sourced from the `TENCENTCLOUD_SECRET_KEY` environment variable or the shared credentials profile.",
 				Sensitive:   true,
 			},
 			"security_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_SECURITY_TOKEN, nil),
-				Description: "TencentCloud Security Token of temporary access credentials. It can be sourced from the `TENCENTCLOUD_SECURITY_TOKEN` environment variable. Notice: for supported products, please refer to: [temporary key supported products](https://intl.cloud.tencent.com/document/product/598/10588).",
+				Description: "TencentCloud Security Token of temporary access credentials. It can be sourced from the `TENCENTCLOUD_SECURITY_TOKEN` environment variable or the shared credentials profile. Notice: for supported products, please refer to: [temporary key supported products](https://intl.cloud.tencent.com/document/product/598/10588).",
 				Sensitive:   true,
 			},
 			"region": {
-				Type:         schema.TypeString,
-				Required:     true,
-				DefaultFunc:  schema.EnvDefaultFunc(PROVIDER_REGION, nil),
-				Description:  "This is the TencentCloud region. It must be provided, but it can also be sourced from the `TENCENTCLOUD_REGION` environment variables. The default input value is ap-guangzhou.",
-				InputDefault: "ap-guangzhou",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "This is the TencentCloud region. It must be provided, but it can also be sourced from the `TENCENTCLOUD_REGION` environment variables or the shared credentials profile.",
+			},
+			"shared_credentials_dir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_SHARED_CREDENTIALS_DIR, nil),
+				Description: "The directory of the shared credentials written by `tccli configure`. It can also be sourced from the `TENCENTCLOUD_SHARED_CREDENTIALS_DIR` environment variable. If not set this defaults to `~/.tccli`.",
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_PROFILE, nil),
+				Description: "The profile name as set in the shared credentials directory, the provider reads `<profile>.credential` and `<profile>.configure` from it. It can also be sourced from the `TENCENTCLOUD_PROFILE` environment variable. If not set, the `default` profile will be used.",
+			},
+			"cam_role_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the CAM role bound to the CVM which terraform runs on. If set, the provider gets the temporary credential of the role from the instance metadata service and refreshes it before expiration, `secret_id` and `secret_key` are not required then. It can also be sourced from the `TENCENTCLOUD_CAM_ROLE_NAME` environment variable.",
 			},
 			"protocol": {
 				Type:         schema.TypeString,
@@ -1657,10 +1699,9 @@ func Provider() *schema.Provider {
 				Description: "The root domain of the API request, Default is `tencentcloudapi.com`.",
 			},
 			"assume_role": {
-				Type:        schema.TypeSet,
+				Type:        schema.TypeList,
 				Optional:    true,
-				MaxItems:    1,
-				Description: "The `assume_role` block. If provided, terraform will attempt to assume this role using the supplied credentials.",
+				Description: "The `assume_role` block. If provided, terraform will attempt to assume this role using the supplied credentials. The assumed credential is refreshed before it expires. Multiple blocks are assumed in order, each one with the credential of the previous role, which makes a role chain.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"role_arn": {
@@ -1692,6 +1733,188 @@ func Provider() *schema.Provider {
 							Optional:    true,
 							Description: "A more restrictive policy when making the AssumeRole call. Its content must not contains `principal` elements. Notice: more syntax references, please refer to: [policies syntax logic](https://intl.cloud.tencent.com/document/product/598/10603).",
 						},
+						"external_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							DefaultFunc: schema.EnvDefaultFunc(PROVIDER_ASSUME_ROLE_EXTERNAL_ID, nil),
+							Description: "The external id of the role to assume, it is required if the trust policy of the role has a `qcs:external_id` condition. It can be sourced from the `TENCENTCLOUD_ASSUME_ROLE_EXTERNAL_ID`.",
+						},
+					},
+				},
+			},
+			"proxy_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateProxyURL,
+				Description:  "The proxy of the API requests, like `http://proxy.example.com:8080`. The `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables are used if it is not set.",
+			},
+			"ca_bundle_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_CA_BUNDLE_FILE, nil),
+				Description: "The PEM file of the CA certificates trusted besides the system ones, like the CA of a corporate proxy. It can also be sourced from the `TENCENTCLOUD_CA_BUNDLE_FILE` environment variable.",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to skip the verification of the server certificates. It is insecure and only for testing. Default is `false`.",
+			},
+			"min_tls_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"1.0", "1.1", "1.2", "1.3"}),
+				Description:  "The min TLS version of the API requests. Valid values: `1.0`, `1.1`, `1.2`, `1.3`. Default is `1.2`.",
+			},
+			"connect_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validateIntegerMin(1),
+				Description:  "The timeout in seconds of establishing a connection to the API endpoints. Default is `30`.",
+			},
+			"tls_handshake_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validateIntegerMin(1),
+				Description:  "The timeout in seconds of the TLS handshake with the API endpoints. Default is `10`.",
+			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `endpoints` block, it overrides the API endpoints of products, like `cvm = \"cvm.internal.example.com\"`.",
+				Elem: &schema.Resource{
+					Schema: endpointsSchema(),
+				},
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `default_tags` block, it defines the tags applied to all the resources which support tags.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The tags merged into the `tags` of every resource, the `tags` of the resource win on conflict.",
+						},
+					},
+				},
+			},
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `ignore_tags` block, it defines the tags managed outside terraform, which are ignored by all the resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The tag keys to ignore.",
+						},
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The prefixes of the tag keys to ignore, like `tke-`.",
+						},
+					},
+				},
+			},
+			"log": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `log` block, it defines how the API requests and responses are logged.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"full_payload": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether to log the request and response bodies verbatim without redacting the sensitive fields. It is only for debugging, the logs may contain passwords and secret keys. It can also be enabled by the `TENCENTCLOUD_LOG_FULL_PAYLOAD` environment variable. Default is `false`.",
+						},
+						"redacted_fields": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The extra sensitive fields redacted from the logs besides the default ones like `password`, `secretkey`, `privatekey` and `token`. A field is redacted if its name contains any of them case-insensitively.",
+						},
+					},
+				},
+			},
+			"api_rate_limits": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeInt},
+				ValidateFunc: validateApiRateLimits,
+				Description:  "The max QPS of the API requests. The key is a product like `cvm`, which applies to each action of the product, or `product.Action` like `cvm.RunInstances`, which applies to the action only. The requests exceeding the limit wait for their turn. The limits apply to the requests of this provider configuration only. Default is `15` for each action.",
+			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The `retry` block, it defines how the failed API requests are retried. The policy applies to the requests of this provider configuration only, except the read and write timeouts.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validateIntegerMin(0),
+							Description:  "The max attempts of a request which fails with the rate limit or network errors, which are retried by the API client. The retryable errors returned to the resources are retried until the read or write timeout, except the ones of COS and CI, which stop at the max attempts as well. Default is `0`, which means retrying until the read or write timeout.",
+						},
+						"min_backoff": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validateIntegerMin(1),
+							Description:  "The initial backoff in seconds between two attempts of the requests limited by `max_attempts`, it doubles on each attempt with a random jitter. Default is `1`.",
+						},
+						"max_backoff": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validateIntegerMin(1),
+							Description:  "The max backoff in seconds between two attempts of the requests limited by `max_attempts`. Default is `30`.",
+						},
+						"read_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validateIntegerMin(1),
+							Description:  "The timeout in minutes of retrying the read requests, it applies to all the provider configurations, so the aliases must not set different ones. It can also be sourced from the `TENCENTCLOUD_READ_RETRY_TIMEOUT` environment variable, default is `3`.",
+						},
+						"write_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validateIntegerMin(1),
+							Description:  "The timeout in minutes of retrying the write requests, it applies to all the provider configurations, so the aliases must not set different ones. It can also be sourced from the `TENCENTCLOUD_WRITE_RETRY_TIMEOUT` environment variable, default is `5`.",
+						},
+						"retryable_error_codes": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The extra retryable error codes of products.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"product": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Default:     connectivity.RetryAllProducts,
+										Description: "The product of the error codes, like `cvm` or `cbs`, which is the prefix of the API endpoint. Default is `*`, which means all products.",
+									},
+									"codes": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "The error codes, a code without the dot suffix like `ResourceInsufficient` also matches `ResourceInsufficient.CloudDiskUnavailable`.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -1782,6 +2005,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_kubernetes_cluster_levels":                 datasourceTencentCloudKubernetesClusterLevels(),
 			"tencentcloud_kubernetes_cluster_common_names":           datasourceTencentCloudKubernetesClusterCommonNames(),
 			"tencentcloud_kubernetes_cluster_authentication_options": dataSourceTencentCloudKubernetesClusterAuthenticationOptions(),
+			"tencentcloud_kubernetes_cluster_auth":                   dataSourceTencentCloudKubernetesClusterAuth(),
 			"tencentcloud_kubernetes_available_cluster_versions":     dataSourceTencentCloudKubernetesAvailableClusterVersions(),
 			"tencentcloud_eks_clusters":                              dataSourceTencentCloudEKSClusters(),
 			"tencentcloud_eks_cluster_credential":                    datasourceTencentCloudEksClusterCredential(),
@@ -2412,6 +2636,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_as_scaling_group_status":                             resourceTencentCloudAsScalingGroupStatus(),
 			"tencentcloud_as_attachment":                                       resourceTencentCloudAsAttachment(),
 			"tencentcloud_as_scaling_policy":                                   resourceTencentCloudAsScalingPolicy(),
+			"tencentcloud_as_target_tracking_policy":                           resourceTencentCloudAsTargetTrackingPolicy(),
 			"tencentcloud_as_schedule":                                         resourceTencentCloudAsSchedule(),
 			"tencentcloud_as_lifecycle_hook":                                   resourceTencentCloudAsLifecycleHook(),
 			"tencentcloud_as_notification":                                     resourceTencentCloudAsNotification(),
@@ -2937,27 +3162,106 @@ func Provider() *schema.Provider {
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	for name, resource := range provider.ResourcesMap {
+		if !defaultTagsExcludedResources[name] {
+			withDefaultTags(resource)
+		}
+		withIgnoreTags(resource)
+		if isRegionalResource(name, resource) {
+			withRegion(resource, false)
+		}
+		withContext(resource)
+	}
+	for name, dataSource := range provider.DataSourcesMap {
+		if isRegionalResource(name, dataSource) {
+			withRegion(dataSource, true)
+		}
+		withContext(dataSource)
+	}
+	return provider
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	secretId := d.Get("secret_id").(string)
-	secretKey := d.Get("secret_key").(string)
-	securityToken := d.Get("security_token").(string)
-	region := d.Get("region").(string)
+	if err := checkInvalidEnvs(); err != nil {
+		return nil, err
+	}
+	retryPolicy, err := getRetryPolicy(d)
+	if err != nil {
+		return nil, err
+	}
+
+	logConfig, err := getLogConfig(d)
+	if err != nil {
+		return nil, err
+	}
+	connectivity.SetLogConfig(logConfig)
+
+	limits := make(map[string]int64)
+	if v, ok := d.GetOk("api_rate_limits"); ok {
+		for key, limit := range v.(map[string]interface{}) {
+			limits[key] = int64(limit.(int))
+		}
+	}
+
+	profile, err := loadSharedCredentialsProfile(d.Get("shared_credentials_dir").(string), d.Get("profile").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	secretId, secretKey, securityToken, credentialSource := getProviderCredential(d, profile)
+	camRoleName, camRoleNameSource := getProviderConfig(d, "cam_role_name", PROVIDER_CAM_ROLE_NAME, profile, "")
+	region, regionSource := getProviderConfig(d, "region", PROVIDER_REGION, profile, profile.Region)
 	protocol := d.Get("protocol").(string)
 	domain := d.Get("domain").(string)
 
-	// standard client
-	var tcClient TencentCloudClient
-	tcClient.apiV3Conn = &connectivity.TencentCloudClient{
-		Credential: common.NewTokenCredential(
+	if region == "" {
+		return nil, fmt.Errorf("`region` must be provided by the provider config, `%s` or %s", PROVIDER_REGION, profile.describe())
+	}
+
+	var credential common.CredentialIface
+	if camRoleName != "" {
+		// the role credential is temporary, it refreshes itself from the metadata service before expiration
+		roleCredential, err := connectivity.NewCvmRoleCredential(os.Getenv(PROVIDER_METADATA_ENDPOINT), camRoleName)
+		if err != nil {
+			return nil, fmt.Errorf("`cam_role_name` is set from %s, but %v", camRoleNameSource, err)
+		}
+		credential = roleCredential
+		log.Printf("[INFO] using credential of %s from %s and region %s from %s", roleCredential.Source(), camRoleNameSource, region, regionSource)
+	} else {
+		if secretId == "" || secretKey == "" {
+			if secretId != "" {
+				return nil, fmt.Errorf("`secret_id` is set from %s, but `secret_key` is missing in it", credentialSource)
+			}
+			if secretKey != "" {
+				return nil, fmt.Errorf("`secret_key` is set from %s, but `secret_id` is missing in it", credentialSource)
+			}
+			return nil, fmt.Errorf("`secret_id` and `secret_key` or `cam_role_name` must be provided by the provider config, `%s`/`%s`/`%s` or %s", PROVIDER_SECRET_ID, PROVIDER_SECRET_KEY, PROVIDER_CAM_ROLE_NAME, profile.describe())
+		}
+		credential = common.NewTokenCredential(
 			secretId,
 			secretKey,
 			securityToken,
-		),
-		Region:   region,
-		Protocol: protocol,
-		Domain:   domain,
+		)
+		log.Printf("[INFO] using credential from %s and region %s from %s", credentialSource, region, regionSource)
+	}
+
+	transport, err := connectivity.NewTransport(getTransportConfig(d))
+	if err != nil {
+		return nil, err
+	}
+
+	// standard client
+	var tcClient TencentCloudClient
+	tcClient.apiV3Conn = &connectivity.TencentCloudClient{
+		Credential:    credential,
+		Region:        region,
+		Protocol:      protocol,
+		Domain:        domain,
+		RetryPolicy:   retryPolicy,
+		Endpoints:     getEndpoints(d),
+		HTTPTransport: transport,
+		RateLimits:    ratelimit.NewLimits(limits),
 	}
 
 	envRoleArn := os.Getenv(PROVIDER_ASSUME_ROLE_ARN)
@@ -2970,49 +3274,350 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			var err error
 			assumeRoleSessionDuration, err = strconv.Atoi(envSessionDuration)
 			if err != nil {
-				return nil, err
+				return nil, fmt.Errorf("`%s` must be int: %v", PROVIDER_ASSUME_ROLE_SESSION_DURATION, err)
 			}
 		}
 		if assumeRoleSessionDuration == 0 {
 			assumeRoleSessionDuration = 7200
 		}
 
-		_ = genClientWithSTS(&tcClient, envRoleArn, envSessionName, assumeRoleSessionDuration, "")
+		assumeRole := assumeRoleConfig{
+			RoleArn:         envRoleArn,
+			SessionName:     envSessionName,
+			SessionDuration: assumeRoleSessionDuration,
+			ExternalId:      os.Getenv(PROVIDER_ASSUME_ROLE_EXTERNAL_ID),
+		}
+		if err := genClientWithSTS(&tcClient, assumeRole); err != nil {
+			return nil, fmt.Errorf("assume role `%s` from %s `%s` failed: %v", envRoleArn, PROVIDER_SOURCE_ENV, PROVIDER_ASSUME_ROLE_ARN, err)
+		}
 	}
 
-	// get assume role from tf config
-	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
-	if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
-		assumeRoleArn := assumeRole["role_arn"].(string)
-		assumeRoleSessionName := assumeRole["session_name"].(string)
-		assumeRoleSessionDuration := assumeRole["session_duration"].(int)
-		assumeRolePolicy := assumeRole["policy"].(string)
-
-		_ = genClientWithSTS(&tcClient, assumeRoleArn, assumeRoleSessionName, assumeRoleSessionDuration, assumeRolePolicy)
+	// get assume role from tf config, every role is assumed with the credential of the previous one
+	for i, v := range d.Get("assume_role").([]interface{}) {
+		item := v.(map[string]interface{})
+		assumeRole := assumeRoleConfig{
+			RoleArn:         item["role_arn"].(string),
+			SessionName:     item["session_name"].(string),
+			SessionDuration: item["session_duration"].(int),
+			Policy:          item["policy"].(string),
+			ExternalId:      item["external_id"].(string),
+		}
+		if err := genClientWithSTS(&tcClient, assumeRole); err != nil {
+			return nil, fmt.Errorf("assume role `%s` from %s `assume_role.%d` failed: %v", assumeRole.RoleArn, PROVIDER_SOURCE_CONFIG, i, err)
+		}
+	}
+	tcClient.defaultTags = helper.GetTags(d, "default_tags.0.tags")
+	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		ignoreTags := v.([]interface{})[0].(map[string]interface{})
+		tcClient.ignoreTags = &ignoreTagsConfig{keys: make(map[string]bool)}
+		for _, key := range ignoreTags["keys"].(*schema.Set).List() {
+			tcClient.ignoreTags.keys[key.(string)] = true
+		}
+		for _, prefix := range ignoreTags["key_prefixes"].(*schema.Set).List() {
+			if prefix.(string) != "" {
+				tcClient.ignoreTags.keyPrefixes = append(tcClient.ignoreTags.keyPrefixes, prefix.(string))
+			}
+		}
 	}
+
 	return &tcClient, nil
 }
 
-func genClientWithSTS(tcClient *TencentCloudClient, assumeRoleArn, assumeRoleSessionName string, assumeRoleSessionDuration int, assumeRolePolicy string) error {
-	// applying STS credentials
-	request := sts.NewAssumeRoleRequest()
-	request.RoleArn = helper.String(assumeRoleArn)
-	request.RoleSessionName = helper.String(assumeRoleSessionName)
-	request.DurationSeconds = helper.IntUint64(assumeRoleSessionDuration)
-	if assumeRolePolicy != "" {
-		request.Policy = helper.String(url.QueryEscape(assumeRolePolicy))
+type assumeRoleConfig struct {
+	RoleArn         string
+	SessionName     string
+	SessionDuration int
+	Policy          string
+	ExternalId      string
+}
+
+// genClientWithSTS replaces the credential of client with the credential of the assumed role,
+// which calls AssumeRole again with the current credential before it expires.
+func genClientWithSTS(tcClient *TencentCloudClient, assumeRole assumeRoleConfig) error {
+	// the credential of client will be replaced, so keep a sts client with the source credential
+	sourceConn := &connectivity.TencentCloudClient{
+		Credential:    tcClient.apiV3Conn.Credential,
+		Region:        tcClient.apiV3Conn.Region,
+		Protocol:      tcClient.apiV3Conn.Protocol,
+		Domain:        tcClient.apiV3Conn.Domain,
+		RetryPolicy:   tcClient.apiV3Conn.RetryPolicy,
+		Endpoints:     tcClient.apiV3Conn.Endpoints,
+		HTTPTransport: tcClient.apiV3Conn.HTTPTransport,
+		RateLimits:    tcClient.apiV3Conn.RateLimits,
+	}
+
+	fetch := func() (*connectivity.TemporaryCredential, error) {
+		// applying STS credentials
+		request := sts.NewAssumeRoleRequest()
+		request.RoleArn = helper.String(assumeRole.RoleArn)
+		request.RoleSessionName = helper.String(assumeRole.SessionName)
+		request.DurationSeconds = helper.IntUint64(assumeRole.SessionDuration)
+		if assumeRole.Policy != "" {
+			request.Policy = helper.String(url.QueryEscape(assumeRole.Policy))
+		}
+		if assumeRole.ExternalId != "" {
+			request.ExternalId = helper.String(assumeRole.ExternalId)
+		}
+		response, err := sourceConn.UseStsClient().AssumeRole(request)
+		if err != nil {
+			return nil, err
+		}
+		if response == nil || response.Response == nil || response.Response.Credentials == nil {
+			return nil, fmt.Errorf("AssumeRole returns empty credentials")
+		}
+
+		credentials := response.Response.Credentials
+		result := &connectivity.TemporaryCredential{
+			SecretId:  helper.PString(credentials.TmpSecretId),
+			SecretKey: helper.PString(credentials.TmpSecretKey),
+			Token:     helper.PString(credentials.Token),
+		}
+		if response.Response.ExpiredTime != nil {
+			result.ExpiredTime = time.Unix(*response.Response.ExpiredTime, 0)
+		}
+		return result, nil
 	}
-	ratelimit.Check(request.GetAction())
-	response, err := tcClient.apiV3Conn.UseStsClient().AssumeRole(request)
+
+	// using STS credentials
+	credential, err := connectivity.NewRefreshableCredential(fmt.Sprintf("assume role `%s`", assumeRole.RoleArn), fetch)
 	if err != nil {
 		return err
 	}
-	// using STS credentials
-	tcClient.apiV3Conn.Credential = common.NewTokenCredential(
-		*response.Response.Credentials.TmpSecretId,
-		*response.Response.Credentials.TmpSecretKey,
-		*response.Response.Credentials.Token,
-	)
+	tcClient.apiV3Conn.Credential = credential
+	return nil
+}
+
+// sharedCredentialsProfile is the credential and configure of a tccli profile
+type sharedCredentialsProfile struct {
+	Name      string
+	Dir       string
+	SecretId  string
+	SecretKey string
+	Token     string
+	Region    string
+	loaded    bool
+}
+
+func (me *sharedCredentialsProfile) describe() string {
+	if !me.loaded {
+		return fmt.Sprintf("%s `%s` (not found in %s)", PROVIDER_SOURCE_PROFILE, me.Name, me.Dir)
+	}
+	return fmt.Sprintf("%s `%s` (%s)", PROVIDER_SOURCE_PROFILE, me.Name, me.Dir)
+}
+
+// loadSharedCredentialsProfile reads `<profile>.credential` and `<profile>.configure` written by tccli.
+// A broken default profile is ignored, while a profile which is set explicitly must be readable.
+func loadSharedCredentialsProfile(dir, name string) (*sharedCredentialsProfile, error) {
+	explicit := dir != "" || name != ""
+	if dir == "" {
+		dir = DEFAULT_SHARED_CREDENTIALS_DIR
+	}
+	if name == "" {
+		name = DEFAULT_PROFILE
+	}
+	profile := &sharedCredentialsProfile{Name: name, Dir: dir}
+
+	err := profile.load()
+	if err != nil {
+		if explicit {
+			return nil, err
+		}
+		log.Printf("[WARN] ignore %s: %v", profile.describe(), err)
+		return &sharedCredentialsProfile{Name: name, Dir: dir}, nil
+	}
+	return profile, nil
+}
+
+func (me *sharedCredentialsProfile) load() error {
+	credentialFile := filepath.Join(me.Dir, me.Name+".credential")
+	credentialContent, err := ReadFromFile(credentialFile)
+	if err != nil {
+		return fmt.Errorf("read %s `%s` failed: %v", PROVIDER_SOURCE_PROFILE, me.Name, err)
+	}
+	var credential struct {
+		SecretId  string `json:"secretId"`
+		SecretKey string `json:"secretKey"`
+		Token     string `json:"token"`
+	}
+	if err := json.Unmarshal(credentialContent, &credential); err != nil {
+		return fmt.Errorf("parse %s `%s` file %s failed: %v", PROVIDER_SOURCE_PROFILE, me.Name, credentialFile, err)
+	}
+	me.SecretId = credential.SecretId
+	me.SecretKey = credential.SecretKey
+	me.Token = credential.Token
+	me.loaded = true
+
+	// configure file is optional, it only provides the default region
+	configureFile := filepath.Join(me.Dir, me.Name+".configure")
+	configureContent, err := ReadFromFile(configureFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("read %s `%s` failed: %v", PROVIDER_SOURCE_PROFILE, me.Name, err)
+	}
+	var configure struct {
+		SysParam struct {
+			Region string `json:"region"`
+		} `json:"_sys_param"`
+	}
+	if err := json.Unmarshal(configureContent, &configure); err != nil {
+		return fmt.Errorf("parse %s `%s` file %s failed: %v", PROVIDER_SOURCE_PROFILE, me.Name, configureFile, err)
+	}
+	me.Region = configure.SysParam.Region
+
+	return nil
+}
+
+// getProviderConfig resolves a provider setting in the order of provider config, environment variable and profile,
+// it returns the value and the name of the source it comes from.
+func getProviderConfig(d *schema.ResourceData, key, envKey string, profile *sharedCredentialsProfile, profileValue string) (string, string) {
+	if v, ok := d.GetOk(key); ok && v.(string) != "" {
+		return v.(string), PROVIDER_SOURCE_CONFIG
+	}
+	if v := os.Getenv(envKey); v != "" {
+		return v, fmt.Sprintf("%s `%s`", PROVIDER_SOURCE_ENV, envKey)
+	}
+	if profileValue != "" {
+		return profileValue, profile.describe()
+	}
+	return "", ""
+}
+
+// getProviderCredential resolves `secret_id`, `secret_key` and `security_token` together from the first source which
+// sets any of the key pair, in the order of provider config, environment variables and profile. The three values of
+// a credential are never mixed from different sources.
+func getProviderCredential(d *schema.ResourceData, profile *sharedCredentialsProfile) (secretId, secretKey, securityToken, source string) {
+	secretId = d.Get("secret_id").(string)
+	secretKey = d.Get("secret_key").(string)
+	if secretId != "" || secretKey != "" {
+		return secretId, secretKey, d.Get("security_token").(string), PROVIDER_SOURCE_CONFIG
+	}
+	secretId = os.Getenv(PROVIDER_SECRET_ID)
+	secretKey = os.Getenv(PROVIDER_SECRET_KEY)
+	if secretId != "" || secretKey != "" {
+		return secretId, secretKey, os.Getenv(PROVIDER_SECURITY_TOKEN),
+			fmt.Sprintf("%s `%s`/`%s`/`%s`", PROVIDER_SOURCE_ENV, PROVIDER_SECRET_ID, PROVIDER_SECRET_KEY, PROVIDER_SECURITY_TOKEN)
+	}
+	return profile.SecretId, profile.SecretKey, profile.Token, profile.describe()
+}
+
+// endpointsSchema returns the schema of `endpoints`, each product of connectivity.EndpointProducts is an attribute
+func endpointsSchema() map[string]*schema.Schema {
+	endpoints := make(map[string]*schema.Schema, len(connectivity.EndpointProducts))
+	for _, product := range connectivity.EndpointProducts {
+		endpoints[strings.ReplaceAll(product, "-", "_")] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateEndpoint,
+			Description:  fmt.Sprintf("The endpoint of `%s`, a host like `%s.internal.example.com` or a URL like `http://127.0.0.1:8080`.", product, product),
+		}
+	}
+	return endpoints
+}
+
+func getEndpoints(d *schema.ResourceData) map[string]string {
+	endpoints := make(map[string]string)
+	for _, product := range connectivity.EndpointProducts {
+		if v, ok := d.GetOk("endpoints.0." + strings.ReplaceAll(product, "-", "_")); ok {
+			endpoints[product] = v.(string)
+		}
+	}
+	return endpoints
+}
+
+func getTransportConfig(d *schema.ResourceData) connectivity.TransportConfig {
+	return connectivity.TransportConfig{
+		ProxyURL:            d.Get("proxy_url").(string),
+		CABundleFile:        d.Get("ca_bundle_file").(string),
+		InsecureSkipVerify:  d.Get("insecure_skip_verify").(bool),
+		MinTLSVersion:       d.Get("min_tls_version").(string),
+		ConnectTimeout:      time.Duration(d.Get("connect_timeout").(int)) * time.Second,
+		TLSHandshakeTimeout: time.Duration(d.Get("tls_handshake_timeout").(int)) * time.Second,
+	}
+}
+
+func getLogConfig(d *schema.ResourceData) (config connectivity.LogConfig, err error) {
+	if v := os.Getenv(PROVIDER_LOG_FULL_PAYLOAD); v != "" {
+		if config.FullPayload, err = strconv.ParseBool(v); err != nil {
+			return config, fmt.Errorf("`%s` must be bool, got %q", PROVIDER_LOG_FULL_PAYLOAD, v)
+		}
+	}
+
+	logList := d.Get("log").([]interface{})
+	if len(logList) == 0 || logList[0] == nil {
+		return config, nil
+	}
+	logMap := logList[0].(map[string]interface{})
+	if logMap["full_payload"].(bool) {
+		config.FullPayload = true
+	}
+	for i, field := range logMap["redacted_fields"].([]interface{}) {
+		if field == nil || field.(string) == "" {
+			return config, fmt.Errorf("`log.redacted_fields.%d` must not be empty", i)
+		}
+		config.RedactedFields = append(config.RedactedFields, field.(string))
+	}
+	return config, nil
+}
+
+// getRetryPolicy returns the retry policy of the `retry` block, and applies its read and write timeouts.
+func getRetryPolicy(d *schema.ResourceData) (*connectivity.RetryPolicy, error) {
+	retryList := d.Get("retry").([]interface{})
+	if len(retryList) == 0 || retryList[0] == nil {
+		return nil, nil
+	}
+	retry := retryList[0].(map[string]interface{})
+
+	policy := &connectivity.RetryPolicy{
+		MaxAttempts:         retry["max_attempts"].(int),
+		MinBackoff:          time.Duration(retry["min_backoff"].(int)) * time.Second,
+		MaxBackoff:          time.Duration(retry["max_backoff"].(int)) * time.Second,
+		RetryableErrorCodes: make(map[string][]string),
+	}
+	if policy.MinBackoff > policy.MaxBackoff {
+		return nil, fmt.Errorf("`retry.min_backoff` %d must not be greater than `retry.max_backoff` %d", retry["min_backoff"], retry["max_backoff"])
+	}
+	for i, v := range retry["retryable_error_codes"].([]interface{}) {
+		item := v.(map[string]interface{})
+		product := item["product"].(string)
+		for _, code := range item["codes"].([]interface{}) {
+			if code == nil || code.(string) == "" {
+				return nil, fmt.Errorf("`retry.retryable_error_codes.%d.codes` must not contain empty code", i)
+			}
+			policy.RetryableErrorCodes[product] = append(policy.RetryableErrorCodes[product], code.(string))
+		}
+	}
+
+	if v := retry["read_timeout"].(int); v > 0 {
+		if err := setRetryTimeout("read_timeout", &readRetryTimeout, time.Duration(v)*time.Minute); err != nil {
+			return nil, err
+		}
+	}
+	if v := retry["write_timeout"].(int); v > 0 {
+		if err := setRetryTimeout("write_timeout", &writeRetryTimeout, time.Duration(v)*time.Minute); err != nil {
+			return nil, err
+		}
+	}
+	return policy, nil
+}
+
+// retryTimeouts are the timeouts set by the `retry` blocks, the retries of all the resources share
+// readRetryTimeout and writeRetryTimeout, so the provider configurations must not set different ones
+var retryTimeouts = struct {
+	sync.Mutex
+	values map[string]time.Duration
+}{values: make(map[string]time.Duration)}
+
+// setRetryTimeout sets timeout to value, it fails if another provider configuration set a different one
+func setRetryTimeout(key string, timeout *time.Duration, value time.Duration) error {
+	retryTimeouts.Lock()
+	defer retryTimeouts.Unlock()
+	if old, ok := retryTimeouts.values[key]; ok && old != value {
+		return fmt.Errorf("`retry.%s` %d conflicts with %d of another provider configuration, it applies to all the provider configurations",
+			key, value/time.Minute, old/time.Minute)
+	}
+	retryTimeouts.values[key] = value
+	*timeout = value
 	return nil
 }
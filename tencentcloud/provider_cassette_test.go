@@ -0,0 +1,95 @@
+package tencentcloud
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+)
+
+// PROVIDER_CASSETTE_MODE is the mode of the cassettes of the acceptance tests, `record` or `replay`,
+// the tests call the real API if it is not set
+const PROVIDER_CASSETTE_MODE = "TENCENTCLOUD_CASSETTE_MODE"
+
+// testAccCassetteDir is where the cassettes are, the file of a test is named after the test
+var testAccCassetteDir = filepath.Join("testdata", "cassettes")
+
+// testAccCassette is the cassette of the running test, the requests of testAccProvider are recorded into it or
+// replayed from it. The provider is shared by the tests, so only one test can use a cassette at a time, and the
+// tests with cassettes must not call t.Parallel.
+var testAccCassette struct {
+	sync.Mutex
+	once     sync.Once
+	cassette *connectivity.Cassette
+}
+
+// testAccPreCheckCassette is the PreCheck of the tests which can be recorded and replayed. Without
+// TENCENTCLOUD_CASSETTE_MODE it is the same as testAccPreCheck. In the replay mode no credential is needed,
+// and the test is skipped if it has no cassette.
+func testAccPreCheckCassette(t *testing.T) {
+	mode := connectivity.CassetteMode(os.Getenv(PROVIDER_CASSETTE_MODE))
+	switch mode {
+	case "":
+		testAccPreCheck(t)
+		return
+	case connectivity.CassetteRecord:
+		testAccPreCheck(t)
+	case connectivity.CassetteReplay:
+		// the requests are not sent, so any credential works
+		if os.Getenv(PROVIDER_SECRET_ID) == "" || os.Getenv(PROVIDER_SECRET_KEY) == "" {
+			os.Setenv(PROVIDER_SECRET_ID, "AKIDcassette")
+			os.Setenv(PROVIDER_SECRET_KEY, "cassette")
+		}
+		if os.Getenv(PROVIDER_REGION) == "" {
+			os.Setenv(PROVIDER_REGION, defaultRegion)
+		}
+	}
+
+	path := filepath.Join(testAccCassetteDir, t.Name()+".json")
+	if _, err := os.Stat(path); mode == connectivity.CassetteReplay && os.IsNotExist(err) {
+		t.Skipf("no cassette of %s is recorded in %s", t.Name(), testAccCassetteDir)
+	}
+	cassette, err := connectivity.NewCassette(path, mode)
+	if err != nil {
+		t.Fatalf("load cassette of %s failed: %v", t.Name(), err)
+	}
+
+	testAccCassette.once.Do(func() {
+		configure := testAccProvider.ConfigureFunc
+		testAccProvider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+			meta, err := configure(d)
+			if err != nil {
+				return nil, err
+			}
+			testAccCassette.Lock()
+			defer testAccCassette.Unlock()
+			if testAccCassette.cassette != nil {
+				client := meta.(*TencentCloudClient).apiV3Conn
+				client.HTTPTransport = testAccCassette.cassette.RoundTripper(client.HTTPTransport)
+			}
+			return meta, nil
+		}
+	})
+
+	testAccCassette.Lock()
+	defer testAccCassette.Unlock()
+	if testAccCassette.cassette != nil {
+		t.Fatalf("another test is using a cassette, the tests with cassettes must not call t.Parallel")
+	}
+	testAccCassette.cassette = cassette
+	t.Cleanup(func() {
+		testAccCassette.Lock()
+		testAccCassette.cassette = nil
+		testAccCassette.Unlock()
+
+		// a failed run is not recorded, so the cassettes are always of the passed runs
+		if mode == connectivity.CassetteRecord && !t.Failed() {
+			if err := cassette.Save(); err != nil {
+				t.Errorf("save cassette of %s failed: %v", t.Name(), err)
+			}
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package tencentcloud
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+)
+
+// withContext moves the Create, Read, Update and Delete of r to their context variants. The functions are called with
+// the client bound to the context of the operation, so the API requests, and the retries of them, are canceled when
+// terraform is interrupted. The operations with a timeout in the Timeouts of r are canceled on the timeout as well,
+// the others are not limited by terraform.
+func withContext(r *schema.Resource) {
+	if create := r.Create; create != nil {
+		f := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(create(d, contextMeta(ctx, meta)))
+		}
+		r.Create = nil
+		if hasTimeout(r, schema.TimeoutCreate) {
+			r.CreateContext = f
+		} else {
+			r.CreateWithoutTimeout = f
+		}
+	}
+
+	if read := r.Read; read != nil {
+		f := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(read(d, contextMeta(ctx, meta)))
+		}
+		r.Read = nil
+		if hasTimeout(r, schema.TimeoutRead) {
+			r.ReadContext = f
+		} else {
+			r.ReadWithoutTimeout = f
+		}
+	}
+
+	if update := r.Update; update != nil {
+		f := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(update(d, contextMeta(ctx, meta)))
+		}
+		r.Update = nil
+		if hasTimeout(r, schema.TimeoutUpdate) {
+			r.UpdateContext = f
+		} else {
+			r.UpdateWithoutTimeout = f
+		}
+	}
+
+	if del := r.Delete; del != nil {
+		f := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(del(d, contextMeta(ctx, meta)))
+		}
+		r.Delete = nil
+		if hasTimeout(r, schema.TimeoutDelete) {
+			r.DeleteContext = f
+		} else {
+			r.DeleteWithoutTimeout = f
+		}
+	}
+}
+
+// hasTimeout returns whether the timeout of operation is declared in the Timeouts of r
+func hasTimeout(r *schema.Resource, operation string) bool {
+	timeouts := r.Timeouts
+	if timeouts == nil {
+		return false
+	}
+	if timeouts.Default != nil {
+		return true
+	}
+	switch operation {
+	case schema.TimeoutCreate:
+		return timeouts.Create != nil
+	case schema.TimeoutRead:
+		return timeouts.Read != nil
+	case schema.TimeoutUpdate:
+		return timeouts.Update != nil
+	case schema.TimeoutDelete:
+		return timeouts.Delete != nil
+	}
+	return false
+}
+
+// contextMeta returns the client whose requests are sent with ctx
+func contextMeta(ctx context.Context, meta interface{}) interface{} {
+	client, ok := meta.(*TencentCloudClient)
+	if !ok || client == nil || client.apiV3Conn == nil {
+		return meta
+	}
+	return client.withContext(ctx)
+}
+
+// withContext returns the client whose requests are sent with ctx, which shares the settings with me
+func (me *TencentCloudClient) withContext(ctx context.Context) *TencentCloudClient {
+	return &TencentCloudClient{
+		apiV3Conn:   me.apiV3Conn.WithContext(ctx),
+		defaultTags: me.defaultTags,
+		ignoreTags:  me.ignoreTags,
+	}
+}
+
+// retryOperation is resource.Retry which stops when the operation of the client in meta is canceled or timed out,
+// it is for the long waits of the resources whose Timeouts are configurable
+func retryOperation(meta interface{}, timeout time.Duration, f resource.RetryFunc) error {
+	ctx := context.Background()
+	if client, ok := meta.(*TencentCloudClient); ok && client != nil && client.apiV3Conn != nil {
+		ctx = client.apiV3Conn.Context()
+	}
+	return resource.RetryContext(ctx, timeout, f)
+}
+
+// isContextError returns whether err is caused by the cancellation or timeout of the context of the operation,
+// the request will fail again if retried. The timeouts of the requests themselves are still retryable.
+func isContextError(err error) bool {
+	var message string
+	switch realErr := errors.Cause(err).(type) {
+	case *sdkErrors.TencentCloudSDKError:
+		if realErr.Code != "ClientError.NetworkError" {
+			return false
+		}
+		message = realErr.Message
+	case nil:
+		return false
+	default:
+		if realErr == context.Canceled || realErr == context.DeadlineExceeded {
+			return true
+		}
+		message = realErr.Error()
+	}
+	if strings.Contains(message, "Client.Timeout") {
+		return false
+	}
+	return strings.Contains(message, context.Canceled.Error()) || strings.Contains(message, context.DeadlineExceeded.Error())
+}
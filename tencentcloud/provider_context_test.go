@@ -0,0 +1,128 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+)
+
+func TestContextResource(t *testing.T) {
+	meta := &TencentCloudClient{apiV3Conn: &connectivity.TencentCloudClient{Region: "ap-guangzhou"}}
+	for _, timeouts := range []*schema.ResourceTimeout{nil, {Create: schema.DefaultTimeout(time.Minute)}} {
+		var hasDeadline bool
+		r := &schema.Resource{
+			Create: func(d *schema.ResourceData, meta interface{}) error {
+				_, hasDeadline = meta.(*TencentCloudClient).apiV3Conn.Context().Deadline()
+				d.SetId("ins-test")
+				return nil
+			},
+			Read: func(d *schema.ResourceData, meta interface{}) error {
+				return nil
+			},
+			Delete: func(d *schema.ResourceData, meta interface{}) error {
+				return nil
+			},
+			Timeouts: timeouts,
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		}
+		withContext(r)
+		if r.Create != nil || r.Read != nil || r.Delete != nil {
+			t.Fatalf("the legacy functions are not moved")
+		}
+		if err := r.InternalValidate(nil, true); err != nil {
+			t.Fatalf("invalid resource: %v", err)
+		}
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{"name": "test"})
+		diff, err := r.SimpleDiff(context.TODO(), nil, config, meta)
+		if err != nil {
+			t.Fatalf("diff failed: %v", err)
+		}
+		state, diags := r.Apply(context.TODO(), nil, diff, meta)
+		if diags.HasError() || state.ID != "ins-test" {
+			t.Fatalf("apply failed: %v", diags)
+		}
+		if hasDeadline != (timeouts != nil) {
+			t.Errorf("expected the deadline %t with timeouts %v", timeouts != nil, timeouts)
+		}
+		if meta.apiV3Conn.Context() != context.Background() {
+			t.Errorf("the context of provider client is changed")
+		}
+	}
+}
+
+func TestContextCancelRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the closed connection is detected after the body is read
+		_, _ = ioutil.ReadAll(r.Body)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	meta := &TencentCloudClient{apiV3Conn: &connectivity.TencentCloudClient{
+		Credential: common.NewCredential("id", "key"),
+		Region:     "ap-guangzhou",
+		Endpoints:  map[string]string{"cvm": server.URL},
+	}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	client := contextMeta(ctx, meta).(*TencentCloudClient)
+
+	var calls int
+	start := time.Now()
+	err := retryOperation(client, time.Minute, func() *resource.RetryError {
+		calls++
+		_, err := client.apiV3Conn.UseCvmClient().DescribeInstances(cvm.NewDescribeInstancesRequest())
+		if err != nil {
+			return retryError(err)
+		}
+		return resource.RetryableError(fmt.Errorf("instance is pending"))
+	})
+	if err == nil || !isContextError(err) {
+		t.Fatalf("expected the context error, got %v", err)
+	}
+	if calls != 1 || time.Since(start) > 10*time.Second {
+		t.Errorf("expected the retries to stop on cancel, %d calls in %s", calls, time.Since(start))
+	}
+}
+
+func TestIsContextError(t *testing.T) {
+	cases := []struct {
+		err      error
+		expected bool
+	}{
+		{nil, false},
+		{context.Canceled, true},
+		{fmt.Errorf("wait: %w", context.DeadlineExceeded), true},
+		{sdkErrors.NewTencentCloudSDKError("ClientError.NetworkError", "Fail to get response because Post \"https://cvm.tencentcloudapi.com\": context canceled", ""), true},
+		{sdkErrors.NewTencentCloudSDKError("ClientError.NetworkError", "Fail to get response because Post \"https://cvm.tencentcloudapi.com\": context deadline exceeded (Client.Timeout exceeded while awaiting headers)", ""), false},
+		{sdkErrors.NewTencentCloudSDKError("ClientError.NetworkError", "Fail to get response because EOF", ""), false},
+		{sdkErrors.NewTencentCloudSDKError("InternalError", "context canceled", "req-1"), false},
+	}
+	for _, c := range cases {
+		if isContextError(c.err) != c.expected {
+			t.Errorf("expected %t for %v", c.expected, c.err)
+		}
+	}
+	if retryError(context.Canceled).Retryable {
+		t.Errorf("the canceled error is retried")
+	}
+}
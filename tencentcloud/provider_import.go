@@ -0,0 +1,242 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+// ImportCandidate is an existing resource which can be adopted by `terraform import`
+type ImportCandidate struct {
+	// Id is the import id of the resource, the parts of a composite id are joined like the resource does
+	Id string
+	// Name is the name of the resource in the cloud, it can be empty
+	Name string
+}
+
+// resourceIdSchemas are the declarations of the composite ids of the resources, which are documented by gendoc
+var resourceIdSchemas = map[string]*helper.IdSchema{
+	"tencentcloud_clb_listener":                clbListenerId,
+	"tencentcloud_clb_listener_rule":           clbListenerRuleId,
+	"tencentcloud_cls_export":                  clsExportId,
+	"tencentcloud_kubernetes_addon_attachment": tkeAddonAttachmentId,
+	"tencentcloud_monitor_tmp_scrape_job":      monitorTmpScrapeJobId,
+	"tencentcloud_mysql_account":               mysqlAccountId,
+	"tencentcloud_nat_gateway_snat":            natGatewaySnatId,
+	"tencentcloud_route_table_entry":           routeTableEntryId,
+	"tencentcloud_tdcpg_instance":              tdcpgInstanceId,
+	"tencentcloud_vpn_gateway_route":           vpnGatewayRouteId,
+}
+
+// ResourceIdFormat returns the format of the composite id of the resource, like `<clb_id>#<listener_id>`,
+// it is empty if the id is not declared in resourceIdSchemas
+func ResourceIdFormat(resourceType string) string {
+	if idSchema, ok := resourceIdSchemas[resourceType]; ok {
+		return idSchema.String()
+	}
+	return ""
+}
+
+// CheckResourceId returns the error of id if it is not in the format of the composite id of the resource
+func CheckResourceId(resourceType, id string) error {
+	if idSchema, ok := resourceIdSchemas[resourceType]; ok {
+		_, err := idSchema.Parse(id)
+		return err
+	}
+	return nil
+}
+
+type importLister func(ctx context.Context, client *TencentCloudClient) ([]ImportCandidate, error)
+
+// importListers list the existing resources of the types through the service layer
+var importListers = map[string]importLister{
+	"tencentcloud_vpc": func(ctx context.Context, client *TencentCloudClient) ([]ImportCandidate, error) {
+		service := VpcService{client: client.apiV3Conn}
+		vpcs, err := service.DescribeVpcs(ctx, "", "", nil, nil, "", "")
+		if err != nil {
+			return nil, err
+		}
+		candidates := make([]ImportCandidate, 0, len(vpcs))
+		for _, v := range vpcs {
+			candidates = append(candidates, ImportCandidate{Id: v.vpcId, Name: v.name})
+		}
+		return candidates, nil
+	},
+	"tencentcloud_subnet": func(ctx context.Context, client *TencentCloudClient) ([]ImportCandidate, error) {
+		service := VpcService{client: client.apiV3Conn}
+		subnets, err := service.DescribeSubnets(ctx, "", "", "", "", nil, nil, nil, "", "")
+		if err != nil {
+			return nil, err
+		}
+		candidates := make([]ImportCandidate, 0, len(subnets))
+		for _, v := range subnets {
+			candidates = append(candidates, ImportCandidate{Id: v.subnetId, Name: v.name})
+		}
+		return candidates, nil
+	},
+	"tencentcloud_security_group": func(ctx context.Context, client *TencentCloudClient) ([]ImportCandidate, error) {
+		service := VpcService{client: client.apiV3Conn}
+		sgs, err := service.DescribeSecurityGroups(ctx, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		candidates := make([]ImportCandidate, 0, len(sgs))
+		for _, v := range sgs {
+			candidates = append(candidates, ImportCandidate{Id: *v.SecurityGroupId, Name: helper.PString(v.SecurityGroupName)})
+		}
+		return candidates, nil
+	},
+	"tencentcloud_route_table": func(ctx context.Context, client *TencentCloudClient) ([]ImportCandidate, error) {
+		service := VpcService{client: client.apiV3Conn}
+		tables, err := service.DescribeRouteTables(ctx, "", "", "", nil, nil, "")
+		if err != nil {
+			return nil, err
+		}
+		candidates := make([]ImportCandidate, 0, len(tables))
+		for _, v := range tables {
+			candidates = append(candidates, ImportCandidate{Id: v.routeTableId, Name: v.name})
+		}
+		return candidates, nil
+	},
+	"tencentcloud_route_table_entry": func(ctx context.Context, client *TencentCloudClient) ([]ImportCandidate, error) {
+		service := VpcService{client: client.apiV3Conn}
+		tables, err := service.DescribeRouteTables(ctx, "", "", "", nil, nil, "")
+		if err != nil {
+			return nil, err
+		}
+		candidates := make([]ImportCandidate, 0)
+		for _, table := range tables {
+			for _, entry := range table.entryInfos {
+				// the other routes are managed by the cloud, like the local and the CCN ones
+				if entry.entryType != "USER" {
+					continue
+				}
+				candidates = append(candidates, ImportCandidate{
+					Id:   routeTableEntryId.Format(strconv.FormatInt(entry.routeEntryId, 10), table.routeTableId),
+					Name: entry.description,
+				})
+			}
+		}
+		return candidates, nil
+	},
+	"tencentcloud_eip": func(ctx context.Context, client *TencentCloudClient) ([]ImportCandidate, error) {
+		service := VpcService{client: client.apiV3Conn}
+		eips, err := service.DescribeEipByFilter(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		candidates := make([]ImportCandidate, 0, len(eips))
+		for _, v := range eips {
+			candidates = append(candidates, ImportCandidate{Id: *v.AddressId, Name: helper.PString(v.AddressName)})
+		}
+		return candidates, nil
+	},
+	"tencentcloud_instance": func(ctx context.Context, client *TencentCloudClient) ([]ImportCandidate, error) {
+		service := CvmService{client: client.apiV3Conn}
+		instances, err := service.DescribeInstanceByFilter(ctx, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		candidates := make([]ImportCandidate, 0, len(instances))
+		for _, v := range instances {
+			candidates = append(candidates, ImportCandidate{Id: *v.InstanceId, Name: helper.PString(v.InstanceName)})
+		}
+		return candidates, nil
+	},
+	"tencentcloud_cbs_storage": func(ctx context.Context, client *TencentCloudClient) ([]ImportCandidate, error) {
+		service := CbsService{client: client.apiV3Conn}
+		disks, err := service.DescribeDisksByFilter(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		candidates := make([]ImportCandidate, 0, len(disks))
+		for _, v := range disks {
+			// the system disks are managed by the instances
+			if v.DiskUsage != nil && *v.DiskUsage == "SYSTEM_DISK" {
+				continue
+			}
+			candidate := ImportCandidate{Id: *v.DiskId}
+			if v.DiskName != nil {
+				candidate.Name = *v.DiskName
+			}
+			candidates = append(candidates, candidate)
+		}
+		return candidates, nil
+	},
+	"tencentcloud_clb_instance": func(ctx context.Context, client *TencentCloudClient) ([]ImportCandidate, error) {
+		service := ClbService{client: client.apiV3Conn}
+		clbs, err := service.DescribeLoadBalancerByFilter(ctx, map[string]interface{}{})
+		if err != nil {
+			return nil, err
+		}
+		candidates := make([]ImportCandidate, 0, len(clbs))
+		for _, v := range clbs {
+			candidates = append(candidates, ImportCandidate{Id: *v.LoadBalancerId, Name: helper.PString(v.LoadBalancerName)})
+		}
+		return candidates, nil
+	},
+	"tencentcloud_clb_listener": func(ctx context.Context, client *TencentCloudClient) ([]ImportCandidate, error) {
+		candidates := make([]ImportCandidate, 0)
+		err := forEachClbListener(ctx, client, func(clbId string, listener *clb.Listener) {
+			candidates = append(candidates, ImportCandidate{Id: clbListenerId.Format(clbId, *listener.ListenerId), Name: helper.PString(listener.ListenerName)})
+		})
+		return candidates, err
+	},
+	"tencentcloud_clb_listener_rule": func(ctx context.Context, client *TencentCloudClient) ([]ImportCandidate, error) {
+		candidates := make([]ImportCandidate, 0)
+		err := forEachClbListener(ctx, client, func(clbId string, listener *clb.Listener) {
+			for _, rule := range listener.Rules {
+				candidates = append(candidates, ImportCandidate{
+					Id:   clbListenerRuleId.Format(clbId, *listener.ListenerId, *rule.LocationId),
+					Name: helper.PString(rule.Domain) + helper.PString(rule.Url),
+				})
+			}
+		})
+		return candidates, err
+	},
+}
+
+func forEachClbListener(ctx context.Context, client *TencentCloudClient, f func(clbId string, listener *clb.Listener)) error {
+	service := ClbService{client: client.apiV3Conn}
+	clbs, err := service.DescribeLoadBalancerByFilter(ctx, map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	for _, v := range clbs {
+		listeners, err := service.DescribeListenersByFilter(ctx, map[string]interface{}{"clb_id": *v.LoadBalancerId})
+		if err != nil {
+			return err
+		}
+		for _, listener := range listeners {
+			f(*v.LoadBalancerId, listener)
+		}
+	}
+	return nil
+}
+
+// ImportableResourceTypes returns the resource types whose existing resources can be listed by ListImportCandidates
+func ImportableResourceTypes() []string {
+	types := make([]string, 0, len(importListers))
+	for k := range importListers {
+		types = append(types, k)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// ListImportCandidates lists the existing resources of the type in the region of meta, which is the meta of the
+// configured provider
+func ListImportCandidates(ctx context.Context, meta interface{}, resourceType string) ([]ImportCandidate, error) {
+	lister, ok := importListers[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("the existing resources of %s can not be listed, the supported ones are %v", resourceType, ImportableResourceTypes())
+	}
+	if ctx.Value(logIdKey) == nil {
+		ctx = context.WithValue(ctx, logIdKey, getLogId(contextNil))
+	}
+	return lister(ctx, meta.(*TencentCloudClient))
+}
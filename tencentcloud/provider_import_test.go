@@ -0,0 +1,148 @@
+package tencentcloud
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/mockapi"
+)
+
+func TestImportableResourceTypes(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for _, resourceType := range ImportableResourceTypes() {
+		if r, ok := resources[resourceType]; !ok || r.Importer == nil {
+			t.Errorf("%s is not a resource which can be imported", resourceType)
+		}
+	}
+	if _, err := ListImportCandidates(context.TODO(), &TencentCloudClient{}, "tencentcloud_unknown"); err == nil {
+		t.Errorf("expected error of the unknown resource type")
+	}
+}
+
+func TestListImportCandidates(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	meta := testMockapiMeta(server)
+
+	vpc := testMockapiApply(t, meta, "tencentcloud_vpc", nil, map[string]interface{}{
+		"name":       "import-vpc",
+		"cidr_block": "10.0.0.0/16",
+	})
+	subnet := testMockapiApply(t, meta, "tencentcloud_subnet", nil, map[string]interface{}{
+		"name":              "import-subnet",
+		"vpc_id":            vpc.ID,
+		"cidr_block":        "10.0.1.0/24",
+		"availability_zone": "ap-guangzhou-3",
+	})
+	disk := testMockapiApply(t, meta, "tencentcloud_cbs_storage", nil, map[string]interface{}{
+		"storage_name":      "import-disk",
+		"storage_type":      "CLOUD_PREMIUM",
+		"storage_size":      50,
+		"availability_zone": "ap-guangzhou-3",
+	})
+	instance := testMockapiApply(t, meta, "tencentcloud_instance", nil, map[string]interface{}{
+		"instance_name":     "import-instance",
+		"availability_zone": "ap-guangzhou-3",
+		"image_id":          "img-mockapi0",
+		"instance_type":     "S5.MEDIUM2",
+		"vpc_id":            vpc.ID,
+		"subnet_id":         subnet.ID,
+	})
+
+	// the name of an EIP is optional, it is not returned when it is not set
+	server.Handle("vpc", "DescribeAddresses", func(request *mockapi.Request) (interface{}, error) {
+		return map[string]interface{}{"TotalCount": 1, "AddressSet": []interface{}{map[string]interface{}{
+			"AddressId": "eip-1", "AddressIp": "1.1.1.1", "AddressStatus": "UNBIND",
+		}}}, nil
+	})
+
+	for resourceType, expected := range map[string][]ImportCandidate{
+		"tencentcloud_eip":    {{Id: "eip-1"}},
+		"tencentcloud_vpc":    {{Id: vpc.ID, Name: "import-vpc"}},
+		"tencentcloud_subnet": {{Id: subnet.ID, Name: "import-subnet"}},
+		// the system disk of the instance is not listed
+		"tencentcloud_cbs_storage": {{Id: disk.ID, Name: "import-disk"}},
+		"tencentcloud_instance":    {{Id: instance.ID, Name: "import-instance"}},
+	} {
+		candidates, err := ListImportCandidates(context.TODO(), meta, resourceType)
+		if err != nil {
+			t.Fatalf("list %s failed: %v", resourceType, err)
+		}
+		sort.Slice(candidates, func(i, j int) bool { return candidates[i].Id < candidates[j].Id })
+		if !reflect.DeepEqual(candidates, expected) {
+			t.Errorf("expected %v of %s, got %v", expected, resourceType, candidates)
+		}
+	}
+}
+
+func TestResourceIdSchemas(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for resourceType, idSchema := range resourceIdSchemas {
+		r, ok := resources[resourceType]
+		if !ok || r.Importer == nil {
+			t.Errorf("%s is not a resource which can be imported", resourceType)
+			continue
+		}
+		parts := idSchema.Parts()
+		values := make([]string, len(parts))
+		for i, part := range parts {
+			values[i] = part + "-1"
+		}
+		id := idSchema.Format(values...)
+		if err := CheckResourceId(resourceType, id); err != nil {
+			t.Errorf("unexpected error of %s: %v", resourceType, err)
+		}
+
+		// the parts missing or empty are rejected before reading
+		for _, malformed := range []string{values[0], idSchema.Format(append([]string{""}, values[1:]...)...)} {
+			d := r.TestResourceData()
+			d.SetId(malformed)
+			if _, err := r.Importer.StateContext(context.TODO(), d, &TencentCloudClient{}); err == nil ||
+				!strings.Contains(err.Error(), idSchema.String()) {
+				t.Errorf("expected error of %s id `%s` with `%s`, got %v", resourceType, malformed, idSchema, err)
+			}
+		}
+	}
+	if ResourceIdFormat("tencentcloud_clb_listener_rule") != "<clb_id>#<listener_id>#<rule_id>" {
+		t.Errorf("unexpected id format of tencentcloud_clb_listener_rule: %s", ResourceIdFormat("tencentcloud_clb_listener_rule"))
+	}
+	if ResourceIdFormat("tencentcloud_route_table_entry") != "<route_entry_id>.<route_table_id>" {
+		t.Errorf("unexpected id format of tencentcloud_route_table_entry: %s", ResourceIdFormat("tencentcloud_route_table_entry"))
+	}
+
+	// the optional host of mysql account can be omitted, but not the other parts
+	if ResourceIdFormat("tencentcloud_mysql_account") != "<mysql_id>#<account_name>[#<host>]" {
+		t.Errorf("unexpected id format of tencentcloud_mysql_account: %s", ResourceIdFormat("tencentcloud_mysql_account"))
+	}
+	if values, err := mysqlAccountId.Parse("cdb-1#tf_account"); err != nil || !reflect.DeepEqual(values, []string{"cdb-1", "tf_account", ""}) {
+		t.Errorf("unexpected values %v of mysql account id: %v", values, err)
+	}
+	for _, malformed := range []string{"cdb-1", "cdb-1#tf_account#%#extra", "cdb-1#tf_account#"} {
+		if err := CheckResourceId("tencentcloud_mysql_account", malformed); err == nil {
+			t.Errorf("expected error of mysql account id `%s`", malformed)
+		}
+	}
+
+	// the old rule ids are the location ids only, whose clb and listener are taken from the state
+	d := resourceTencentCloudClbListenerRule().TestResourceData()
+	d.SetId("loc-1")
+	if _, _, _, err := parseClbListenerRuleId(d); err == nil || !strings.Contains(err.Error(), clbListenerRuleId.String()) {
+		t.Errorf("expected error of the old style rule id without state, got %v", err)
+	}
+	_ = d.Set("clb_id", "lb-1")
+	_ = d.Set("listener_id", "lbl-1")
+	if clbId, listenerId, ruleId, err := parseClbListenerRuleId(d); err != nil || clbId != "lb-1" || listenerId != "lbl-1" || ruleId != "loc-1" {
+		t.Errorf("unexpected values %s, %s, %s of the old style rule id: %v", clbId, listenerId, ruleId, err)
+	}
+	d.SetId(clbListenerRuleId.Format("lb-2", "lbl-2", "loc-2"))
+	if clbId, listenerId, ruleId, err := parseClbListenerRuleId(d); err != nil || clbId != "lb-2" || listenerId != "lbl-2" || ruleId != "loc-2" {
+		t.Errorf("unexpected values %s, %s, %s of the rule id: %v", clbId, listenerId, ruleId, err)
+	}
+	d.SetId("lb-2#lbl-2")
+	if _, _, _, err := parseClbListenerRuleId(d); err == nil {
+		t.Errorf("expected error of the rule id without location")
+	}
+}
@@ -0,0 +1,185 @@
+package tencentcloud
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// regionExcludedPrefixes are the products whose resources are global, they have no `region` argument
+var regionExcludedPrefixes = []string{
+	"tencentcloud_cam_",
+	"tencentcloud_cat_",
+	"tencentcloud_cdn_",
+	"tencentcloud_ciam_",
+	"tencentcloud_css_",
+	"tencentcloud_dnspod_",
+	"tencentcloud_domains",
+	"tencentcloud_gaap_",
+	"tencentcloud_organization_",
+	"tencentcloud_private_dns_",
+	"tencentcloud_project",
+	"tencentcloud_sms_",
+	"tencentcloud_ssl_",
+	"tencentcloud_sts_",
+	"tencentcloud_teo_",
+	"tencentcloud_user_info",
+	"tencentcloud_vod_",
+}
+
+// regionRegexp matches a region like `ap-guangzhou` or `ap-shanghai-fsi`
+var regionRegexp = regexp.MustCompile(`^[a-z]+-[a-z]+(-[a-z0-9]+)*$`)
+
+// REGION_ID_SP separates the id and region of a resource to import, like `ins-xxx@ap-shanghai`
+const REGION_ID_SP = "@"
+
+func isRegionalResource(name string, r *schema.Resource) bool {
+	if _, ok := r.Schema["region"]; ok {
+		return false
+	}
+	for _, prefix := range regionExcludedPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// withRegion adds the optional `region` to a regional resource or data source, which defaults to the region of the
+// provider. All the functions of r are called with the client of the region, so the API requests, tags and ids of
+// the resource are all in the region. A resource in another region is imported with the id `<id>@<region>`.
+func withRegion(r *schema.Resource, dataSource bool) {
+	description := "The region of the resource, like `ap-shanghai`. Default is the `region` of the provider."
+	if dataSource {
+		description = "The region to query, like `ap-shanghai`. Default is the `region` of the provider."
+	}
+	r.Schema["region"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     !dataSource,
+		ValidateFunc: validateRegion,
+		Description:  description,
+	}
+
+	if create := r.Create; create != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			meta = regionalMeta(d.Get("region").(string), meta)
+			err := create(d, meta)
+			setRegion(d, meta)
+			return err
+		}
+	}
+	if create := r.CreateContext; create != nil {
+		r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			meta = regionalMeta(d.Get("region").(string), meta)
+			diags := create(ctx, d, meta)
+			setRegion(d, meta)
+			return diags
+		}
+	}
+
+	if read := r.Read; read != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			meta = regionalMeta(d.Get("region").(string), meta)
+			err := read(d, meta)
+			setRegion(d, meta)
+			return err
+		}
+	}
+	if read := r.ReadContext; read != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			meta = regionalMeta(d.Get("region").(string), meta)
+			diags := read(ctx, d, meta)
+			setRegion(d, meta)
+			return diags
+		}
+	}
+
+	if update := r.Update; update != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return update(d, regionalMeta(d.Get("region").(string), meta))
+		}
+	}
+	if update := r.UpdateContext; update != nil {
+		r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return update(ctx, d, regionalMeta(d.Get("region").(string), meta))
+		}
+	}
+
+	if del := r.Delete; del != nil {
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			return del(d, regionalMeta(d.Get("region").(string), meta))
+		}
+	}
+	if del := r.DeleteContext; del != nil {
+		r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return del(ctx, d, regionalMeta(d.Get("region").(string), meta))
+		}
+	}
+
+	if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return customizeDiff(ctx, d, regionalMeta(d.Get("region").(string), meta))
+		}
+	}
+
+	if importer := r.Importer; importer != nil {
+		state, stateContext := importer.State, importer.StateContext
+		r.Importer = &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if id, region, ok := parseRegionalId(d.Id()); ok {
+					d.SetId(id)
+					_ = d.Set("region", region)
+				}
+				meta = regionalMeta(d.Get("region").(string), meta)
+				if stateContext != nil {
+					return stateContext(ctx, d, meta)
+				}
+				if state != nil {
+					return state(d, meta)
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		}
+	}
+}
+
+// regionalMeta returns the client of region, it is meta itself if region is empty or the region of the provider
+func regionalMeta(region string, meta interface{}) interface{} {
+	client, ok := meta.(*TencentCloudClient)
+	if !ok || client == nil || client.apiV3Conn == nil || region == "" || region == client.apiV3Conn.Region {
+		return meta
+	}
+	return client.withRegion(region)
+}
+
+// setRegion sets `region` to the region of the client which the resource is read with
+func setRegion(d *schema.ResourceData, meta interface{}) {
+	client, ok := meta.(*TencentCloudClient)
+	if d.Id() == "" || !ok || client == nil || client.apiV3Conn == nil {
+		return
+	}
+	_ = d.Set("region", client.apiV3Conn.Region)
+}
+
+// parseRegionalId splits the import id like `ins-xxx@ap-shanghai` into the id and region
+func parseRegionalId(importId string) (id, region string, ok bool) {
+	index := strings.LastIndex(importId, REGION_ID_SP)
+	if index <= 0 || !regionRegexp.MatchString(importId[index+1:]) {
+		return importId, "", false
+	}
+	return importId[:index], importId[index+1:], true
+}
+
+// withRegion returns the client of region, which shares the credential and settings with me
+func (me *TencentCloudClient) withRegion(region string) *TencentCloudClient {
+	return &TencentCloudClient{
+		apiV3Conn:   me.apiV3Conn.WithRegion(region),
+		defaultTags: me.defaultTags,
+		ignoreTags:  me.ignoreTags,
+	}
+}
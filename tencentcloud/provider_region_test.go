@@ -0,0 +1,124 @@
+package tencentcloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+)
+
+// testRegionalResource returns a resource which records the regions of the client it is called with
+func testRegionalResource(regions *[]string) *schema.Resource {
+	record := func(meta interface{}) {
+		*regions = append(*regions, meta.(*TencentCloudClient).apiV3Conn.Region)
+	}
+	r := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			record(meta)
+			d.SetId("ins-test")
+			return nil
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			record(meta)
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			record(meta)
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+	withRegion(r, false)
+	return r
+}
+
+func TestResourceRegion(t *testing.T) {
+	var regions []string
+	r := testRegionalResource(&regions)
+	meta := &TencentCloudClient{apiV3Conn: &connectivity.TencentCloudClient{Region: "ap-guangzhou"}}
+
+	for _, c := range []struct {
+		region, expected string
+	}{
+		{"", "ap-guangzhou"},
+		{"ap-shanghai", "ap-shanghai"},
+	} {
+		regions = nil
+		raw := make(map[string]interface{})
+		if c.region != "" {
+			raw["region"] = c.region
+		}
+		diff, err := r.SimpleDiff(context.TODO(), nil, terraform.NewResourceConfigRaw(raw), meta)
+		if err != nil {
+			t.Fatalf("diff failed: %v", err)
+		}
+		state, diags := r.Apply(context.TODO(), nil, diff, meta)
+		if diags.HasError() {
+			t.Fatalf("apply failed: %v", diags)
+		}
+		if state.Attributes["region"] != c.expected || len(regions) != 1 || regions[0] != c.expected {
+			t.Errorf("expected created in %s, got %v and state %v", c.expected, regions, state.Attributes)
+		}
+	}
+
+	// the client of a region leaves the region of the provider client unchanged
+	if client := meta.withRegion("ap-shanghai"); client.apiV3Conn.Region != "ap-shanghai" || meta.apiV3Conn.Region == "ap-shanghai" {
+		t.Errorf("unexpected client of region")
+	}
+
+	regions = nil
+	d := r.TestResourceData()
+	d.SetId("ins-test@ap-beijing")
+	states, err := r.Importer.StateContext(context.TODO(), d, meta)
+	if err != nil {
+		t.Fatalf("import failed: %v", err)
+	}
+	if len(states) != 1 || states[0].Id() != "ins-test" || states[0].Get("region") != "ap-beijing" {
+		t.Errorf("unexpected imported state %v", states)
+	}
+	if err := r.Read(states[0], meta); err != nil || len(regions) != 1 || regions[0] != "ap-beijing" {
+		t.Errorf("expected read in ap-beijing, got %v: %v", regions, err)
+	}
+}
+
+func TestParseRegionalId(t *testing.T) {
+	for _, c := range []struct {
+		importId, id, region string
+	}{
+		{"ins-xxx@ap-shanghai", "ins-xxx", "ap-shanghai"},
+		{"cdb-xxx#root@%@ap-shanghai-fsi", "cdb-xxx#root@%", "ap-shanghai-fsi"},
+		{"cdb-xxx#root@%", "cdb-xxx#root@%", ""},
+		{"ins-xxx", "ins-xxx", ""},
+		{"@ap-shanghai", "@ap-shanghai", ""},
+	} {
+		if id, region, _ := parseRegionalId(c.importId); id != c.id || region != c.region {
+			t.Errorf("%s: expected %s and %s, got %s and %s", c.importId, c.id, c.region, id, region)
+		}
+	}
+}
+
+func TestRegionalResources(t *testing.T) {
+	provider := Provider()
+	for _, name := range []string{"tencentcloud_instance", "tencentcloud_vpc", "tencentcloud_kubernetes_cluster"} {
+		if s, ok := provider.ResourcesMap[name].Schema["region"]; !ok || !s.ForceNew {
+			t.Errorf("expected %s has region", name)
+		}
+	}
+	if _, ok := provider.DataSourcesMap["tencentcloud_instances"].Schema["region"]; !ok {
+		t.Errorf("expected tencentcloud_instances has region")
+	}
+	if _, ok := provider.ResourcesMap["tencentcloud_cam_user"].Schema["region"]; ok {
+		t.Errorf("unexpected region of the global resource tencentcloud_cam_user")
+	}
+}
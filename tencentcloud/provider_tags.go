@@ -0,0 +1,273 @@
+package tencentcloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultTagsExcludedResources are the resources whose `tags` are not sent to the API
+var defaultTagsExcludedResources = map[string]bool{
+	"tencentcloud_tcmq_subscribe": true,
+}
+
+// withDefaultTags adds the computed `tags_all` to a taggable resource, which is the `tags` of the resource merged with
+// the `default_tags` of the provider, the tags of the resource win on conflict. The default tags are applied on
+// create, and hidden from `tags` on read unless they are configured in the resource, so the plans have no diff.
+// The updates of the resource are expected to diff the tags by getTagsChange.
+func withDefaultTags(r *schema.Resource) {
+	if !isTaggableResource(r) {
+		return
+	}
+
+	r.Schema["tags_all"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "All the tags of the resource, including the `default_tags` of the provider.",
+	}
+
+	if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := customizeDiff(ctx, d, meta); err != nil {
+				return err
+			}
+			return customizeDiffTagsAll(ctx, d, meta)
+		}
+	} else {
+		r.CustomizeDiff = customizeDiffTagsAll
+	}
+
+	if create := r.Create; create != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			configured := applyDefaultTags(d, meta)
+			err := create(d, meta)
+			setTagsAll(d, meta, configured)
+			return err
+		}
+	}
+	if create := r.CreateContext; create != nil {
+		r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			configured := applyDefaultTags(d, meta)
+			diags := create(ctx, d, meta)
+			setTagsAll(d, meta, configured)
+			return diags
+		}
+	}
+
+	if read := r.Read; read != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			configured := d.Get("tags").(map[string]interface{})
+			err := read(d, meta)
+			setTagsAll(d, meta, configured)
+			return err
+		}
+	}
+	if read := r.ReadContext; read != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			configured := d.Get("tags").(map[string]interface{})
+			diags := read(ctx, d, meta)
+			setTagsAll(d, meta, configured)
+			return diags
+		}
+	}
+
+	if update := r.Update; update != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			configured := d.Get("tags").(map[string]interface{})
+			err := update(d, meta)
+			setTagsAll(d, meta, configured)
+			return err
+		}
+	}
+	if update := r.UpdateContext; update != nil {
+		r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			configured := d.Get("tags").(map[string]interface{})
+			diags := update(ctx, d, meta)
+			setTagsAll(d, meta, configured)
+			return diags
+		}
+	}
+}
+
+// withIgnoreTags removes the tags ignored by the provider from the read results of a resource whose `tags` is a map
+// but not taggable, the taggable ones are handled by withDefaultTags.
+func withIgnoreTags(r *schema.Resource) {
+	if tags, ok := r.Schema["tags"]; !ok || tags.Type != schema.TypeMap {
+		return
+	}
+	if _, ok := r.Schema["tags_all"]; ok {
+		return
+	}
+
+	if read := r.Read; read != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			err := read(d, meta)
+			removeIgnoredTags(d, meta)
+			return err
+		}
+	}
+	if read := r.ReadContext; read != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			diags := read(ctx, d, meta)
+			removeIgnoredTags(d, meta)
+			return diags
+		}
+	}
+}
+
+// isTaggableResource returns whether the `tags` of r is a map which can be updated
+func isTaggableResource(r *schema.Resource) bool {
+	tags, ok := r.Schema["tags"]
+	if !ok || tags.Type != schema.TypeMap || tags.ForceNew {
+		return false
+	}
+	if elem, ok := tags.Elem.(*schema.Schema); ok && elem.Type != schema.TypeString {
+		return false
+	}
+	if _, ok := r.Schema["tags_all"]; ok {
+		return false
+	}
+	return r.Update != nil || r.UpdateContext != nil
+}
+
+func getDefaultTags(meta interface{}) map[string]string {
+	if client, ok := meta.(*TencentCloudClient); ok && client != nil {
+		return client.defaultTags
+	}
+	return nil
+}
+
+// ignoreTagsConfig is the tags managed outside terraform, which are ignored by all the resources
+type ignoreTagsConfig struct {
+	keys        map[string]bool
+	keyPrefixes []string
+}
+
+func (me *ignoreTagsConfig) ignored(key string) bool {
+	if me == nil {
+		return false
+	}
+	if me.keys[key] {
+		return true
+	}
+	for _, prefix := range me.keyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// filter returns tags without the ignored ones
+func (me *ignoreTagsConfig) filter(tags map[string]interface{}) map[string]interface{} {
+	if me == nil {
+		return tags
+	}
+	result := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		if !me.ignored(k) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+func getIgnoreTags(meta interface{}) *ignoreTagsConfig {
+	if client, ok := meta.(*TencentCloudClient); ok && client != nil {
+		return client.ignoreTags
+	}
+	return nil
+}
+
+// mergeDefaultTags returns the default tags of the provider merged with tags, tags win on conflict
+func mergeDefaultTags(meta interface{}, tags map[string]interface{}) map[string]interface{} {
+	allTags := make(map[string]interface{})
+	for k, v := range getDefaultTags(meta) {
+		allTags[k] = v
+	}
+	for k, v := range tags {
+		allTags[k] = v
+	}
+	return allTags
+}
+
+// mergeIgnoredTags returns the default tags of the provider merged with tags, and the ignored ones of remoteTags,
+// which are the tags of the resource before the update. It is for the APIs replacing all the tags of a resource,
+// so the tags managed outside terraform are kept.
+func mergeIgnoredTags(meta interface{}, tags map[string]interface{}, remoteTags map[string]string) map[string]interface{} {
+	allTags := mergeDefaultTags(meta, tags)
+	ignoreTags := getIgnoreTags(meta)
+	for k, v := range remoteTags {
+		if _, ok := allTags[k]; !ok && ignoreTags.ignored(k) {
+			allTags[k] = v
+		}
+	}
+	return allTags
+}
+
+// getTagsChange returns the old and new tags of the resource like d.GetChange("tags"),
+// but includes the default tags of the provider, so they are added or deleted as well,
+// and excludes the ignored tags from the old ones, so they are never deleted.
+func getTagsChange(d *schema.ResourceData, meta interface{}) (oldTags, newTags interface{}) {
+	oldTags, newTags = d.GetChange("tags")
+	// the state written before `tags_all` is introduced has only `tags`
+	if oldAll, _ := d.GetChange("tags_all"); oldAll != nil {
+		if oldAll, ok := oldAll.(map[string]interface{}); ok && len(oldAll) > 0 {
+			oldTags = oldAll
+		}
+	}
+	// the ignored tags are not deleted
+	oldTags = getIgnoreTags(meta).filter(oldTags.(map[string]interface{}))
+	newTags = mergeDefaultTags(meta, newTags.(map[string]interface{}))
+	return
+}
+
+// applyDefaultTags sets `tags` with the default tags merged before the resource is created,
+// it returns the configured tags
+func applyDefaultTags(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	configured := d.Get("tags").(map[string]interface{})
+	if len(getDefaultTags(meta)) > 0 {
+		_ = d.Set("tags", mergeDefaultTags(meta, configured))
+	}
+	return configured
+}
+
+// setTagsAll sets `tags_all` to the tags read from the resource without the ignored ones, and removes the default
+// tags from `tags` unless they are in the configured tags
+func setTagsAll(d *schema.ResourceData, meta interface{}, configured map[string]interface{}) {
+	if d.Id() == "" {
+		return
+	}
+	defaultTags := getDefaultTags(meta)
+	allTags := getIgnoreTags(meta).filter(d.Get("tags").(map[string]interface{}))
+	tags := make(map[string]interface{}, len(allTags))
+	for k, v := range allTags {
+		if _, ok := configured[k]; !ok {
+			if value, ok := defaultTags[k]; ok && value == v {
+				continue
+			}
+		}
+		tags[k] = v
+	}
+	_ = d.Set("tags", tags)
+	_ = d.Set("tags_all", allTags)
+}
+
+// removeIgnoredTags removes the ignored tags from the `tags` read from the resource
+func removeIgnoredTags(d *schema.ResourceData, meta interface{}) {
+	ignoreTags := getIgnoreTags(meta)
+	if d.Id() == "" || ignoreTags == nil {
+		return
+	}
+	_ = d.Set("tags", ignoreTags.filter(d.Get("tags").(map[string]interface{})))
+}
+
+func customizeDiffTagsAll(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+	return d.SetNew("tags_all", mergeDefaultTags(meta, d.Get("tags").(map[string]interface{})))
+}
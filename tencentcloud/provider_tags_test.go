@@ -0,0 +1,192 @@
+package tencentcloud
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+// testTaggableResource returns a resource whose tags are kept in apiTags like a cloud resource
+func testTaggableResource(apiTags map[string]string) *schema.Resource {
+	read := func(d *schema.ResourceData, meta interface{}) error {
+		tags := make(map[string]string)
+		for k, v := range apiTags {
+			tags[k] = v
+		}
+		return d.Set("tags", tags)
+	}
+	r := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			for k, v := range helper.GetTags(d, "tags") {
+				apiTags[k] = v
+			}
+			d.SetId("ins-test")
+			return read(d, meta)
+		},
+		Read: read,
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			if d.HasChanges("tags", "tags_all") {
+				oldTags, newTags := getTagsChange(d, meta)
+				replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+				for k, v := range replaceTags {
+					apiTags[k] = v
+				}
+				for _, k := range deleteTags {
+					delete(apiTags, k)
+				}
+			}
+			return read(d, meta)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+	withDefaultTags(r)
+	return r
+}
+
+func testApplyTags(t *testing.T, r *schema.Resource, state *terraform.InstanceState, tags map[string]interface{}, meta interface{}) *terraform.InstanceState {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"tags": tags})
+	diff, err := r.SimpleDiff(context.TODO(), state, config, meta)
+	if err != nil {
+		t.Fatalf("diff failed: %v", err)
+	}
+	if diff == nil {
+		return state
+	}
+	state, diags := r.Apply(context.TODO(), state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("apply failed: %v", diags)
+	}
+	return state
+}
+
+func testPlanHasDiff(t *testing.T, r *schema.Resource, state *terraform.InstanceState, tags map[string]interface{}, meta interface{}) bool {
+	state, diags := r.RefreshWithoutUpgrade(context.TODO(), state, meta)
+	if diags.HasError() {
+		t.Fatalf("refresh failed: %v", diags)
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"tags": tags})
+	diff, err := r.SimpleDiff(context.TODO(), state, config, meta)
+	if err != nil {
+		t.Fatalf("diff failed: %v", err)
+	}
+	return diff != nil && !diff.Empty()
+}
+
+func TestDefaultTags(t *testing.T) {
+	apiTags := make(map[string]string)
+	r := testTaggableResource(apiTags)
+	meta := &TencentCloudClient{defaultTags: map[string]string{"owner": "ops", "env": "prod"}}
+	configured := map[string]interface{}{"env": "test", "app": "web"}
+
+	state := testApplyTags(t, r, nil, configured, meta)
+	if expected := map[string]string{"owner": "ops", "env": "test", "app": "web"}; !reflect.DeepEqual(apiTags, expected) {
+		t.Errorf("expected tags %v applied, got %v", expected, apiTags)
+	}
+	if state.Attributes["tags.%"] != "2" || state.Attributes["tags_all.%"] != "3" || state.Attributes["tags_all.owner"] != "ops" {
+		t.Errorf("unexpected state %v", state.Attributes)
+	}
+	if testPlanHasDiff(t, r, state, configured, meta) {
+		t.Errorf("expected no diff with the default tags")
+	}
+
+	// the default tags changed
+	meta.defaultTags = map[string]string{"team": "infra"}
+	if !testPlanHasDiff(t, r, state, configured, meta) {
+		t.Errorf("expected diff of tags_all")
+	}
+	state = testApplyTags(t, r, state, configured, meta)
+	if expected := map[string]string{"team": "infra", "env": "test", "app": "web"}; !reflect.DeepEqual(apiTags, expected) {
+		t.Errorf("expected tags %v applied, got %v", expected, apiTags)
+	}
+	if testPlanHasDiff(t, r, state, configured, meta) {
+		t.Errorf("expected no diff after the default tags applied")
+	}
+
+	// the default tag is changed outside terraform
+	apiTags["team"] = "other"
+	if !testPlanHasDiff(t, r, state, configured, meta) {
+		t.Errorf("expected diff of the drifted default tag")
+	}
+}
+
+func TestDefaultTagsResources(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		tags, ok := r.Schema["tags"]
+		if !ok || tags.Type != schema.TypeMap || tags.ForceNew || r.Update == nil && r.UpdateContext == nil {
+			continue
+		}
+		if _, ok := r.Schema["tags_all"]; !ok && !defaultTagsExcludedResources[name] {
+			t.Errorf("%s has updatable tags but no tags_all", name)
+		}
+	}
+}
+
+func TestIgnoreTags(t *testing.T) {
+	apiTags := make(map[string]string)
+	r := testTaggableResource(apiTags)
+	meta := &TencentCloudClient{
+		defaultTags: map[string]string{"owner": "ops"},
+		ignoreTags: &ignoreTagsConfig{
+			keys:        map[string]bool{"cost-center": true},
+			keyPrefixes: []string{"tke-"},
+		},
+	}
+	configured := map[string]interface{}{"app": "web"}
+
+	state := testApplyTags(t, r, nil, configured, meta)
+
+	// the tags added outside terraform
+	apiTags["cost-center"] = "1024"
+	apiTags["tke-cluster-id"] = "cls-xxx"
+	if testPlanHasDiff(t, r, state, configured, meta) {
+		t.Errorf("expected no diff with the ignored tags")
+	}
+
+	configured = map[string]interface{}{"app": "api"}
+	state = testApplyTags(t, r, state, configured, meta)
+	expected := map[string]string{"owner": "ops", "app": "api", "cost-center": "1024", "tke-cluster-id": "cls-xxx"}
+	if !reflect.DeepEqual(apiTags, expected) {
+		t.Errorf("expected tags %v, got %v", expected, apiTags)
+	}
+	if _, ok := state.Attributes["tags_all.cost-center"]; ok {
+		t.Errorf("unexpected ignored tag in state %v", state.Attributes)
+	}
+
+	// the resource whose tags can not be updated
+	r = &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return d.Set("tags", apiTags)
+		},
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+	withDefaultTags(r)
+	withIgnoreTags(r)
+	d := r.TestResourceData()
+	d.SetId("ins-test")
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tags := d.Get("tags").(map[string]interface{}); len(tags) != 2 || tags["app"] != "api" {
+		t.Errorf("unexpected tags %v", tags)
+	}
+}
@@ -1,12 +1,22 @@
 package tencentcloud
 
 import (
+	"crypto/tls"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	sts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sts/v20180813"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/mockapi"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,6 +53,7 @@ const (
 
 func init() {
 	testAccProvider = Provider()
+	testAccProvider.ConfigureFunc = testAccConfigureWithTestTag(testAccProvider.ConfigureFunc)
 	testAccProviders = map[string]*schema.Provider{
 		"tencentcloud": testAccProvider,
 	}
@@ -63,6 +74,484 @@ func TestProviderImpl(t *testing.T) {
 	var _ = Provider()
 }
 
+func TestProviderConfigureSharedCredentials(t *testing.T) {
+	dir := t.TempDir()
+	writeProfile := func(name, credential, configure string) {
+		if err := ioutil.WriteFile(filepath.Join(dir, name+".credential"), []byte(credential), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if configure == "" {
+			return
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name+".configure"), []byte(configure), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeProfile("default", `{"secretId": "profile-id", "secretKey": "profile-key"}`, `{"_sys_param": {"region": "ap-shanghai", "output": "json"}}`)
+	writeProfile("dev", `{"secretId": "dev-id", "secretKey": "dev-key", "token": "dev-token"}`, "")
+	writeProfile("broken", `{"secretId": `, "")
+	writeProfile("idonly", `{"secretId": "id-only"}`, "")
+
+	for _, env := range []string{PROVIDER_SECRET_ID, PROVIDER_SECRET_KEY, PROVIDER_SECURITY_TOKEN, PROVIDER_REGION, PROVIDER_PROFILE, PROVIDER_SHARED_CREDENTIALS_DIR, PROVIDER_CAM_ROLE_NAME, PROVIDER_ASSUME_ROLE_ARN, PROVIDER_ASSUME_ROLE_SESSION_NAME} {
+		t.Setenv(env, "")
+	}
+
+	tests := []struct {
+		name      string
+		raw       map[string]interface{}
+		env       map[string]string
+		secretId  string
+		secretKey string
+		token     string
+		region    string
+		err       string
+	}{
+		{
+			name:      "profile",
+			raw:       map[string]interface{}{"shared_credentials_dir": dir},
+			secretId:  "profile-id",
+			secretKey: "profile-key",
+			region:    "ap-shanghai",
+		},
+		{
+			name:      "env over profile",
+			raw:       map[string]interface{}{"shared_credentials_dir": dir},
+			env:       map[string]string{PROVIDER_SECRET_ID: "env-id", PROVIDER_SECRET_KEY: "env-key"},
+			secretId:  "env-id",
+			secretKey: "env-key",
+			region:    "ap-shanghai",
+		},
+		{
+			name:      "config over env",
+			raw:       map[string]interface{}{"shared_credentials_dir": dir, "secret_id": "config-id", "secret_key": "config-key", "region": "ap-beijing"},
+			env:       map[string]string{PROVIDER_SECRET_ID: "env-id", PROVIDER_SECRET_KEY: "env-key", PROVIDER_REGION: "ap-guangzhou"},
+			secretId:  "config-id",
+			secretKey: "config-key",
+			region:    "ap-beijing",
+		},
+		{
+			name:      "profile from env",
+			raw:       map[string]interface{}{"region": "ap-guangzhou"},
+			env:       map[string]string{PROVIDER_SHARED_CREDENTIALS_DIR: dir, PROVIDER_PROFILE: "dev"},
+			secretId:  "dev-id",
+			secretKey: "dev-key",
+			token:     "dev-token",
+			region:    "ap-guangzhou",
+		},
+		{
+			name:      "env key pair without the token of profile",
+			raw:       map[string]interface{}{"shared_credentials_dir": dir, "profile": "dev", "region": "ap-guangzhou"},
+			env:       map[string]string{PROVIDER_SECRET_ID: "env-id", PROVIDER_SECRET_KEY: "env-key"},
+			secretId:  "env-id",
+			secretKey: "env-key",
+			region:    "ap-guangzhou",
+		},
+		{
+			name:      "config key pair without the token of env",
+			raw:       map[string]interface{}{"secret_id": "config-id", "secret_key": "config-key", "region": "ap-guangzhou"},
+			env:       map[string]string{PROVIDER_SECURITY_TOKEN: "env-token"},
+			secretId:  "config-id",
+			secretKey: "config-key",
+			region:    "ap-guangzhou",
+		},
+		{
+			name: "config id without the key of profile",
+			raw:  map[string]interface{}{"shared_credentials_dir": dir, "profile": "dev", "secret_id": "config-id", "region": "ap-guangzhou"},
+			err:  "`secret_id` is set from provider config, but `secret_key` is missing in it",
+		},
+		{
+			name: "missing region",
+			raw:  map[string]interface{}{"shared_credentials_dir": dir, "profile": "dev"},
+			err:  "`region` must be provided",
+		},
+		{
+			name: "missing profile",
+			raw:  map[string]interface{}{"shared_credentials_dir": dir, "profile": "absent"},
+			err:  "read shared credentials profile `absent` failed",
+		},
+		{
+			name: "broken profile",
+			raw:  map[string]interface{}{"shared_credentials_dir": dir, "profile": "broken"},
+			err:  "parse shared credentials profile `broken` file",
+		},
+		{
+			name: "secret key missing",
+			raw:  map[string]interface{}{"shared_credentials_dir": dir, "profile": "idonly", "region": "ap-guangzhou"},
+			err:  "`secret_id` is set from shared credentials profile `idonly`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tt.raw)
+			meta, err := providerConfigure(d)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expect error contains %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			conn := meta.(*TencentCloudClient).apiV3Conn
+			if conn.Credential.GetSecretId() != tt.secretId || conn.Credential.GetSecretKey() != tt.secretKey || conn.Credential.GetToken() != tt.token {
+				t.Errorf("unexpected credential: %+v", conn.Credential)
+			}
+			if conn.Region != tt.region {
+				t.Errorf("expect region %s, got %s", tt.region, conn.Region)
+			}
+		})
+	}
+}
+
+func TestProviderConfigureCamRole(t *testing.T) {
+	metadata := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cam/security-credentials/terraform-runner" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"TmpSecretId":"role-id","TmpSecretKey":"role-key","Token":"role-token","ExpiredTime":%d,"Code":"Success"}`, time.Now().Add(time.Hour).Unix())
+	}))
+	defer metadata.Close()
+
+	for _, env := range []string{PROVIDER_SECRET_ID, PROVIDER_SECRET_KEY, PROVIDER_SECURITY_TOKEN, PROVIDER_PROFILE, PROVIDER_SHARED_CREDENTIALS_DIR, PROVIDER_CAM_ROLE_NAME, PROVIDER_ASSUME_ROLE_ARN, PROVIDER_ASSUME_ROLE_SESSION_NAME} {
+		t.Setenv(env, "")
+	}
+	t.Setenv(PROVIDER_METADATA_ENDPOINT, metadata.URL)
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{"cam_role_name": "terraform-runner", "region": "ap-guangzhou"})
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	credential := meta.(*TencentCloudClient).apiV3Conn.Credential
+	if credential.GetSecretId() != "role-id" || credential.GetSecretKey() != "role-key" || credential.GetToken() != "role-token" {
+		t.Errorf("unexpected credential: %s/%s/%s", credential.GetSecretId(), credential.GetSecretKey(), credential.GetToken())
+	}
+
+	t.Setenv(PROVIDER_CAM_ROLE_NAME, "unbound-role")
+	d = schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{"region": "ap-guangzhou"})
+	_, err = providerConfigure(d)
+	if err == nil || !strings.Contains(err.Error(), "`cam_role_name` is set from environment variable `TENCENTCLOUD_CAM_ROLE_NAME`") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+// testAssumeRoleServer returns the mock STS endpoint, whose roles are assumable with the credential of trusts
+func testAssumeRoleServer(trusts map[string]string) *mockapi.Server {
+	server := mockapi.NewServer()
+	// the handlers run with the lock of the server, so the credentials of the roles are added beforehand
+	for roleArn := range trusts {
+		secretId := "AKID" + strings.TrimPrefix(roleArn, "qcs::cam::uin/100:roleName/")
+		server.AddCredential(secretId, "key-of-"+secretId)
+	}
+	server.Handle("sts", "AssumeRole", func(request *mockapi.Request) (interface{}, error) {
+		var params sts.AssumeRoleRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		if trusts[*params.RoleArn] != request.SecretId {
+			return nil, mockapi.NewError("AuthFailure.UnauthorizedOperation", "%s is not allowed to assume role %s", request.SecretId, *params.RoleArn)
+		}
+		secretId := "AKID" + strings.TrimPrefix(*params.RoleArn, "qcs::cam::uin/100:roleName/")
+		return map[string]interface{}{
+			"Credentials": map[string]interface{}{"TmpSecretId": secretId, "TmpSecretKey": "key-of-" + secretId, "Token": "token-of-" + secretId},
+			"ExpiredTime": time.Now().Add(time.Hour).Unix(),
+			"Expiration":  time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+		}, nil
+	})
+	return server
+}
+
+func testAssumeRoleRaw(server *mockapi.Server, roles ...string) map[string]interface{} {
+	assumeRoles := make([]interface{}, 0, len(roles))
+	for _, role := range roles {
+		assumeRoles = append(assumeRoles, map[string]interface{}{
+			"role_arn":         "qcs::cam::uin/100:roleName/" + role,
+			"session_name":     "terraform",
+			"session_duration": 3600,
+		})
+	}
+	return map[string]interface{}{
+		"secret_id":   mockapi.SecretId,
+		"secret_key":  mockapi.SecretKey,
+		"region":      "ap-guangzhou",
+		"endpoints":   []interface{}{map[string]interface{}{"sts": server.URL}},
+		"assume_role": assumeRoles,
+	}
+}
+
+func TestProviderConfigureAssumeRoleChain(t *testing.T) {
+	for _, env := range []string{PROVIDER_CAM_ROLE_NAME, PROVIDER_PROFILE, PROVIDER_SHARED_CREDENTIALS_DIR, PROVIDER_ASSUME_ROLE_ARN, PROVIDER_ASSUME_ROLE_SESSION_NAME} {
+		t.Setenv(env, "")
+	}
+	// role `deployer` is only assumable with the credential of role `ops`
+	server := testAssumeRoleServer(map[string]string{
+		"qcs::cam::uin/100:roleName/ops":      mockapi.SecretId,
+		"qcs::cam::uin/100:roleName/deployer": "AKIDops",
+	})
+	defer server.Close()
+
+	meta, err := providerConfigure(schema.TestResourceDataRaw(t, Provider().Schema, testAssumeRoleRaw(server, "ops", "deployer")))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	credential := meta.(*TencentCloudClient).apiV3Conn.Credential
+	if credential.GetSecretId() != "AKIDdeployer" || credential.GetToken() != "token-of-AKIDdeployer" {
+		t.Errorf("expected the credential of the last role, got %s/%s", credential.GetSecretId(), credential.GetToken())
+	}
+	if calls := server.Calls("sts", "AssumeRole"); calls != 2 {
+		t.Errorf("expected 2 AssumeRole calls, got %d", calls)
+	}
+}
+
+func TestProviderConfigureAssumeRoleError(t *testing.T) {
+	for _, env := range []string{PROVIDER_CAM_ROLE_NAME, PROVIDER_PROFILE, PROVIDER_SHARED_CREDENTIALS_DIR, PROVIDER_ASSUME_ROLE_ARN, PROVIDER_ASSUME_ROLE_SESSION_NAME} {
+		t.Setenv(env, "")
+	}
+	server := testAssumeRoleServer(map[string]string{"qcs::cam::uin/100:roleName/ops": mockapi.SecretId})
+	defer server.Close()
+
+	// the source credential is not trusted by role `deployer`
+	_, err := providerConfigure(schema.TestResourceDataRaw(t, Provider().Schema, testAssumeRoleRaw(server, "deployer")))
+	if err == nil || !strings.Contains(err.Error(), "assume role `qcs::cam::uin/100:roleName/deployer` from provider config `assume_role.0` failed") ||
+		!strings.Contains(err.Error(), "AuthFailure.UnauthorizedOperation") {
+		t.Errorf("expected the AssumeRole error, got %v", err)
+	}
+}
+
+func TestProviderConfigureRetry(t *testing.T) {
+	for _, env := range []string{PROVIDER_CAM_ROLE_NAME, PROVIDER_PROFILE, PROVIDER_SHARED_CREDENTIALS_DIR, PROVIDER_ASSUME_ROLE_ARN, PROVIDER_ASSUME_ROLE_SESSION_NAME} {
+		t.Setenv(env, "")
+	}
+	defer func(read, write time.Duration) {
+		readRetryTimeout, writeRetryTimeout = read, write
+		retryTimeouts.values = make(map[string]time.Duration)
+	}(readRetryTimeout, writeRetryTimeout)
+
+	raw := map[string]interface{}{
+		"secret_id":  "id",
+		"secret_key": "key",
+		"region":     "ap-guangzhou",
+		"retry": []interface{}{map[string]interface{}{
+			"max_attempts":  5,
+			"min_backoff":   2,
+			"max_backoff":   20,
+			"read_timeout":  10,
+			"write_timeout": 20,
+			"retryable_error_codes": []interface{}{
+				map[string]interface{}{"product": "cvm", "codes": []interface{}{"ResourceInsufficient"}},
+				map[string]interface{}{"codes": []interface{}{"InternalError"}},
+			},
+		}},
+	}
+	meta, err := providerConfigure(schema.TestResourceDataRaw(t, Provider().Schema, raw))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	policy := meta.(*TencentCloudClient).apiV3Conn.RetryPolicy
+	if policy == nil || policy.MaxAttempts != 5 || policy.MinBackoff != 2*time.Second || policy.MaxBackoff != 20*time.Second {
+		t.Errorf("unexpected retry policy: %+v", policy)
+	}
+	if codes := policy.ErrorCodes("cvm"); len(codes) != 2 {
+		t.Errorf("unexpected cvm retryable error codes: %v", codes)
+	}
+	if readRetryTimeout != 10*time.Minute || writeRetryTimeout != 20*time.Minute {
+		t.Errorf("unexpected retry timeouts: %s, %s", readRetryTimeout, writeRetryTimeout)
+	}
+
+	// the timeouts are shared by all the provider configurations, an alias must not change them
+	alias := map[string]interface{}{
+		"secret_id":  "id",
+		"secret_key": "key",
+		"region":     "ap-shanghai",
+		"retry":      []interface{}{map[string]interface{}{"max_attempts": 2, "read_timeout": 5}},
+	}
+	_, err = providerConfigure(schema.TestResourceDataRaw(t, Provider().Schema, alias))
+	if err == nil || !strings.Contains(err.Error(), "`retry.read_timeout` 5 conflicts with 10 of another provider configuration") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	alias["retry"] = []interface{}{map[string]interface{}{"max_attempts": 2, "read_timeout": 10}}
+	aliasMeta, err := providerConfigure(schema.TestResourceDataRaw(t, Provider().Schema, alias))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p := aliasMeta.(*TencentCloudClient).apiV3Conn.RetryPolicy; p.MaxAttempts != 2 || policy.MaxAttempts != 5 {
+		t.Errorf("the policies of provider configurations must be separated, got %+v and %+v", p, policy)
+	}
+
+	raw["retry"] = []interface{}{map[string]interface{}{"min_backoff": 30, "max_backoff": 10}}
+	_, err = providerConfigure(schema.TestResourceDataRaw(t, Provider().Schema, raw))
+	if err == nil || !strings.Contains(err.Error(), "`retry.min_backoff` 30 must not be greater than `retry.max_backoff` 10") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// invalid environment variables are reported instead of panicking
+	defer func(old []string) { invalidEnvs = old }(invalidEnvs)
+	t.Setenv(PROVIDER_READ_RETRY_TIMEOUT, "3m")
+	if v := getEnvDefault(PROVIDER_READ_RETRY_TIMEOUT, 3); v != 3 {
+		t.Errorf("expect default value, got %d", v)
+	}
+	_, err = providerConfigure(schema.TestResourceDataRaw(t, Provider().Schema, raw))
+	if err == nil || !strings.Contains(err.Error(), "`TENCENTCLOUD_READ_RETRY_TIMEOUT` must be int") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestProviderConfigureApiRateLimits(t *testing.T) {
+	for _, env := range []string{PROVIDER_CAM_ROLE_NAME, PROVIDER_PROFILE, PROVIDER_SHARED_CREDENTIALS_DIR, PROVIDER_ASSUME_ROLE_ARN, PROVIDER_ASSUME_ROLE_SESSION_NAME} {
+		t.Setenv(env, "")
+	}
+	raw := map[string]interface{}{
+		"secret_id":  "id",
+		"secret_key": "key",
+		"region":     "ap-guangzhou",
+		"api_rate_limits": map[string]interface{}{
+			"cvm":              "20",
+			"cvm.RunInstances": "5",
+		},
+	}
+	meta, err := providerConfigure(schema.TestResourceDataRaw(t, Provider().Schema, raw))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	limits := meta.(*TencentCloudClient).apiV3Conn.RateLimits
+	if limit := limits.Limit("cvm", "RunInstances"); limit != 5 {
+		t.Errorf("expected limit 5 of cvm.RunInstances, got %d", limit)
+	}
+	if limit := limits.Limit("cvm", "DescribeInstances"); limit != 20 {
+		t.Errorf("expected limit 20 of cvm.DescribeInstances, got %d", limit)
+	}
+
+	// the limits of another provider configuration are not changed
+	delete(raw, "api_rate_limits")
+	meta, err = providerConfigure(schema.TestResourceDataRaw(t, Provider().Schema, raw))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if limit := meta.(*TencentCloudClient).apiV3Conn.RateLimits.Limit("cvm", "RunInstances"); limit != 10 {
+		t.Errorf("expected the default limit 10 of cvm.RunInstances, got %d", limit)
+	}
+	if limit := limits.Limit("cvm", "RunInstances"); limit != 5 {
+		t.Errorf("expected limit 5 of cvm.RunInstances kept, got %d", limit)
+	}
+
+	_, errs := validateApiRateLimits(map[string]interface{}{"cvm.": 1, "cbs": "0", "a.b.c": 1}, "api_rate_limits")
+	if len(errs) != 3 {
+		t.Errorf("expected 3 errors, got %v", errs)
+	}
+}
+
+func TestProviderConfigureLog(t *testing.T) {
+	raw := map[string]interface{}{
+		"log": []interface{}{map[string]interface{}{
+			"redacted_fields": []interface{}{"UserData"},
+		}},
+	}
+	config, err := getLogConfig(schema.TestResourceDataRaw(t, Provider().Schema, raw))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.FullPayload || len(config.RedactedFields) != 1 || config.RedactedFields[0] != "UserData" {
+		t.Errorf("unexpected log config: %+v", config)
+	}
+
+	t.Setenv(PROVIDER_LOG_FULL_PAYLOAD, "true")
+	if config, _ = getLogConfig(schema.TestResourceDataRaw(t, Provider().Schema, raw)); !config.FullPayload {
+		t.Errorf("expected full payload from %s", PROVIDER_LOG_FULL_PAYLOAD)
+	}
+
+	t.Setenv(PROVIDER_LOG_FULL_PAYLOAD, "yes")
+	if _, err = getLogConfig(schema.TestResourceDataRaw(t, Provider().Schema, raw)); err == nil {
+		t.Errorf("expected error of invalid %s", PROVIDER_LOG_FULL_PAYLOAD)
+	}
+}
+
+func TestProviderConfigureEndpoints(t *testing.T) {
+	for _, env := range []string{PROVIDER_CAM_ROLE_NAME, PROVIDER_PROFILE, PROVIDER_SHARED_CREDENTIALS_DIR, PROVIDER_ASSUME_ROLE_ARN, PROVIDER_ASSUME_ROLE_SESSION_NAME} {
+		t.Setenv(env, "")
+	}
+
+	raw := map[string]interface{}{
+		"secret_id":  "id",
+		"secret_key": "key",
+		"region":     "ap-guangzhou",
+		"endpoints": []interface{}{map[string]interface{}{
+			"cvm":         "cvm.internal.example.com",
+			"cos_control": "http://127.0.0.1:9000",
+		}},
+	}
+	meta, err := providerConfigure(schema.TestResourceDataRaw(t, Provider().Schema, raw))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]string{"cvm": "cvm.internal.example.com", "cos-control": "http://127.0.0.1:9000"}
+	if endpoints := meta.(*TencentCloudClient).apiV3Conn.Endpoints; !reflect.DeepEqual(endpoints, expected) {
+		t.Errorf("expected endpoints %v, got %v", expected, endpoints)
+	}
+
+	for _, endpoint := range []string{"cvm.internal.example.com", "127.0.0.1:8080", "http://127.0.0.1:8080", "https://cvm.internal.example.com/"} {
+		if _, errs := validateEndpoint(endpoint, "endpoints.0.cvm"); len(errs) > 0 {
+			t.Errorf("unexpected errors of %s: %v", endpoint, errs)
+		}
+	}
+	for _, endpoint := range []string{"", "cvm.internal.example.com/api", "ftp://127.0.0.1", "http://127.0.0.1/api"} {
+		if _, errs := validateEndpoint(endpoint, "endpoints.0.cvm"); len(errs) == 0 {
+			t.Errorf("expected error of %q", endpoint)
+		}
+	}
+}
+
+func TestProviderConfigureTransport(t *testing.T) {
+	for _, env := range []string{PROVIDER_CAM_ROLE_NAME, PROVIDER_PROFILE, PROVIDER_SHARED_CREDENTIALS_DIR, PROVIDER_ASSUME_ROLE_ARN, PROVIDER_ASSUME_ROLE_SESSION_NAME, PROVIDER_CA_BUNDLE_FILE} {
+		t.Setenv(env, "")
+	}
+
+	raw := map[string]interface{}{
+		"secret_id":       "id",
+		"secret_key":      "key",
+		"region":          "ap-guangzhou",
+		"proxy_url":       "http://127.0.0.1:3128",
+		"min_tls_version": "1.3",
+		"connect_timeout": 5,
+	}
+	meta, err := providerConfigure(schema.TestResourceDataRaw(t, Provider().Schema, raw))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	transport, ok := meta.(*TencentCloudClient).apiV3Conn.HTTPTransport.(*http.Transport)
+	if !ok {
+		t.Fatalf("unexpected transport %v", meta.(*TencentCloudClient).apiV3Conn.HTTPTransport)
+	}
+	request, _ := http.NewRequest(http.MethodPost, "https://cvm.tencentcloudapi.com", nil)
+	if proxy, err := transport.Proxy(request); err != nil || proxy.String() != "http://127.0.0.1:3128" {
+		t.Errorf("unexpected proxy %v: %v", proxy, err)
+	}
+	if transport.TLSClientConfig.MinVersion != tls.VersionTLS13 || transport.TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("unexpected tls config %+v", transport.TLSClientConfig)
+	}
+
+	raw["ca_bundle_file"] = filepath.Join(t.TempDir(), "ca.pem")
+	if _, err := providerConfigure(schema.TestResourceDataRaw(t, Provider().Schema, raw)); err == nil {
+		t.Errorf("expected error of the missing ca bundle file")
+	}
+
+	for _, proxy := range []string{"http://127.0.0.1:3128", "socks5://proxy.example.com:1080"} {
+		if _, errs := validateProxyURL(proxy, "proxy_url"); len(errs) > 0 {
+			t.Errorf("unexpected errors of %s: %v", proxy, errs)
+		}
+	}
+	for _, proxy := range []string{"", "127.0.0.1:3128", "ftp://127.0.0.1"} {
+		if _, errs := validateProxyURL(proxy, "proxy_url"); len(errs) == 0 {
+			t.Errorf("expected error of %q", proxy)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv(PROVIDER_SECRET_ID); v == "" {
 		t.Fatalf("%v must be set for acceptance tests\n", PROVIDER_SECRET_ID)
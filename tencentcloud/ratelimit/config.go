@@ -8,27 +8,17 @@ const (
 
 func init() {
 
-	//old  (filename . key)
-	limitConfig["resource_tc_instance"] = 50
-	limitConfig["resource_tc_instance.create"] = 10
-	limitConfig["resource_tc_instance.update"] = 10
-	limitConfig["resource_tc_instance.delete"] = 10
+	// product . action
+	limitConfig["cvm.RunInstances"] = 10
+	limitConfig["cvm.ModifyInstancesAttribute"] = 10
+	limitConfig["cvm.TerminateInstances"] = 10
 
-	//new(filename . action)
-	limitConfig["service_tencentcloud_mysql"] = 50
-	limitConfig["service_tencentcloud_mysql.CreateDBInstanceHour"] = 20
-	limitConfig["service_tencentcloud_mysql.OfflineIsolatedInstances"] = 20
-	limitConfig["service_tencentcloud_mysql.CreateBackup"] = 5
-	limitConfig["service_tencentcloud_mysql.ModifyInstanceParam"] = 20
+	limitConfig["cdb"] = 50
+	limitConfig["cdb.CreateDBInstanceHour"] = 20
+	limitConfig["cdb.OfflineIsolatedInstances"] = 20
+	limitConfig["cdb.CreateBackup"] = 5
+	limitConfig["cdb.ModifyInstanceParam"] = 20
 
-	//new(filename)
-	limitConfig["service_tencentcloud_cos"] = DefaultLimit
-	limitConfig["service_tencentcloud_vpc"] = DefaultLimit
-	limitConfig["service_tencentcloud_redis"] = DefaultLimit
-	limitConfig["service_tencentcloud_mongodb"] = DefaultLimit
-	limitConfig["service_tencentcloud_dcg"] = DefaultLimit
-	limitConfig["service_tencentcloud_dc"] = 5
-	limitConfig["service_tencentcloud_ccn"] = DefaultLimit
-	limitConfig["service_tencentcloud_cbs"] = DefaultLimit
-	limitConfig["service_tencentcloud_as"] = DefaultLimit
+	// product
+	limitConfig["dc"] = 5
 }
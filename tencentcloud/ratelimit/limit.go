@@ -1,73 +1,130 @@
 package ratelimit
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"math/rand"
-	"runtime"
-	"strings"
 	"sync"
 	"time"
-
-	"github.com/yangwenmai/ratelimit/simpleratelimit"
 )
 
-var (
-	limitConfig = make(map[string]int64)
-
-	limitContainer = make(map[string]*simpleratelimit.RateLimiter)
-
-	locker sync.Mutex
-)
-
-func ProCheck(namespace, action string) {
-
-	key := fmt.Sprintf("%s.%s", namespace, action)
-
-	var limit *simpleratelimit.RateLimiter
+// limitConfig is the default QPS by `product` or `product.Action`, see init
+var limitConfig = make(map[string]int64)
 
-	locker.Lock()
-
-	limitNumber := limitConfig[key]
-	if limitNumber == 0 {
-		limitNumber = DefaultLimit
+// Limiter is a token bucket which allows `qps` requests per second, with bursts of up to `qps` requests
+type Limiter struct {
+	mu     sync.Mutex
+	qps    float64
+	tokens float64
+	last   time.Time
+}
 
-		if limitConfig[namespace] != 0 {
-			limitNumber = limitConfig[namespace]
-		}
-		limitConfig[key] = limitNumber
+// NewLimiter returns a full token bucket of qps
+func NewLimiter(qps int64) *Limiter {
+	return &Limiter{
+		qps:    float64(qps),
+		tokens: float64(qps),
+		last:   time.Now(),
 	}
+}
 
-	if limitContainer[key] == nil {
-		limitContainer[key] = simpleratelimit.New(int(limitNumber), time.Second)
+// Wait blocks until a token is available or ctx is done
+func (me *Limiter) Wait(ctx context.Context) error {
+	me.mu.Lock()
+	now := time.Now()
+	me.tokens += now.Sub(me.last).Seconds() * me.qps
+	if me.tokens > me.qps {
+		me.tokens = me.qps
 	}
+	me.last = now
 
-	limit = limitContainer[key]
-	locker.Unlock()
+	// reserve a token, a negative balance means the requests queued before
+	me.tokens--
+	if me.tokens >= 0 {
+		me.mu.Unlock()
+		return nil
+	}
+	wait := time.Duration(-me.tokens / me.qps * float64(time.Second))
+	me.mu.Unlock()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// give the reserved token back
+		me.mu.Lock()
+		me.tokens++
+		me.mu.Unlock()
+		return ctx.Err()
+	}
+}
 
-	old := time.Now()
+// Limits are the QPS limits of the actions of the products, each provider configuration has its own limits,
+// so the requests of a provider alias are not limited by the others
+type Limits struct {
+	mu       sync.Mutex
+	config   map[string]int64
+	limiters map[string]*Limiter
+}
 
-	for limit.Limit() {
-		//Prevent wake at same time
-		sleepMs := 10 + rand.Intn(30)
-		time.Sleep(time.Duration(sleepMs) * time.Microsecond)
+// defaultLimits limits the requests of the clients without their own limits
+var defaultLimits = NewLimits(nil)
 
-		if time.Since(old) > 5*time.Minute {
-			log.Printf("[WARN] %s wait too long, we try to release it", key)
-			break
-		}
+// NewLimits returns the limits which override limitConfig, the key is `product` which applies to every action of the
+// product, or `product.Action` which applies to the action only, like `cvm` or `cvm.RunInstances`.
+func NewLimits(limits map[string]int64) *Limits {
+	config := make(map[string]int64, len(limits))
+	for key, limit := range limits {
+		config[key] = limit
 	}
-
+	return &Limits{config: config, limiters: make(map[string]*Limiter)}
 }
 
-func Check(action string) {
+// Limit returns the QPS of the action of product
+func (me *Limits) Limit(product, action string) int64 {
+	if me == nil {
+		me = defaultLimits
+	}
+	if limit := me.get(fmt.Sprintf("%s.%s", product, action)); limit > 0 {
+		return limit
+	}
+	if limit := me.get(product); limit > 0 {
+		return limit
+	}
+	return DefaultLimit
+}
 
-	_, filePath, _, _ := runtime.Caller(1)
+func (me *Limits) get(key string) int64 {
+	if limit, ok := me.config[key]; ok {
+		return limit
+	}
+	return limitConfig[key]
+}
 
-	items := strings.Split(filePath, `/`)
-	items = strings.Split(items[len(items)-1], `\`)
+// Wait blocks until the action of product is allowed by its limit, or ctx is done.
+// The default limits apply if me is nil.
+func (me *Limits) Wait(ctx context.Context, product, action string) error {
+	if me == nil {
+		me = defaultLimits
+	}
+	key := fmt.Sprintf("%s.%s", product, action)
 
-	fileName := strings.TrimSuffix(items[len(items)-1], ".go")
+	me.mu.Lock()
+	limit := me.limiters[key]
+	if limit == nil {
+		limit = NewLimiter(me.Limit(product, action))
+		me.limiters[key] = limit
+	}
+	me.mu.Unlock()
 
-	ProCheck(fileName, action)
+	start := time.Now()
+	if err := limit.Wait(ctx); err != nil {
+		return fmt.Errorf("wait for rate limit of %s failed: %v", key, err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		log.Printf("[DEBUG] %s waited %s for rate limit", key, elapsed)
+	}
+	return nil
 }
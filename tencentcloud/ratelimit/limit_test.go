@@ -0,0 +1,91 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLimiterWait(t *testing.T) {
+	limiter := NewLimiter(10)
+
+	start := time.Now()
+	for i := 0; i < 15; i++ {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	// the first 10 requests pass with the burst, the other 5 wait 0.5s in total
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond || elapsed > 2*time.Second {
+		t.Errorf("unexpected elapsed %s", elapsed)
+	}
+}
+
+func TestLimiterWaitCanceled(t *testing.T) {
+	limiter := NewLimiter(1)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	// the canceled request gave its token back
+	limiter.mu.Lock()
+	tokens := limiter.tokens
+	limiter.mu.Unlock()
+	if tokens < -0.5 {
+		t.Errorf("expected the token given back, got %f", tokens)
+	}
+}
+
+func TestLimitsLimit(t *testing.T) {
+	limits := NewLimits(map[string]int64{
+		"vpc":                  30,
+		"vpc.CreateVpc":        3,
+		"cvm.RunInstances":     2,
+		"unknown.DescribeFoos": 1,
+	})
+
+	cases := []struct {
+		limits          *Limits
+		product, action string
+		expected        int64
+	}{
+		{limits, "vpc", "CreateVpc", 3},
+		{limits, "vpc", "DescribeVpcs", 30},
+		{limits, "cvm", "RunInstances", 2},
+		{limits, "cvm", "DescribeInstances", DefaultLimit},
+		{limits, "cdb", "CreateBackup", 5},
+		{limits, "cdb", "DescribeDBInstances", 50},
+		{limits, "unknown", "DescribeFoos", 1},
+		// the limits of the other clients are not changed
+		{nil, "cvm", "RunInstances", 10},
+		{NewLimits(nil), "vpc", "CreateVpc", DefaultLimit},
+	}
+	for _, c := range cases {
+		if limit := c.limits.Limit(c.product, c.action); limit != c.expected {
+			t.Errorf("%s.%s: expected %d, got %d", c.product, c.action, c.expected, limit)
+		}
+	}
+}
+
+func TestLimitsWait(t *testing.T) {
+	limited := NewLimits(map[string]int64{"cvm.DescribeInstances": 1})
+	if err := limited.Wait(context.Background(), "cvm", "DescribeInstances"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := limited.Wait(ctx, "cvm", "DescribeInstances"); err == nil {
+		t.Errorf("expected the request to be limited")
+	}
+	// the requests of other limits have their own limiters
+	if err := NewLimits(nil).Wait(ctx, "cvm", "DescribeInstances"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -58,7 +58,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	apigateway "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apigateway/v20180808"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudAPIGatewayAPI() *schema.Resource {
@@ -467,7 +466,6 @@ func resourceTencentCloudAPIGatewayAPICreate(d *schema.ResourceData, meta interf
 	}
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = apiGatewayService.client.UseAPIGatewayClient().CreateApi(request)
 		if err != nil {
 			return retryError(err)
@@ -790,7 +788,6 @@ func resourceTencentCloudAPIGatewayAPIUpdate(d *schema.ResourceData, meta interf
 	}
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = apiGatewayService.client.UseAPIGatewayClient().ModifyApi(request)
 		if err != nil {
 			return retryError(err)
@@ -244,11 +244,11 @@ func resourceTencentCloudApmInstanceUpdate(d *schema.ResourceData, meta interfac
 
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		ctx := context.WithValue(context.TODO(), logIdKey, logId)
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("apm", "apm-instance", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
@@ -1,6 +1,8 @@
 /*
 Provides a resource to create a group of AS (Auto scaling) instances.
 
+~> **NOTE:** When `instance_refresh` is set, changing `configuration_id` replaces the instances of the old launch configurations in batches: the new instances are scaled out and the old ones are removed from the scaling group by `strategy`, and the scaling activities wait for the lifecycle hooks. The instances protected from scale in by `tencentcloud_as_protect_instances` and the attached ones are kept. If the refresh fails, the remaining instances are kept in `outdated_instance_ids`, and the next apply refreshes them. If the scale out of `TERMINATE_BEFORE_LAUNCH` fails, the desired capacity lowered by the removed instances is restored, so the scaling group launches them again.
+
 Example Usage
 
 ```hcl
@@ -30,6 +32,28 @@ resource "tencentcloud_as_scaling_group" "scaling_group" {
 }
 ```
 
+Refresh the instances in batches when the launch configuration changes
+
+```hcl
+resource "tencentcloud_as_scaling_group" "scaling_group" {
+  scaling_group_name = "tf-as-scaling-group"
+  configuration_id   = tencentcloud_as_scaling_config.launch_configuration.id
+  max_size           = 6
+  min_size           = 2
+  desired_capacity   = 4
+  vpc_id             = "vpc-3efmz0z"
+  subnet_ids         = ["subnet-mc3egos"]
+
+  instance_refresh {
+    strategy               = "LAUNCH_BEFORE_TERMINATE"
+    min_healthy_percentage = 75
+    batch_size             = 2
+    checkpoint_percentages = [50]
+    checkpoint_delay       = 600
+  }
+}
+```
+
 Import
 
 AutoScaling Groups can be imported using the id, e.g.
@@ -44,24 +68,31 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudAsScalingGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsScalingGroupCreate,
-		Read:   resourceTencentCloudAsScalingGroupRead,
-		Update: resourceTencentCloudAsScalingGroupUpdate,
-		Delete: resourceTencentCloudAsScalingGroupDelete,
+		Create:        resourceTencentCloudAsScalingGroupCreate,
+		Read:          resourceTencentCloudAsScalingGroupRead,
+		UpdateContext: resourceTencentCloudAsScalingGroupUpdateContext,
+		Delete:        resourceTencentCloudAsScalingGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: asScalingGroupInstanceRefreshCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(3 * time.Hour),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_name": {
@@ -73,7 +104,7 @@ func resourceTencentCloudAsScalingGroup() *schema.Resource {
 			"configuration_id": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "An available ID for a launch configuration.",
+				Description: "An available ID for a launch configuration. Only the instances created after it changes use the new launch configuration, unless `instance_refresh` is set.",
 			},
 			"max_size": {
 				Type:         schema.TypeInt,
@@ -215,6 +246,50 @@ func resourceTencentCloudAsScalingGroup() *schema.Resource {
 				Optional:    true,
 				Description: "Tags of a scaling group.",
 			},
+			"instance_refresh": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Settings of replacing the instances of the old launch configuration in batches when `configuration_id` changes. The instances protected from scale in and the attached ones are kept.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"strategy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      SCALING_GROUP_INSTANCE_REFRESH_LAUNCH_FIRST,
+							ValidateFunc: validateAllowedStringValue(SCALING_GROUP_INSTANCE_REFRESH_STRATEGIES),
+							Description:  "Strategy of replacing a batch. `LAUNCH_BEFORE_TERMINATE` scales out the new instances first, it is limited by `max_size`. `TERMINATE_BEFORE_LAUNCH` removes the old instances first, it is limited by `min_size` and `min_healthy_percentage`. Default is `LAUNCH_BEFORE_TERMINATE`.",
+						},
+						"min_healthy_percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      90,
+							ValidateFunc: validateIntegerInRange(0, 100),
+							Description:  "Percentage of the instances which stay in service during the replacement, it limits the batches of `TERMINATE_BEFORE_LAUNCH`. Default is `90`.",
+						},
+						"batch_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validateIntegerInRange(1, 2000),
+							Description:  "Max number of the instances replaced in a batch. Default is `1`.",
+						},
+						"checkpoint_percentages": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validateIntegerInRange(1, 99)},
+							Description: "Percentages of the replaced instances to pause at for `checkpoint_delay`, e.g. `[20, 50]`.",
+						},
+						"checkpoint_delay": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      300,
+							ValidateFunc: validateIntegerInRange(0, 3600),
+							Description:  "Seconds to pause at each checkpoint. Default is `300`.",
+						},
+					},
+				},
+			},
 
 			// computed value
 			"status": {
@@ -232,6 +307,12 @@ func resourceTencentCloudAsScalingGroup() *schema.Resource {
 				Computed:    true,
 				Description: "The time when the AS group was created.",
 			},
+			"outdated_instance_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the instances of the old launch configurations, which are refreshed by the next apply. It is only set when `instance_refresh` is set, and the instances protected from scale in and the attached ones are not included.",
+			},
 			"multi_zone_subnet_policy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -360,8 +441,6 @@ func resourceTencentCloudAsScalingGroupCreate(d *schema.ResourceData, meta inter
 
 	var id string
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := meta.(*TencentCloudClient).apiV3Conn.UseAsClient().CreateAutoScalingGroup(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -458,6 +537,21 @@ func resourceTencentCloudAsScalingGroupRead(d *schema.ResourceData, meta interfa
 		_ = d.Set("multi_zone_subnet_policy", scalingGroup.MultiZoneSubnetPolicy)
 	}
 
+	outdated := make([]string, 0)
+	if len(d.Get("instance_refresh").([]interface{})) > 0 {
+		instances, err := describeAsScalingGroupInstances(ctx, &asService, scalingGroupId)
+		if err != nil {
+			return err
+		}
+		for _, instance := range instances {
+			protected := instance.ProtectedFromScaleIn != nil && *instance.ProtectedFromScaleIn
+			if asInstanceOutdated(instance, helper.PString(scalingGroup.LaunchConfigurationId)) && !protected {
+				outdated = append(outdated, *instance.InstanceId)
+			}
+		}
+	}
+	_ = d.Set("outdated_instance_ids", outdated)
+
 	if v := d.Get("scaling_mode"); v != "" {
 		_ = d.Set("scaling_mode", v.(string))
 	}
@@ -526,6 +620,10 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 		updateAttrs = append(updateAttrs, "scaling_group_name")
 		request.AutoScalingGroupName = helper.String(d.Get("scaling_group_name").(string))
 	}
+	if d.HasChange("configuration_id") {
+		updateAttrs = append(updateAttrs, "configuration_id")
+		request.LaunchConfigurationId = helper.String(d.Get("configuration_id").(string))
+	}
 	if d.HasChange("max_size") {
 		updateAttrs = append(updateAttrs, "max_size")
 		request.MaxSize = helper.IntUint64(d.Get("max_size").(int))
@@ -605,8 +703,6 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.UseAsClient().ModifyAutoScalingGroup(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -666,8 +762,6 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 
 	if len(updateAttrs) > 0 {
 		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(balancerRequest.GetAction())
-
 			balancerResponse, err := client.UseAsClient().ModifyLoadBalancers(balancerRequest)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -684,8 +778,8 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldValue, newValue := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldValue, newValue := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("as", "auto-scaling-group", region, d.Id())
@@ -700,6 +794,271 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 	return resourceTencentCloudAsScalingGroupRead(d, meta)
 }
 
+// asScalingGroupInstanceRefreshCustomizeDiff plans the refresh of the instances by `instance_refresh` when
+// `configuration_id` changes, or when the instances of the old launch configurations are left by a failed refresh
+func asScalingGroupInstanceRefreshCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || len(d.Get("instance_refresh").([]interface{})) == 0 {
+		return nil
+	}
+	if d.HasChange("configuration_id") || len(d.Get("outdated_instance_ids").([]interface{})) > 0 {
+		return d.SetNewComputed("outdated_instance_ids")
+	}
+	return nil
+}
+
+// resourceTencentCloudAsScalingGroupUpdateContext updates the scaling group and refreshes its instances if needed,
+// the warnings and the progress of the refresh are returned as diagnostics
+func resourceTencentCloudAsScalingGroupUpdateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	meta = contextMeta(ctx, meta)
+	outdated, _ := d.GetChange("outdated_instance_ids")
+	refresh := len(d.Get("instance_refresh").([]interface{})) > 0 &&
+		(d.HasChange("configuration_id") || len(outdated.([]interface{})) > 0)
+
+	if err := resourceTencentCloudAsScalingGroupUpdate(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	if !refresh {
+		return nil
+	}
+
+	// the instances left by a failed refresh are kept in `outdated_instance_ids`, so the next apply resumes it
+	diags := refreshAsScalingGroupInstances(d, meta)
+	return append(diags, diag.FromErr(resourceTencentCloudAsScalingGroupRead(d, meta))...)
+}
+
+// asInstanceOutdated returns whether the instance is created by the scaling group with an old launch configuration
+func asInstanceOutdated(instance *as.Instance, configurationId string) bool {
+	return helper.PString(instance.CreationType) == SCALING_GROUP_INSTANCE_CREATION_TYPE_AUTO &&
+		helper.PString(instance.LaunchConfigurationId) != configurationId
+}
+
+// asInstanceRefreshBatch returns the number of the instances replaced in a batch, which is limited by the max size
+// for LAUNCH_BEFORE_TERMINATE, and by the min size and the healthy instances for TERMINATE_BEFORE_LAUNCH
+func asInstanceRefreshBatch(strategy string, desired, minSize, maxSize, inService int64, minHealthyPercentage, batchSize int) int64 {
+	size := int64(batchSize)
+	if strategy == SCALING_GROUP_INSTANCE_REFRESH_LAUNCH_FIRST {
+		if size > maxSize-desired {
+			size = maxSize - desired
+		}
+	} else {
+		if size > desired-minSize {
+			size = desired - minSize
+		}
+		// the healthy instances are rounded up
+		minHealthy := (inService*int64(minHealthyPercentage) + 99) / 100
+		if size > inService-minHealthy {
+			size = inService - minHealthy
+		}
+	}
+	if size < 0 {
+		size = 0
+	}
+	return size
+}
+
+// refreshAsScalingGroupInstances replaces the instances of the old launch configurations in batches by
+// `instance_refresh`. In each batch, the new instances are scaled out and the old ones are removed, in the order of
+// the strategy, and the activities are waited, so the lifecycle hooks of the scaling group apply. The replacement
+// pauses for `checkpoint_delay` when the replaced percentage reaches a checkpoint.
+func refreshAsScalingGroupInstances(d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		logId                = getLogId(contextNil)
+		ctx                  = context.WithValue(context.TODO(), logIdKey, logId)
+		client               = meta.(*TencentCloudClient).apiV3Conn
+		asService            = AsService{client: client}
+		scalingGroupId       = d.Id()
+		configurationId      = d.Get("configuration_id").(string)
+		strategy             = d.Get("instance_refresh.0.strategy").(string)
+		minHealthyPercentage = d.Get("instance_refresh.0.min_healthy_percentage").(int)
+		batchSize            = d.Get("instance_refresh.0.batch_size").(int)
+		checkpointDelay      = time.Duration(d.Get("instance_refresh.0.checkpoint_delay").(int)) * time.Second
+		deadline             = time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+	)
+	checkpoints := make([]int, 0)
+	for _, v := range d.Get("instance_refresh.0.checkpoint_percentages").([]interface{}) {
+		checkpoints = append(checkpoints, v.(int))
+	}
+	sort.Ints(checkpoints)
+
+	scalingGroup, has, err := asService.DescribeAutoScalingGroupById(ctx, scalingGroupId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if has == 0 {
+		return diag.Errorf("scaling group %s is not found", scalingGroupId)
+	}
+	instances, err := describeAsScalingGroupInstances(ctx, &asService, scalingGroupId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var (
+		old       = make([]string, 0, len(instances))
+		inService int64
+		current   int64
+	)
+	for _, instance := range instances {
+		if helper.PString(instance.LifeCycleState) == SCALING_GROUP_INSTANCE_STATE_IN_SERVICE {
+			inService++
+		}
+		if !asInstanceOutdated(instance, configurationId) {
+			if helper.PString(instance.CreationType) == SCALING_GROUP_INSTANCE_CREATION_TYPE_AUTO {
+				current++
+			}
+			continue
+		}
+		if instance.ProtectedFromScaleIn != nil && *instance.ProtectedFromScaleIn {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Instance %s of scaling group %s is not refreshed", *instance.InstanceId, scalingGroupId),
+				Detail:   fmt.Sprintf("It is protected from scale in and keeps launch configuration %s.", helper.PString(instance.LaunchConfigurationId)),
+			})
+			continue
+		}
+		old = append(old, *instance.InstanceId)
+	}
+	if len(old) == 0 {
+		return diags
+	}
+
+	total := len(old)
+	failed := func(err error) diag.Diagnostics {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Refreshing the instances of scaling group %s failed", scalingGroupId),
+			Detail: fmt.Sprintf("%d of %d instances are replaced, the old instances left are %s: %v",
+				total-len(old), total, strings.Join(old, ", "), err),
+		})
+	}
+
+	size := asInstanceRefreshBatch(strategy, int64(*scalingGroup.DesiredCapacity), int64(*scalingGroup.MinSize),
+		int64(*scalingGroup.MaxSize), inService, minHealthyPercentage, batchSize)
+	if size == 0 {
+		if strategy == SCALING_GROUP_INSTANCE_REFRESH_LAUNCH_FIRST {
+			return failed(fmt.Errorf("no instance can be scaled out in a batch, `max_size` must be greater than the desired capacity %d", *scalingGroup.DesiredCapacity))
+		}
+		return failed(fmt.Errorf("no instance can be removed in a batch, `min_size` and `instance_refresh.0.min_healthy_percentage` must leave fewer than the %d instances in service", inService))
+	}
+
+	scaleOut := func(number int) error {
+		return retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
+			if e := asService.ScaleOutInstances(ctx, scalingGroupId, number); e != nil {
+				return retryError(e, AsScalingGroupInProgress)
+			}
+			return nil
+		})
+	}
+	remove := func(batch []string) error {
+		return retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
+			if e := asService.RemoveInstances(ctx, scalingGroupId, batch); e != nil {
+				return retryError(e, AsScalingGroupInProgress)
+			}
+			return nil
+		})
+	}
+	// the removed instances lower the desired capacity, restoring it makes the scaling group launch them again
+	restoreDesiredCapacity := func() error {
+		request := as.NewModifyAutoScalingGroupRequest()
+		request.AutoScalingGroupId = helper.String(scalingGroupId)
+		request.DesiredCapacity = helper.Int64Uint64(*scalingGroup.DesiredCapacity)
+		return retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
+			if e := asService.ModifyAutoScalingGroup(ctx, request); e != nil {
+				return retryError(e, AsScalingGroupInProgress)
+			}
+			return nil
+		})
+	}
+
+	for len(old) > 0 {
+		if time.Now().After(deadline) {
+			return failed(fmt.Errorf("the refresh is timed out"))
+		}
+		n := int(size)
+		if n > len(old) {
+			n = len(old)
+		}
+		batch := old[:n]
+
+		if strategy == SCALING_GROUP_INSTANCE_REFRESH_LAUNCH_FIRST {
+			if err := scaleOut(n); err != nil {
+				return failed(fmt.Errorf("scale out %d instances failed: %v", n, err))
+			}
+		}
+		if err := remove(batch); err != nil {
+			return failed(fmt.Errorf("remove instances %s failed: %v", strings.Join(batch, ", "), err))
+		}
+		if strategy == SCALING_GROUP_INSTANCE_REFRESH_TERMINATE_FIRST {
+			if err := scaleOut(n); err != nil {
+				old = old[n:]
+				if e := restoreDesiredCapacity(); e != nil {
+					return failed(fmt.Errorf("scale out %d instances failed after %s are removed: %v, and restore the desired capacity %d failed: %v",
+						n, strings.Join(batch, ", "), err, *scalingGroup.DesiredCapacity, e))
+				}
+				return failed(fmt.Errorf("scale out %d instances failed after %s are removed, the desired capacity is restored to %d: %v",
+					n, strings.Join(batch, ", "), *scalingGroup.DesiredCapacity, err))
+			}
+		}
+		current += int64(n)
+		old = old[n:]
+		if err := waitAsScalingGroupInstancesInService(ctx, meta, &asService, scalingGroupId, configurationId, current, time.Until(deadline)); err != nil {
+			return failed(err)
+		}
+		log.Printf("[DEBUG]%s scaling group [%s] refreshed %d of %d instances", logId, scalingGroupId, total-len(old), total)
+
+		percentage := (total - len(old)) * 100 / total
+		reached := false
+		for len(checkpoints) > 0 && checkpoints[0] <= percentage {
+			checkpoints = checkpoints[1:]
+			reached = true
+		}
+		if reached && len(old) > 0 && checkpointDelay > 0 {
+			log.Printf("[DEBUG]%s scaling group [%s] pauses %s at checkpoint %d%%", logId, scalingGroupId, checkpointDelay, percentage)
+			select {
+			case <-client.Context().Done():
+				return failed(client.Context().Err())
+			case <-time.After(checkpointDelay):
+			}
+		}
+	}
+	return diags
+}
+
+func describeAsScalingGroupInstances(ctx context.Context, asService *AsService, scalingGroupId string) ([]*as.Instance, error) {
+	return asService.DescribeAsInstancesByFilter(ctx, map[string]interface{}{
+		"filters": []*as.Filter{{
+			Name:   helper.String("auto-scaling-group-id"),
+			Values: []*string{&scalingGroupId},
+		}},
+	})
+}
+
+// waitAsScalingGroupInstancesInService waits until count instances of the launch configuration are in service,
+// it fails when any of them fails to be created
+func waitAsScalingGroupInstancesInService(ctx context.Context, meta interface{}, asService *AsService, scalingGroupId, configurationId string, count int64, timeout time.Duration) error {
+	return retryOperation(meta, timeout, func() *resource.RetryError {
+		instances, err := describeAsScalingGroupInstances(ctx, asService, scalingGroupId)
+		if err != nil {
+			return retryError(err, InternalError)
+		}
+		var running int64
+		for _, instance := range instances {
+			if helper.PString(instance.LaunchConfigurationId) != configurationId {
+				continue
+			}
+			switch helper.PString(instance.LifeCycleState) {
+			case SCALING_GROUP_INSTANCE_STATE_CREATION_FAILED:
+				return resource.NonRetryableError(fmt.Errorf("instance %s failed to be created", helper.PString(instance.InstanceId)))
+			case SCALING_GROUP_INSTANCE_STATE_IN_SERVICE:
+				running++
+			}
+		}
+		if running < count {
+			return resource.RetryableError(fmt.Errorf("%d of %d instances of launch configuration %s are in service", running, count, configurationId))
+		}
+		return nil
+	})
+}
+
 func resourceTencentCloudAsScalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_as_scaling_group.delete")()
 
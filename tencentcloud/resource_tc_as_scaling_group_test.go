@@ -6,9 +6,13 @@ import (
 	"log"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/mockapi"
 )
 
 func init() {
@@ -267,3 +271,326 @@ resource "tencentcloud_as_scaling_group" "scaling_group" {
 }
 `, defaultVpcId, defaultSubnetId)
 }
+
+func TestAsInstanceRefreshBatch(t *testing.T) {
+	cases := []struct {
+		strategy                        string
+		desired, minSize, maxSize       int64
+		inService                       int64
+		minHealthyPercentage, batchSize int
+		expected                        int64
+	}{
+		{SCALING_GROUP_INSTANCE_REFRESH_LAUNCH_FIRST, 4, 1, 10, 4, 90, 2, 2},
+		{SCALING_GROUP_INSTANCE_REFRESH_LAUNCH_FIRST, 4, 1, 5, 4, 90, 2, 1},
+		{SCALING_GROUP_INSTANCE_REFRESH_LAUNCH_FIRST, 4, 1, 4, 4, 0, 2, 0},
+		{SCALING_GROUP_INSTANCE_REFRESH_TERMINATE_FIRST, 4, 1, 4, 4, 50, 3, 2},
+		{SCALING_GROUP_INSTANCE_REFRESH_TERMINATE_FIRST, 4, 3, 4, 4, 0, 3, 1},
+		// 90% of 4 instances is rounded up to 4
+		{SCALING_GROUP_INSTANCE_REFRESH_TERMINATE_FIRST, 4, 1, 4, 4, 90, 3, 0},
+		{SCALING_GROUP_INSTANCE_REFRESH_TERMINATE_FIRST, 4, 1, 4, 2, 50, 3, 1},
+	}
+	for i, c := range cases {
+		size := asInstanceRefreshBatch(c.strategy, c.desired, c.minSize, c.maxSize, c.inService, c.minHealthyPercentage, c.batchSize)
+		if size != c.expected {
+			t.Errorf("case %d: expected %d, got %d", i, c.expected, size)
+		}
+	}
+}
+
+// testAsScalingGroup is the scaling group faked by testAsScalingGroupMockapi
+type testAsScalingGroup struct {
+	configurationId  string
+	desired, maxSize int64
+	created          int
+	operations       []string
+	instances        []*testAsScalingGroupInstance
+	// scaleOutError fails the scale out if it is set
+	scaleOutError error
+}
+
+type testAsScalingGroupInstance struct {
+	id, configurationId, creationType string
+	protected                         bool
+}
+
+// testAsScalingGroupMockapi fakes the scaling group asg-1 and its instances, the scale out creates the instances of
+// the current launch configuration, which are in service immediately
+func testAsScalingGroupMockapi(server *mockapi.Server, group *testAsScalingGroup) {
+	server.Handle("as", "DescribeAutoScalingGroups", func(request *mockapi.Request) (interface{}, error) {
+		return map[string]interface{}{"TotalCount": 1, "AutoScalingGroupSet": []interface{}{map[string]interface{}{
+			"AutoScalingGroupId": "asg-1", "AutoScalingGroupName": "tf-as-group", "LaunchConfigurationId": group.configurationId,
+			"DesiredCapacity": group.desired, "MinSize": 1, "MaxSize": group.maxSize, "VpcId": "vpc-1", "DefaultCooldown": 300,
+			"RetryPolicy": SCALING_GROUP_RETRY_POLICY_IMMEDIATE_RETRY, "InActivityStatus": SCALING_GROUP_NOT_IN_ACTIVITY_STATUS,
+			"InstanceCount": len(group.instances),
+		}}}, nil
+	})
+	server.Handle("as", "ModifyAutoScalingGroup", func(request *mockapi.Request) (interface{}, error) {
+		var params as.ModifyAutoScalingGroupRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		if params.LaunchConfigurationId != nil {
+			group.configurationId = *params.LaunchConfigurationId
+		}
+		// the instances of the raised desired capacity are launched
+		if params.DesiredCapacity != nil && int64(*params.DesiredCapacity) != group.desired {
+			for ; group.desired < int64(*params.DesiredCapacity); group.desired++ {
+				group.created++
+				group.instances = append(group.instances, &testAsScalingGroupInstance{
+					fmt.Sprintf("ins-new-%d", group.created), group.configurationId, SCALING_GROUP_INSTANCE_CREATION_TYPE_AUTO, false,
+				})
+			}
+			group.operations = append(group.operations, fmt.Sprintf("desired:%d", group.desired))
+		}
+		return map[string]interface{}{}, nil
+	})
+	server.Handle("as", "DescribeAutoScalingInstances", func(request *mockapi.Request) (interface{}, error) {
+		set := make([]interface{}, 0, len(group.instances))
+		for _, v := range group.instances {
+			set = append(set, map[string]interface{}{
+				"InstanceId": v.id, "AutoScalingGroupId": "asg-1", "LaunchConfigurationId": v.configurationId,
+				"LifeCycleState": SCALING_GROUP_INSTANCE_STATE_IN_SERVICE, "HealthStatus": "HEALTHY",
+				"ProtectedFromScaleIn": v.protected, "CreationType": v.creationType,
+			})
+		}
+		return map[string]interface{}{"TotalCount": len(set), "AutoScalingInstanceSet": set}, nil
+	})
+	server.Handle("as", "ScaleOutInstances", func(request *mockapi.Request) (interface{}, error) {
+		var params as.ScaleOutInstancesRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		if group.scaleOutError != nil {
+			return nil, group.scaleOutError
+		}
+		number := int64(*params.ScaleOutNumber)
+		if group.desired+number > group.maxSize {
+			return nil, mockapi.NewError("InvalidParameter", "desired capacity %d exceeds the max size", group.desired+number)
+		}
+		for i := int64(0); i < number; i++ {
+			group.created++
+			group.instances = append(group.instances, &testAsScalingGroupInstance{
+				fmt.Sprintf("ins-new-%d", group.created), group.configurationId, SCALING_GROUP_INSTANCE_CREATION_TYPE_AUTO, false,
+			})
+		}
+		group.desired += number
+		group.operations = append(group.operations, fmt.Sprintf("out:%d", number))
+		return map[string]interface{}{"ActivityId": "asa-1"}, nil
+	})
+	server.Handle("as", "RemoveInstances", func(request *mockapi.Request) (interface{}, error) {
+		var params as.RemoveInstancesRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		for _, instanceId := range params.InstanceIds {
+			for i := range group.instances {
+				if group.instances[i].id == *instanceId {
+					if group.instances[i].protected {
+						return nil, mockapi.NewError("InvalidParameter", "instance %s is protected", *instanceId)
+					}
+					group.instances = append(group.instances[:i], group.instances[i+1:]...)
+					break
+				}
+			}
+			group.desired--
+			group.operations = append(group.operations, "rm:"+*instanceId)
+		}
+		return map[string]interface{}{"ActivityId": "asa-1"}, nil
+	})
+	server.Handle("as", "DescribeAutoScalingActivities", func(request *mockapi.Request) (interface{}, error) {
+		return map[string]interface{}{"TotalCount": 1, "ActivitySet": []interface{}{map[string]interface{}{
+			"ActivityId": "asa-1", "StatusCode": SCALING_GROUP_ACTIVITY_STATUS_SUCCESSFUL,
+		}}}, nil
+	})
+}
+
+func TestRefreshAsScalingGroupInstances(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	meta := testMockapiMeta(server)
+	meta.apiV3Conn.Endpoints["as"] = server.URL
+
+	group := &testAsScalingGroup{
+		configurationId: "asc-new",
+		desired:         4,
+		maxSize:         5,
+		instances: []*testAsScalingGroupInstance{
+			{"ins-old-1", "asc-old", SCALING_GROUP_INSTANCE_CREATION_TYPE_AUTO, false},
+			{"ins-old-2", "asc-old", SCALING_GROUP_INSTANCE_CREATION_TYPE_AUTO, false},
+			{"ins-protected", "asc-old", SCALING_GROUP_INSTANCE_CREATION_TYPE_AUTO, true},
+			{"ins-attached", "", "MANUAL_ATTACHING", false},
+		},
+	}
+	testAsScalingGroupMockapi(server, group)
+
+	r := resourceTencentCloudAsScalingGroup()
+	d := r.TestResourceData()
+	d.SetId("asg-1")
+	_ = d.Set("configuration_id", group.configurationId)
+	_ = d.Set("instance_refresh", []interface{}{map[string]interface{}{
+		"strategy":               SCALING_GROUP_INSTANCE_REFRESH_LAUNCH_FIRST,
+		"min_healthy_percentage": 90,
+		"batch_size":             2,
+		"checkpoint_percentages": []interface{}{50},
+		"checkpoint_delay":       1,
+	}})
+
+	start := time.Now()
+	diags := refreshAsScalingGroupInstances(d, meta)
+	if diags.HasError() {
+		t.Fatalf("refresh instances failed: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Summary, "ins-protected") {
+		t.Errorf("expected the warning of the protected instance, got %v", diags)
+	}
+	// batch_size is limited to 1 by max_size
+	if strings.Join(group.operations, ",") != "out:1,rm:ins-old-1,out:1,rm:ins-old-2" {
+		t.Errorf("expected the old instances to be replaced one by one, got %v", group.operations)
+	}
+	if group.desired != 4 || len(group.instances) != 4 {
+		t.Errorf("expected the desired capacity to be kept, got %d", group.desired)
+	}
+	if time.Since(start) < time.Second {
+		t.Errorf("expected the refresh to pause at the checkpoint")
+	}
+
+	// no instance can be removed when all of them must stay in service
+	group.configurationId = "asc-newer"
+	group.operations = nil
+	_ = d.Set("configuration_id", group.configurationId)
+	_ = d.Set("instance_refresh", []interface{}{map[string]interface{}{
+		"strategy":               SCALING_GROUP_INSTANCE_REFRESH_TERMINATE_FIRST,
+		"min_healthy_percentage": 100,
+		"batch_size":             2,
+	}})
+	diags = refreshAsScalingGroupInstances(d, meta)
+	if !diags.HasError() || !strings.Contains(diags[len(diags)-1].Detail, "0 of 2 instances are replaced") {
+		t.Errorf("expected error of the empty batch, got %v", diags)
+	}
+
+	_ = d.Set("instance_refresh", []interface{}{map[string]interface{}{
+		"strategy":               SCALING_GROUP_INSTANCE_REFRESH_TERMINATE_FIRST,
+		"min_healthy_percentage": 50,
+		"batch_size":             2,
+	}})
+	diags = refreshAsScalingGroupInstances(d, meta)
+	if diags.HasError() {
+		t.Fatalf("refresh instances failed: %v", diags)
+	}
+	if strings.Join(group.operations, ",") != "rm:ins-new-1,rm:ins-new-2,out:2" {
+		t.Errorf("expected the old instances to be removed first, got %v", group.operations)
+	}
+}
+
+func TestAsScalingGroupInstanceRefreshResume(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	meta := testMockapiMeta(server)
+	meta.apiV3Conn.Endpoints["as"] = server.URL
+
+	group := &testAsScalingGroup{
+		configurationId: "asc-old",
+		desired:         2,
+		maxSize:         3,
+		instances: []*testAsScalingGroupInstance{
+			{"ins-old-1", "asc-old", SCALING_GROUP_INSTANCE_CREATION_TYPE_AUTO, false},
+			{"ins-old-2", "asc-old", SCALING_GROUP_INSTANCE_CREATION_TYPE_AUTO, false},
+		},
+	}
+	testAsScalingGroupMockapi(server, group)
+
+	r := resourceTencentCloudAsScalingGroup()
+	config := func(configurationId string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"scaling_group_name": "tf-as-group",
+			"configuration_id":   configurationId,
+			"max_size":           3,
+			"min_size":           1,
+			"vpc_id":             "vpc-1",
+			"instance_refresh": []interface{}{map[string]interface{}{
+				"strategy":   SCALING_GROUP_INSTANCE_REFRESH_LAUNCH_FIRST,
+				"batch_size": 1,
+			}},
+		})
+	}
+	state, diags := r.RefreshWithoutUpgrade(context.TODO(), &terraform.InstanceState{ID: "asg-1", Attributes: map[string]string{
+		"id": "asg-1", "instance_refresh.#": "1", "instance_refresh.0.strategy": SCALING_GROUP_INSTANCE_REFRESH_LAUNCH_FIRST,
+		"instance_refresh.0.min_healthy_percentage": "90", "instance_refresh.0.batch_size": "1", "instance_refresh.0.checkpoint_delay": "300",
+	}}, meta)
+	if diags.HasError() {
+		t.Fatalf("read scaling group failed: %v", diags)
+	}
+	if diff, err := r.SimpleDiff(context.TODO(), state, config("asc-old"), meta); err != nil || !diff.Empty() {
+		t.Fatalf("expected no change, got %v, %v", diff, err)
+	}
+
+	// the scale out of the second batch fails after the first batch is replaced
+	server.Handle("as", "RemoveInstances", func(request *mockapi.Request) (interface{}, error) {
+		group.scaleOutError = mockapi.NewError("ResourceInsufficient.AutoScalingGroupAboveMaxSize", "quota exceeded")
+		group.instances = group.instances[1:]
+		group.desired--
+		group.operations = append(group.operations, "rm:ins-old-1")
+		return map[string]interface{}{"ActivityId": "asa-1"}, nil
+	})
+	diff, err := r.SimpleDiff(context.TODO(), state, config("asc-new"), meta)
+	if err != nil {
+		t.Fatalf("plan failed: %v", err)
+	}
+	state, diags = r.Apply(context.TODO(), state, diff, meta)
+	if !diags.HasError() {
+		t.Fatalf("expected the refresh to fail")
+	}
+	if state.Attributes["configuration_id"] != "asc-new" || state.Attributes["outdated_instance_ids.#"] != "1" ||
+		state.Attributes["outdated_instance_ids.0"] != "ins-old-2" {
+		t.Fatalf("expected the instance left to be outdated, got %v", state.Attributes)
+	}
+
+	// the next plan resumes the refresh without any change of the config
+	testAsScalingGroupMockapi(server, group)
+	group.scaleOutError = nil
+	group.operations = nil
+	diff, err = r.SimpleDiff(context.TODO(), state, config("asc-new"), meta)
+	if err != nil || diff.Empty() || !diff.Attributes["outdated_instance_ids.#"].NewComputed {
+		t.Fatalf("expected the refresh to be resumed, got %v, %v", diff, err)
+	}
+	state, diags = r.Apply(context.TODO(), state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("resume the refresh failed: %v", diags)
+	}
+	if strings.Join(group.operations, ",") != "out:1,rm:ins-old-2" || state.Attributes["outdated_instance_ids.#"] != "0" {
+		t.Errorf("expected the instance left to be replaced, got %v, %v", group.operations, state.Attributes)
+	}
+	if diff, err = r.SimpleDiff(context.TODO(), state, config("asc-new"), meta); err != nil || !diff.Empty() {
+		t.Errorf("expected no change after the refresh, got %v, %v", diff, err)
+	}
+
+	// the desired capacity lowered by the removed instances is restored when the scale out fails
+	group.scaleOutError = mockapi.NewError("ResourceInsufficient.AutoScalingGroupAboveMaxSize", "quota exceeded")
+	group.operations = nil
+	terminateFirst := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"scaling_group_name": "tf-as-group",
+		"configuration_id":   "asc-newer",
+		"max_size":           3,
+		"min_size":           1,
+		"vpc_id":             "vpc-1",
+		"instance_refresh": []interface{}{map[string]interface{}{
+			"strategy":               SCALING_GROUP_INSTANCE_REFRESH_TERMINATE_FIRST,
+			"min_healthy_percentage": 50,
+			"batch_size":             1,
+		}},
+	})
+	diff, err = r.SimpleDiff(context.TODO(), state, terminateFirst, meta)
+	if err != nil {
+		t.Fatalf("plan failed: %v", err)
+	}
+	state, diags = r.Apply(context.TODO(), state, diff, meta)
+	if !diags.HasError() || !strings.Contains(diags[len(diags)-1].Detail, "the desired capacity is restored to 2") {
+		t.Fatalf("expected the refresh to fail, got %v", diags)
+	}
+	if strings.Join(group.operations, ",") != "rm:ins-new-1,desired:2" || group.desired != 2 || len(group.instances) != 2 {
+		t.Errorf("expected the desired capacity to be restored, got %v, %d", group.operations, group.desired)
+	}
+	if state.Attributes["outdated_instance_ids.#"] != "1" || state.Attributes["outdated_instance_ids.0"] != "ins-new-2" {
+		t.Errorf("expected the instance left to be outdated, got %v", state.Attributes)
+	}
+}
@@ -0,0 +1,296 @@
+/*
+Provides a resource for an AS (Auto scaling) target tracking policy, which adjusts the desired capacity of the scaling group to keep a metric around the target value.
+
+~> **NOTE:** AS creates and manages the alarms of a target tracking policy, they are exported in `metric_alarms`. The `desired_capacity` of the scaling group is changed by the policy, ignore it with `lifecycle { ignore_changes = [desired_capacity] }`.
+
+Example Usage
+
+Keep the average CPU utilization at 60%
+
+```hcl
+resource "tencentcloud_as_target_tracking_policy" "cpu" {
+  scaling_group_id          = tencentcloud_as_scaling_group.scaling_group.id
+  policy_name               = "tf-as-target-tracking-cpu"
+  predefined_metric_type    = "ASG_AVG_CPU_UTILIZATION"
+  target_value              = 60
+  estimated_instance_warmup = 180
+  disable_scale_in          = false
+}
+```
+
+Import
+
+AS target tracking policy can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_as_target_tracking_policy.cpu asp-xxxxxxxx
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudAsTargetTrackingPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudAsTargetTrackingPolicyCreate,
+		Read:   resourceTencentCloudAsTargetTrackingPolicyRead,
+		Update: resourceTencentCloudAsTargetTrackingPolicyUpdate,
+		Delete: resourceTencentCloudAsTargetTrackingPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scaling_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of a scaling group.",
+			},
+			"policy_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the target tracking policy.",
+			},
+			"predefined_metric_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue(SCALING_POLICY_PREDEFINED_METRIC_TYPE),
+				Description:  "The metric tracked by the policy. Valid values: `ASG_AVG_CPU_UTILIZATION`, `ASG_AVG_LAN_TRAFFIC_OUT`, `ASG_AVG_LAN_TRAFFIC_IN`, `ASG_AVG_WAN_TRAFFIC_OUT` and `ASG_AVG_WAN_TRAFFIC_IN`.",
+			},
+			"target_value": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntegerMin(1),
+				Description:  "The target value of the metric. It is in % and in the range [1, 100) for `ASG_AVG_CPU_UTILIZATION`, and in Mbps for the traffic metrics.",
+			},
+			"estimated_instance_warmup": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validateIntegerInRange(0, 3600),
+				Description:  "The warmup time of the new instances in second, the metrics of an instance are not counted before it is warmed up. Valid value ranges: (0~3600). Default is `300`.",
+			},
+			"disable_scale_in": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the policy only scales out. Default is `false`.",
+			},
+			"metric_alarms": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The alarms created by AS for the policy.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison_operator": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Comparison operator.",
+						},
+						"metric_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of an indicator.",
+						},
+						"threshold": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Alarm threshold.",
+						},
+						"precise_threshold": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Precise alarm threshold.",
+						},
+						"period": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Time period in second.",
+						},
+						"continuous_time": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Retry times.",
+						},
+						"statistic": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Statistic types.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceTencentCloudAsTargetTrackingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_as_target_tracking_policy.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	asService := AsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	request := as.NewCreateScalingPolicyRequest()
+	request.AutoScalingGroupId = helper.String(d.Get("scaling_group_id").(string))
+	request.ScalingPolicyName = helper.String(d.Get("policy_name").(string))
+	request.ScalingPolicyType = helper.String(SCALING_POLICY_TYPE_TARGET_TRACKING)
+	request.PredefinedMetricType = helper.String(d.Get("predefined_metric_type").(string))
+	request.TargetValue = helper.IntUint64(d.Get("target_value").(int))
+	request.EstimatedInstanceWarmup = helper.IntUint64(d.Get("estimated_instance_warmup").(int))
+	request.DisableScaleIn = helper.Bool(d.Get("disable_scale_in").(bool))
+
+	var scalingPolicyId string
+	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		id, e := asService.CreateScalingPolicy(ctx, request)
+		if e != nil {
+			return retryError(e)
+		}
+		scalingPolicyId = id
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId(scalingPolicyId)
+
+	return resourceTencentCloudAsTargetTrackingPolicyRead(d, meta)
+}
+
+func resourceTencentCloudAsTargetTrackingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_as_target_tracking_policy.read")()
+	defer inconsistentCheck(d, meta)()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	scalingPolicyId := d.Id()
+	asService := AsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	var (
+		scalingPolicy *as.ScalingPolicy
+		has           int
+	)
+	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		result, count, e := asService.DescribeScalingPolicyById(ctx, scalingPolicyId)
+		if e != nil {
+			return retryError(e)
+		}
+		scalingPolicy, has = result, count
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if has == 0 {
+		d.SetId("")
+		log.Printf("[WARN]%s resource `AsTargetTrackingPolicy` [%s] not found, please check if it has been deleted.\n", logId, scalingPolicyId)
+		return nil
+	}
+	if scalingPolicy.ScalingPolicyType == nil || *scalingPolicy.ScalingPolicyType != SCALING_POLICY_TYPE_TARGET_TRACKING {
+		return fmt.Errorf("scaling policy %s is not a target tracking policy, manage it with `tencentcloud_as_scaling_policy`", scalingPolicyId)
+	}
+
+	_ = d.Set("scaling_group_id", scalingPolicy.AutoScalingGroupId)
+	_ = d.Set("policy_name", scalingPolicy.ScalingPolicyName)
+	if scalingPolicy.PredefinedMetricType != nil {
+		_ = d.Set("predefined_metric_type", scalingPolicy.PredefinedMetricType)
+	}
+	if scalingPolicy.TargetValue != nil {
+		_ = d.Set("target_value", scalingPolicy.TargetValue)
+	}
+	if scalingPolicy.EstimatedInstanceWarmup != nil {
+		_ = d.Set("estimated_instance_warmup", scalingPolicy.EstimatedInstanceWarmup)
+	}
+	if scalingPolicy.DisableScaleIn != nil {
+		_ = d.Set("disable_scale_in", scalingPolicy.DisableScaleIn)
+	}
+
+	metricAlarms := make([]map[string]interface{}, 0, len(scalingPolicy.MetricAlarms))
+	for _, alarm := range scalingPolicy.MetricAlarms {
+		alarmMap := map[string]interface{}{
+			"comparison_operator": helper.PString(alarm.ComparisonOperator),
+			"metric_name":         helper.PString(alarm.MetricName),
+			"threshold":           helper.PUint64(alarm.Threshold),
+			"period":              helper.PUint64(alarm.Period),
+			"continuous_time":     helper.PUint64(alarm.ContinuousTime),
+			"statistic":           helper.PString(alarm.Statistic),
+		}
+		if alarm.PreciseThreshold != nil {
+			alarmMap["precise_threshold"] = *alarm.PreciseThreshold
+		}
+		metricAlarms = append(metricAlarms, alarmMap)
+	}
+	_ = d.Set("metric_alarms", metricAlarms)
+
+	return nil
+}
+
+func resourceTencentCloudAsTargetTrackingPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_as_target_tracking_policy.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	asService := AsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	request := as.NewModifyScalingPolicyRequest()
+	request.AutoScalingPolicyId = helper.String(d.Id())
+	if d.HasChange("policy_name") {
+		request.ScalingPolicyName = helper.String(d.Get("policy_name").(string))
+	}
+	// the target of the policy is modified as a whole
+	if d.HasChanges("predefined_metric_type", "target_value", "estimated_instance_warmup", "disable_scale_in") {
+		request.PredefinedMetricType = helper.String(d.Get("predefined_metric_type").(string))
+		request.TargetValue = helper.IntUint64(d.Get("target_value").(int))
+		request.EstimatedInstanceWarmup = helper.IntUint64(d.Get("estimated_instance_warmup").(int))
+		request.DisableScaleIn = helper.Bool(d.Get("disable_scale_in").(bool))
+	}
+
+	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if e := asService.ModifyScalingPolicy(ctx, request); e != nil {
+			return retryError(e)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceTencentCloudAsTargetTrackingPolicyRead(d, meta)
+}
+
+func resourceTencentCloudAsTargetTrackingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_as_target_tracking_policy.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	asService := AsService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		if e := asService.DeleteScalingPolicy(ctx, d.Id()); e != nil {
+			return retryError(e)
+		}
+		return nil
+	})
+}
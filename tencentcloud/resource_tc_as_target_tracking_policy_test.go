@@ -0,0 +1,237 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/mockapi"
+)
+
+func TestAccTencentCloudAsTargetTrackingPolicy(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAsTargetTrackingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAsTargetTrackingPolicy(60, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsScalingPolicyExists("tencentcloud_as_target_tracking_policy.cpu"),
+					resource.TestCheckResourceAttrSet("tencentcloud_as_target_tracking_policy.cpu", "scaling_group_id"),
+					resource.TestCheckResourceAttr("tencentcloud_as_target_tracking_policy.cpu", "policy_name", "tf-as-target-tracking-cpu"),
+					resource.TestCheckResourceAttr("tencentcloud_as_target_tracking_policy.cpu", "predefined_metric_type", "ASG_AVG_CPU_UTILIZATION"),
+					resource.TestCheckResourceAttr("tencentcloud_as_target_tracking_policy.cpu", "target_value", "60"),
+					resource.TestCheckResourceAttr("tencentcloud_as_target_tracking_policy.cpu", "estimated_instance_warmup", "180"),
+					resource.TestCheckResourceAttr("tencentcloud_as_target_tracking_policy.cpu", "disable_scale_in", "false"),
+					resource.TestCheckResourceAttrSet("tencentcloud_as_target_tracking_policy.cpu", "metric_alarms.#"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_as_target_tracking_policy.cpu",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// test update case
+			{
+				Config: testAccAsTargetTrackingPolicy(70, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsScalingPolicyExists("tencentcloud_as_target_tracking_policy.cpu"),
+					resource.TestCheckResourceAttr("tencentcloud_as_target_tracking_policy.cpu", "target_value", "70"),
+					resource.TestCheckResourceAttr("tencentcloud_as_target_tracking_policy.cpu", "disable_scale_in", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAsTargetTrackingPolicyDestroy(s *terraform.State) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	asService := AsService{
+		client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_as_target_tracking_policy" {
+			continue
+		}
+
+		_, has, err := asService.DescribeScalingPolicyById(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if has > 0 {
+			return fmt.Errorf("auto scaling target tracking policy still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccAsTargetTrackingPolicy(targetValue int, disableScaleIn bool) string {
+	return defaultAsVariable + fmt.Sprintf(`
+resource "tencentcloud_vpc" "vpc" {
+  name       = "tf-as-vpc"
+  cidr_block = "10.2.0.0/16"
+}
+
+resource "tencentcloud_subnet" "subnet" {
+  vpc_id            = tencentcloud_vpc.vpc.id
+  name              = "tf-as-subnet"
+  cidr_block        = "10.2.11.0/24"
+  availability_zone = var.availability_zone
+}
+
+resource "tencentcloud_as_scaling_config" "launch_configuration" {
+  configuration_name = "tf-as-configuration-target-tracking"
+  image_id           = "img-9qabwvbn"
+  instance_types     = [data.tencentcloud_instance_types.default.instance_types.0.instance_type]
+}
+
+resource "tencentcloud_as_scaling_group" "scaling_group" {
+  scaling_group_name = "tf-as-scaling-group-target-tracking"
+  configuration_id   = tencentcloud_as_scaling_config.launch_configuration.id
+  max_size           = 1
+  min_size           = 0
+  vpc_id             = tencentcloud_vpc.vpc.id
+  subnet_ids         = [tencentcloud_subnet.subnet.id]
+}
+
+resource "tencentcloud_as_target_tracking_policy" "cpu" {
+  scaling_group_id          = tencentcloud_as_scaling_group.scaling_group.id
+  policy_name               = "tf-as-target-tracking-cpu"
+  predefined_metric_type    = "ASG_AVG_CPU_UTILIZATION"
+  target_value              = %d
+  estimated_instance_warmup = 180
+  disable_scale_in          = %t
+}
+`, targetValue, disableScaleIn)
+}
+
+func TestAsTargetTrackingPolicyCRUD(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	meta := testMockapiMeta(server)
+	meta.apiV3Conn.Endpoints["as"] = server.URL
+
+	policies := map[string]map[string]interface{}{
+		"asp-simple": {
+			"AutoScalingGroupId": "asg-1", "AutoScalingPolicyId": "asp-simple", "ScalingPolicyType": SCALING_POLICY_TYPE_SIMPLE,
+			"ScalingPolicyName": "simple", "AdjustmentType": "CHANGE_IN_CAPACITY", "AdjustmentValue": 1, "Cooldown": 300,
+		},
+	}
+	server.Handle("as", "CreateScalingPolicy", func(request *mockapi.Request) (interface{}, error) {
+		var params as.CreateScalingPolicyRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		if *params.ScalingPolicyType != SCALING_POLICY_TYPE_TARGET_TRACKING || params.MetricAlarm != nil {
+			return nil, mockapi.NewError("InvalidParameter", "unexpected policy type %s", *params.ScalingPolicyType)
+		}
+		policies["asp-1"] = map[string]interface{}{
+			"AutoScalingGroupId": *params.AutoScalingGroupId, "AutoScalingPolicyId": "asp-1",
+			"ScalingPolicyType": *params.ScalingPolicyType, "ScalingPolicyName": *params.ScalingPolicyName,
+			"PredefinedMetricType": *params.PredefinedMetricType, "TargetValue": *params.TargetValue,
+			"EstimatedInstanceWarmup": *params.EstimatedInstanceWarmup, "DisableScaleIn": *params.DisableScaleIn,
+			"MetricAlarms": []interface{}{map[string]interface{}{
+				"ComparisonOperator": "GREATER_THAN", "MetricName": "CPU_UTILIZATION", "Threshold": *params.TargetValue,
+				"PreciseThreshold": float64(*params.TargetValue), "Period": 60, "ContinuousTime": 3, "Statistic": "AVERAGE",
+			}},
+		}
+		return map[string]interface{}{"AutoScalingPolicyId": "asp-1"}, nil
+	})
+	server.Handle("as", "DescribeScalingPolicies", func(request *mockapi.Request) (interface{}, error) {
+		var params as.DescribeScalingPoliciesRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		set := make([]interface{}, 0)
+		if policy, ok := policies[*params.AutoScalingPolicyIds[0]]; ok {
+			set = append(set, policy)
+		}
+		return map[string]interface{}{"ScalingPolicySet": set, "TotalCount": len(set)}, nil
+	})
+	server.Handle("as", "ModifyScalingPolicy", func(request *mockapi.Request) (interface{}, error) {
+		var params as.ModifyScalingPolicyRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		if params.TargetValue == nil || params.DisableScaleIn == nil || params.ScalingPolicyName != nil {
+			return nil, mockapi.NewError("InvalidParameter", "the target is not modified as a whole")
+		}
+		policy := policies[*params.AutoScalingPolicyId]
+		policy["TargetValue"] = *params.TargetValue
+		policy["DisableScaleIn"] = *params.DisableScaleIn
+		return map[string]interface{}{}, nil
+	})
+
+	r := resourceTencentCloudAsTargetTrackingPolicy()
+	d := r.TestResourceData()
+	_ = d.Set("scaling_group_id", "asg-1")
+	_ = d.Set("policy_name", "cpu")
+	_ = d.Set("predefined_metric_type", SCALING_POLICY_PREDEFINED_METRIC_TYPE_CPU_UTILIZATION)
+	_ = d.Set("target_value", 60)
+	_ = d.Set("estimated_instance_warmup", 180)
+	if err := resourceTencentCloudAsTargetTrackingPolicyCreate(d, meta); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if d.Id() != "asp-1" || d.Get("metric_alarms.0.threshold").(int) != 60 || d.Get("metric_alarms.0.precise_threshold").(float64) != 60 {
+		t.Errorf("expected the policy and its alarms to be read back, got %s %v", d.Id(), d.Get("metric_alarms"))
+	}
+
+	// import
+	imported := r.TestResourceData()
+	imported.SetId("asp-1")
+	if err := resourceTencentCloudAsTargetTrackingPolicyRead(imported, meta); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+	for _, key := range []string{"scaling_group_id", "policy_name", "predefined_metric_type", "target_value", "estimated_instance_warmup", "disable_scale_in"} {
+		if fmt.Sprint(imported.Get(key)) != fmt.Sprint(d.Get(key)) {
+			t.Errorf("%s: expected %v, got %v", key, d.Get(key), imported.Get(key))
+		}
+	}
+
+	// update
+	state := d.State()
+	raw := map[string]interface{}{
+		"scaling_group_id":          "asg-1",
+		"policy_name":               "cpu",
+		"predefined_metric_type":    SCALING_POLICY_PREDEFINED_METRIC_TYPE_CPU_UTILIZATION,
+		"target_value":              70,
+		"estimated_instance_warmup": 180,
+		"disable_scale_in":          true,
+	}
+	diff, err := testCustomizeDiff(t, r, state, raw, meta)
+	if err != nil {
+		t.Fatalf("diff failed: %v", err)
+	}
+	updated, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("apply the diff failed: %v", err)
+	}
+	if err := resourceTencentCloudAsTargetTrackingPolicyUpdate(updated, meta); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if policies["asp-1"]["TargetValue"] != uint64(70) || policies["asp-1"]["DisableScaleIn"] != true {
+		t.Errorf("expected the target to be modified, got %v", policies["asp-1"])
+	}
+
+	// a simple policy can not be imported
+	simple := r.TestResourceData()
+	simple.SetId("asp-simple")
+	if err := resourceTencentCloudAsTargetTrackingPolicyRead(simple, meta); err == nil || !strings.Contains(err.Error(), "is not a target tracking policy") {
+		t.Errorf("expected error of the simple policy, got %v", err)
+	}
+
+	// the deleted policy is removed from the state
+	delete(policies, "asp-1")
+	if err := resourceTencentCloudAsTargetTrackingPolicyRead(imported, meta); err != nil || imported.Id() != "" {
+		t.Errorf("expected the deleted policy to be removed, got %s, %v", imported.Id(), err)
+	}
+}
@@ -33,6 +33,24 @@ CAM policy can be imported using the name, e.g.
 ```
 $ terraform import tencentcloud_cam_policy_by_name.foo name
 ```
+
+The ID of `tencentcloud_cam_policy` is accepted as well, so a policy managed by `tencentcloud_cam_policy` can be moved
+to this resource with an `import` block and a `removed` block, e.g.
+
+```hcl
+import {
+  to = tencentcloud_cam_policy_by_name.foo
+  id = "26655801"
+}
+
+removed {
+  from = tencentcloud_cam_policy.foo
+
+  lifecycle {
+    destroy = false
+  }
+}
+```
 */
 package tencentcloud
 
@@ -60,7 +78,7 @@ func resourceTencentCloudCamPolicyByName() *schema.Resource {
 		Update: resourceTencentCloudCamPolicyByNameUpdate,
 		Delete: resourceTencentCloudCamPolicyByNameDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceTencentCloudCamPolicyByNameImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -188,6 +206,44 @@ func resourceTencentCloudCamPolicyByNameCreate(d *schema.ResourceData, meta inte
 	return resourceTencentCloudCamPolicyByNameRead(d, meta)
 }
 
+// resourceTencentCloudCamPolicyByNameImport imports the policy by the name, or by the ID of `tencentcloud_cam_policy`
+func resourceTencentCloudCamPolicyByNameImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	policyId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{d}, nil
+	}
+	camService := CamService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	var policies []*cam.StrategyInfo
+	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		var innerErr error
+		policies, innerErr = camService.DescribePoliciesByFilter(ctx, map[string]interface{}{"name": d.Id()})
+		if innerErr != nil {
+			return retryError(innerErr, InternalError)
+		}
+		if len(policies) > 0 {
+			return nil
+		}
+		policies, innerErr = camService.DescribePoliciesByFilter(ctx, map[string]interface{}{"policy_id": policyId})
+		if innerErr != nil {
+			return retryError(innerErr, InternalError)
+		}
+		if len(policies) > 0 {
+			d.SetId(*policies[0].PolicyName)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s import CAM policy failed, reason:%s\n", logId, err.Error())
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudCamPolicyByNameRead(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_cam_policy_by_name.read")()
 	defer inconsistentCheck(d, meta)()
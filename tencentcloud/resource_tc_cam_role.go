@@ -370,8 +370,8 @@ func resourceTencentCloudCamRoleUpdate(d *schema.ResourceData, meta interface{})
 	d.Partial(false)
 
 	//tag
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldInterface, newInterface := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagService := TagService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
@@ -375,8 +375,8 @@ func resourceTencentCloudCamRoleByNameUpdate(d *schema.ResourceData, meta interf
 	d.Partial(false)
 
 	//tag
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldInterface, newInterface := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagService := TagService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
@@ -403,7 +403,7 @@ func resourceTencentCloudCamUserUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	//tag
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		camService := CamService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
 		}
@@ -427,7 +427,7 @@ func resourceTencentCloudCamUserUpdate(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		oldInterface, newInterface := d.GetChange("tags")
+		oldInterface, newInterface := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagService := TagService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
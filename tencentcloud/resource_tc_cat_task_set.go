@@ -407,10 +407,10 @@ func resourceTencentCloudCatTaskSetUpdate(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("cat", "TaskId", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
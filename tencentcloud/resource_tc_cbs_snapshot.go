@@ -231,9 +231,9 @@ func resourceTencentCloudCbsSnapshotUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
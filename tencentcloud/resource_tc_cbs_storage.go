@@ -33,6 +33,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
@@ -45,6 +46,13 @@ func resourceTencentCloudCbsStorage() *schema.Resource {
 		Read:   resourceTencentCloudCbsStorageRead,
 		Update: resourceTencentCloudCbsStorageUpdate,
 		Delete: resourceTencentCloudCbsStorageDelete,
+		CustomizeDiff: customdiff.All(
+			customDiffOnCreate(
+				customDiffRequiredWhen("charge_type", []string{CBS_CHARGE_TYPE_PREPAID}, "prepaid_period"),
+			),
+			customDiffUpdatableWhen("charge_type", []string{CBS_CHARGE_TYPE_PREPAID}, "charge_type"),
+			customDiffForceNewIfDecrease("storage_size"),
+		),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -59,7 +67,7 @@ func resourceTencentCloudCbsStorage() *schema.Resource {
 			"storage_size": {
 				Type:        schema.TypeInt,
 				Required:    true,
-				Description: "Volume of CBS, and unit is GB.",
+				Description: "Volume of CBS, and unit is GB. The disk can only be expanded, decreasing it creates a new disk.",
 			},
 			"period": {
 				Deprecated:   "It has been deprecated from version 1.33.0. Set `prepaid_period` instead.",
@@ -474,9 +482,9 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 		}
 
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
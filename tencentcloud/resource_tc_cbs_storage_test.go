@@ -12,47 +12,37 @@ import (
 
 func init() {
 	// go test -v ./tencentcloud -sweep=ap-guangzhou -sweep-run=tencentcloud_cbs_storage
-	resource.AddTestSweepers("tencentcloud_cbs_storage", &resource.Sweeper{
-		Name: "tencentcloud_cbs_storage",
-		F: func(r string) error {
-			logId := getLogId(contextNil)
-			ctx := context.WithValue(context.TODO(), logIdKey, logId)
-			cli, _ := sharedClientForRegion(r)
-			client := cli.(*TencentCloudClient).apiV3Conn
-
-			service := CbsService{client}
-
+	addTestSweeper(&sweeper{
+		Name:           "tencentcloud_cbs_storage",
+		ServiceType:    "cvm",
+		ResourcePrefix: "volume",
+		Dependencies:   []string{"tencentcloud_instance"},
+		List: func(ctx context.Context, client *TencentCloudClient) ([]*sweepResource, error) {
+			service := CbsService{client.apiV3Conn}
 			disks, err := service.DescribeDisksByFilter(ctx, nil)
-
 			if err != nil {
-				return err
+				return nil, err
 			}
-
-			for i := range disks {
-				disk := disks[i]
-				id := *disk.DiskId
+			resources := make([]*sweepResource, 0, len(disks))
+			for _, disk := range disks {
+				// the disks without names are not created by the tests
 				if disk.DiskName == nil {
 					continue
 				}
-				name := *disk.DiskName
-				created, err := time.Parse("2006-01-02 15:04:05", *disk.CreateTime)
-				if err != nil {
-					created = time.Now()
-				}
-				if isResourcePersist(name, &created) {
-					continue
+				r := &sweepResource{Id: *disk.DiskId, Name: *disk.DiskName}
+				if created, err := time.Parse("2006-01-02 15:04:05", *disk.CreateTime); err == nil {
+					r.CreatedTime = created
 				}
 				if *disk.DiskState == CBS_STORAGE_STATUS_ATTACHED {
-					continue
-				}
-				err = service.DeleteDiskById(ctx, id)
-				if err != nil {
-					continue
+					r.InUse = "attached"
 				}
-
+				resources = append(resources, r)
 			}
-
-			return nil
+			return resources, nil
+		},
+		Delete: func(ctx context.Context, client *TencentCloudClient, id string) error {
+			service := CbsService{client.apiV3Conn}
+			return service.DeleteDiskById(ctx, id)
 		},
 	})
 }
@@ -264,9 +264,9 @@ func resourceTencentCloudCcnUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
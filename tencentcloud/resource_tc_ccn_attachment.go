@@ -55,7 +55,6 @@ import (
 	"strings"
 
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -305,7 +304,6 @@ func resourceTencentCloudCcnAttachmentUpdate(d *schema.ResourceData, meta interf
 		request.Instances = []*vpc.CcnInstance{&ccnInstance}
 
 		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().ModifyCcnAttachedInstancesAttribute(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -153,7 +153,6 @@ import (
 	cdn "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdn/v20180606"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudCdnDomain() *schema.Resource {
@@ -2531,7 +2530,6 @@ func resourceTencentCloudCdnDomainCreate(d *schema.ResourceData, meta interface{
 	}
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := meta.(*TencentCloudClient).apiV3Conn.UseCdnClient().AddCdnDomain(request)
 		if err != nil {
 			if sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -4025,7 +4023,6 @@ func resourceTencentCloudCdnDomainUpdate(d *schema.ResourceData, meta interface{
 
 	if len(updateAttrs) > 0 {
 		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			_, err := meta.(*TencentCloudClient).apiV3Conn.UseCdnClient().UpdateDomainConfig(request)
 			if err != nil {
 				if sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -4056,8 +4053,8 @@ func resourceTencentCloudCdnDomainUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldTags, newTags := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		tagService := TagService{client: client}
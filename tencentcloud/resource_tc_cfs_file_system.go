@@ -81,7 +81,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudCfsFileSystem() *schema.Resource {
@@ -238,7 +237,6 @@ func resourceTencentCloudCfsFileSystemCreate(d *schema.ResourceData, meta interf
 
 	fsId := ""
 	err := resource.Retry(3*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCfsClient().CreateCfsFileSystem(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -409,9 +407,9 @@ func resourceTencentCloudCfsFileSystemUpdate(d *schema.ResourceData, meta interf
 
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
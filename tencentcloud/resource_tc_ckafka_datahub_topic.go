@@ -256,10 +256,10 @@ func resourceTencentCloudCkafkaDatahubTopicUpdate(d *schema.ResourceData, meta i
 
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName("ckafka", "dipTopic", tcClient.Region, d.Id())
 		if err := tagService.ModifyTags(ctx, resourceName, replaceTags, deleteTags); err != nil {
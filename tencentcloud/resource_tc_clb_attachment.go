@@ -39,7 +39,6 @@ import (
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudClbServerAttachment() *schema.Resource {
@@ -157,7 +156,6 @@ func resourceTencentCloudClbServerAttachmentCreate(d *schema.ResourceData, meta
 
 		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 			requestId := ""
-			ratelimit.Check(request.GetAction())
 			result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().RegisterTargets(request)
 			if e != nil {
 				return retryError(e)
@@ -230,7 +228,6 @@ func resourceTencentCloudClbServerAttachmentDelete(d *schema.ResourceData, meta
 
 		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 			requestId := ""
-			ratelimit.Check(request.GetAction())
 			result, e := meta.(*TencentCloudClient).apiV3Conn.UseClbClient().DeregisterTargets(request)
 			if e != nil {
 
@@ -410,6 +407,9 @@ func resourceTencentCloudClbServerAttachmentRead(d *schema.ResourceData, meta in
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
 	items := strings.Split(d.Id(), "#")
+	if len(items) < 3 {
+		return fmt.Errorf("[CHECK][CLB attachment][Read] check: id %s of resource.tencentcloud_clb_attachment is not match loc-xxx#lbl-xxx#lb-xxx", d.Id())
+	}
 	locationId := items[0]
 	listenerId := items[1]
 	clbId := items[2]
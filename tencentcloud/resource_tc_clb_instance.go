@@ -185,6 +185,7 @@ import (
 	"log"
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -200,6 +201,21 @@ func resourceTencentCloudClbInstance() *schema.Resource {
 		Read:   resourceTencentCloudClbInstanceRead,
 		Update: resourceTencentCloudClbInstanceUpdate,
 		Delete: resourceTencentCloudClbInstanceDelete,
+		CustomizeDiff: customdiff.All(
+			customDiffOnCreate(
+				customDiffConflictsWhen("network_type", []string{CLB_NETWORK_TYPE_INTERNAL},
+					"target_region_info_region", "target_region_info_vpc_id", "vip_isp", "address_ip_version",
+					"internet_charge_type", "internet_bandwidth_max_out", "master_zone_id", "zone_id", "slave_zone_id"),
+				customDiffConflictsWhen("network_type", []string{CLB_NETWORK_TYPE_OPEN}, "subnet_id"),
+				customDiffRequiredWhen("internet_charge_type", []string{INTERNET_CHARGE_TYPE_BANDWIDTH_PACKAGE}, "bandwidth_package_id"),
+				customDiffAllowedWhen("internet_charge_type", []string{INTERNET_CHARGE_TYPE_BANDWIDTH_PACKAGE}, "bandwidth_package_id"),
+			),
+			customDiffRequiredTogether("target_region_info_region", "target_region_info_vpc_id"),
+			customDiffRequiredTogether("log_set_id", "log_topic_id"),
+			customDiffUpdatableWhen("network_type", []string{CLB_NETWORK_TYPE_OPEN},
+				"target_region_info_region", "target_region_info_vpc_id", "internet_charge_type", "internet_bandwidth_max_out"),
+			customDiffImmutable("snat_ips", "dynamic_vip"),
+		),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -886,9 +902,9 @@ func resourceTencentCloudClbInstanceUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
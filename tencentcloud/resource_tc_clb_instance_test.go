@@ -3,10 +3,7 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 	"testing"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -23,50 +20,31 @@ const OpenClbNameUpdate = "tf-clb-update-open"
 
 func init() {
 	// go test -v ./tencentcloud -sweep=ap-guangzhou -sweep-run=tencentcloud_clb_instance
-	resource.AddTestSweepers("tencentcloud_clb_instance", &resource.Sweeper{
-		Name: "tencentcloud_clb_instance",
-		F:    testSweepClbInstance,
-	})
-}
-
-func testSweepClbInstance(region string) error {
-	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	cli, err := sharedClientForRegion(region)
-	if err != nil {
-		return err
-	}
-	client := cli.(*TencentCloudClient).apiV3Conn
-	service := ClbService{client: client}
-
-	res, err := service.DescribeLoadBalancerByFilter(ctx, map[string]interface{}{})
-	if err != nil {
-		return err
-	}
-
-	if len(res) > 0 {
-		for _, v := range res {
-			id := *v.LoadBalancerId
-			instanceName := *v.LoadBalancerName
-			createTime := stringTotime(*v.CreateTime)
-
-			now := time.Now()
-			interval := now.Sub(createTime).Minutes()
-			// keep not delete
-			if strings.HasPrefix(instanceName, keepResource) || strings.HasPrefix(instanceName, defaultResource) {
-				continue
-			}
-			// less than 30 minute, not delete
-			if needProtect == 1 && int64(interval) < 30 {
-				continue
+	addTestSweeper(&sweeper{
+		Name:           "tencentcloud_clb_instance",
+		ServiceType:    "clb",
+		ResourcePrefix: "clb",
+		List: func(ctx context.Context, client *TencentCloudClient) ([]*sweepResource, error) {
+			service := ClbService{client: client.apiV3Conn}
+			res, err := service.DescribeLoadBalancerByFilter(ctx, map[string]interface{}{})
+			if err != nil {
+				return nil, err
 			}
-			if err := service.DeleteLoadBalancerById(ctx, id); err != nil {
-				log.Printf("Delete %s error: %s", id, err.Error())
-				continue
+			resources := make([]*sweepResource, 0, len(res))
+			for _, v := range res {
+				resources = append(resources, &sweepResource{
+					Id:          *v.LoadBalancerId,
+					Name:        *v.LoadBalancerName,
+					CreatedTime: stringTotime(*v.CreateTime),
+				})
 			}
-		}
-	}
-	return nil
+			return resources, nil
+		},
+		Delete: func(ctx context.Context, client *TencentCloudClient, id string) error {
+			service := ClbService{client: client.apiV3Conn}
+			return service.DeleteLoadBalancerById(ctx, id)
+		},
+	})
 }
 
 func TestAccTencentCloudClbInstance_basic(t *testing.T) {
@@ -150,7 +150,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -159,17 +158,18 @@ import (
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// clbListenerId is the id of the listeners, the old ones are the listener ids only, which can not be imported
+var clbListenerId = helper.NewIdSchema("clb_id", "listener_id")
+
 func resourceTencentCloudClbListener() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudClbListenerCreate,
 		Read:   resourceTencentCloudClbListenerRead,
 		Update: resourceTencentCloudClbListenerUpdate,
 		Delete: resourceTencentCloudClbListenerDelete,
-		Importer: &schema.ResourceImporter{
-			State: helper.ImportWithDefaultValue(map[string]interface{}{
-				"scheduler": CLB_LISTENER_SCHEDULER_WRR,
-			}),
-		},
+		Importer: clbListenerId.Importer(helper.ImportWithDefaultValue(map[string]interface{}{
+			"scheduler": CLB_LISTENER_SCHEDULER_WRR,
+		})),
 		Schema: map[string]*schema.Schema{
 			"clb_id": {
 				Type:         schema.TypeString,
@@ -478,10 +478,27 @@ func resourceTencentCloudClbListenerCreate(d *schema.ResourceData, meta interfac
 	listenerId := *response.Response.ListenerIds[0]
 
 	//this ID style changes since terraform 1.47.0
-	d.SetId(clbId + FILED_SP + listenerId)
+	d.SetId(clbListenerId.Format(clbId, listenerId))
 	return resourceTencentCloudClbListenerRead(d, meta)
 }
 
+// parseClbListenerId returns the clb id and the listener id of the listener, the old ids are the listener ids only,
+// whose clb id is taken from the state
+func parseClbListenerId(d *schema.ResourceData) (clbId, listenerId string, err error) {
+	items, err := clbListenerId.ParseOrLast(d.Id())
+	if err != nil {
+		return
+	}
+	clbId, listenerId = items[0], items[1]
+	if clbId == "" {
+		clbId = d.Get("clb_id").(string)
+	}
+	if clbId == "" {
+		err = fmt.Errorf("the old style id %s does not support import, expected `%s`", d.Id(), clbListenerId)
+	}
+	return
+}
+
 func resourceTencentCloudClbListenerRead(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_clb_listener.read")()
 	defer inconsistentCheck(d, meta)()
@@ -491,25 +508,13 @@ func resourceTencentCloudClbListenerRead(d *schema.ResourceData, meta interface{
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	resourceId := d.Id()
-	var listenerId = resourceId
-	items := strings.Split(resourceId, FILED_SP)
-	itemLength := len(items)
-	clbId := d.Get("clb_id").(string)
-
-	if itemLength == 1 && clbId == "" {
-		return fmt.Errorf("the old style listenerId %s does not support import, please use clbId#listenerId style", resourceId)
-	} else if itemLength == 2 && clbId == "" {
-		listenerId = items[1]
-		clbId = items[0]
-	} else if itemLength == 2 && clbId != "" {
-		listenerId = items[1]
-	} else if itemLength != 1 && itemLength != 2 {
-		return fmt.Errorf("broken ID %s", resourceId)
+	clbId, listenerId, err := parseClbListenerId(d)
+	if err != nil {
+		return err
 	}
 
 	var instance *clb.Listener
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
 		result, e := clbService.DescribeListenerById(ctx, listenerId, clbId)
 		if e != nil {
 			return retryError(e)
@@ -615,11 +620,10 @@ func resourceTencentCloudClbListenerUpdate(d *schema.ResourceData, meta interfac
 
 	logId := getLogId(contextNil)
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
-	itemLength := len(items)
-	listenerId := items[itemLength-1]
-	clbId := d.Get("clb_id").(string)
+	clbId, listenerId, err := parseClbListenerId(d)
+	if err != nil {
+		return err
+	}
 	changed := false
 	scheduler := ""
 	listenerName := ""
@@ -716,16 +720,15 @@ func resourceTencentCloudClbListenerDelete(d *schema.ResourceData, meta interfac
 
 	logId := getLogId(contextNil)
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
-	itemLength := len(items)
-	listenerId := items[itemLength-1]
-	clbId := d.Get("clb_id").(string)
+	clbId, listenerId, err := parseClbListenerId(d)
+	if err != nil {
+		return err
+	}
 
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 		e := clbService.DeleteListenerById(ctx, clbId, listenerId)
 		if e != nil {
 			return retryError(e)
@@ -49,15 +49,16 @@ import (
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// clbListenerRuleId is the id of the rules, the old ones are the location ids only, which can not be imported
+var clbListenerRuleId = helper.NewIdSchema("clb_id", "listener_id", "rule_id")
+
 func resourceTencentCloudClbListenerRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbListenerRuleCreate,
-		Read:   resourceTencentCloudClbListenerRuleRead,
-		Update: resourceTencentCloudClbListenerRuleUpdate,
-		Delete: resourceTencentCloudClbListenerRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudClbListenerRuleCreate,
+		Read:     resourceTencentCloudClbListenerRuleRead,
+		Update:   resourceTencentCloudClbListenerRuleUpdate,
+		Delete:   resourceTencentCloudClbListenerRuleDelete,
+		Importer: clbListenerRuleId.Importer(nil),
 
 		Schema: map[string]*schema.Schema{
 			"listener_id": {
@@ -338,7 +339,7 @@ func resourceTencentCloudClbListenerRuleCreate(d *schema.ResourceData, meta inte
 	}
 
 	//this ID style changes since terraform 1.47.0
-	d.SetId(clbId + FILED_SP + listenerId + FILED_SP + locationId)
+	d.SetId(clbListenerRuleId.Format(clbId, listenerId, locationId))
 
 	// set http2
 	if v, ok := d.GetOkExists("http2_switch"); ok {
@@ -371,6 +372,24 @@ func resourceTencentCloudClbListenerRuleCreate(d *schema.ResourceData, meta inte
 	return resourceTencentCloudClbListenerRuleRead(d, meta)
 }
 
+// parseClbListenerRuleId returns the clb id, the listener id and the rule id of the rule, the old ids are the rule
+// ids only, whose clb id and listener id are taken from the state
+func parseClbListenerRuleId(d *schema.ResourceData) (clbId, listenerId, ruleId string, err error) {
+	items, err := clbListenerRuleId.ParseOrLast(d.Id())
+	if err != nil {
+		return
+	}
+	clbId, listenerId, ruleId = items[0], items[1], items[2]
+	if clbId == "" {
+		clbId = d.Get("clb_id").(string)
+		listenerId = d.Get("listener_id").(string)
+	}
+	if clbId == "" {
+		err = fmt.Errorf("the old style id %s does not support import, expected `%s`", d.Id(), clbListenerRuleId)
+	}
+	return
+}
+
 func resourceTencentCloudClbListenerRuleRead(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_clb_listener_rule.read")()
 	defer inconsistentCheck(d, meta)()
@@ -378,27 +397,13 @@ func resourceTencentCloudClbListenerRuleRead(d *schema.ResourceData, meta interf
 	logId := getLogId(contextNil)
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-	resourceId := d.Id()
-	var locationId = resourceId
-	items := strings.Split(resourceId, FILED_SP)
-	itemLength := len(items)
-	clbId := d.Get("clb_id").(string)
-	listenerId := d.Get("listener_id").(string)
-	checkErr := ListenerIdCheck(listenerId)
+	checkErr := ListenerIdCheck(d.Get("listener_id").(string))
 	if checkErr != nil {
 		return checkErr
 	}
-	if itemLength == 1 && clbId == "" {
-		return fmt.Errorf("The old style listenerId %s does not support import, please use clbId#listenerId style", resourceId)
-	} else if itemLength == 3 && clbId == "" {
-		locationId = items[2]
-		listenerId = items[1]
-		clbId = items[0]
-	} else if itemLength == 3 && clbId != "" {
-		locationId = items[2]
-		listenerId = items[1]
-	} else if itemLength != 1 && itemLength != 3 {
-		return fmt.Errorf("broken ID %s", resourceId)
+	clbId, listenerId, locationId, err := parseClbListenerRuleId(d)
+	if err != nil {
+		return err
 	}
 
 	clbService := ClbService{
@@ -407,7 +412,7 @@ func resourceTencentCloudClbListenerRuleRead(d *schema.ResourceData, meta interf
 	//this function is not supported by api, need to be travelled
 	filter := map[string]string{"rule_id": locationId, "listener_id": listenerId, "clb_id": clbId}
 	var instances []*clb.RuleOutput
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
 		results, e := clbService.DescribeRulesByFilter(ctx, filter)
 		if e != nil {
 			return retryError(e)
@@ -474,22 +479,20 @@ func resourceTencentCloudClbListenerRuleUpdate(d *schema.ResourceData, meta inte
 
 	logId := getLogId(contextNil)
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
-	itemLength := len(items)
-	locationId := items[itemLength-1]
-	listenerId := d.Get("listener_id").(string)
-	checkErr := ListenerIdCheck(listenerId)
+	checkErr := ListenerIdCheck(d.Get("listener_id").(string))
 	if checkErr != nil {
 		return checkErr
 	}
-	clbId := d.Get("clb_id").(string)
+	clbId, listenerId, locationId, err := parseClbListenerRuleId(d)
+	if err != nil {
+		return err
+	}
 	protocol := ""
 	//get listener protocol
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 		instance, e := clbService.DescribeListenerById(ctx, listenerId, clbId)
 		if e != nil {
 			return retryError(e)
@@ -647,21 +650,19 @@ func resourceTencentCloudClbListenerRuleDelete(d *schema.ResourceData, meta inte
 
 	logId := getLogId(contextNil)
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-	resourceId := d.Id()
-	items := strings.Split(resourceId, FILED_SP)
-	itemLength := len(items)
-	locationId := items[itemLength-1]
-	listenerId := d.Get("listener_id").(string)
-	checkErr := ListenerIdCheck(listenerId)
+	checkErr := ListenerIdCheck(d.Get("listener_id").(string))
 	if checkErr != nil {
 		return checkErr
 	}
-	clbId := d.Get("clb_id").(string)
+	clbId, listenerId, locationId, err := parseClbListenerRuleId(d)
+	if err != nil {
+		return err
+	}
 
 	clbService := ClbService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 		e := clbService.DeleteRuleById(ctx, clbId, listenerId, locationId)
 		if e != nil {
 			return retryError(e)
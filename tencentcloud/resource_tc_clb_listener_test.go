@@ -12,10 +12,8 @@ import (
 )
 
 func TestAccTencentCloudClbListener_basic(t *testing.T) {
-	t.Parallel()
-
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckCassette(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckClbListenerDestroy,
 		Steps: []resource.TestStep{
@@ -41,10 +39,8 @@ func TestAccTencentCloudClbListener_basic(t *testing.T) {
 }
 
 func TestAccTencentCloudClbListener_tcp_basic(t *testing.T) {
-	t.Parallel()
-
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckCassette(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckClbListenerDestroy,
 		Steps: []resource.TestStep{
@@ -94,9 +90,8 @@ func TestAccTencentCloudClbListener_tcp_basic(t *testing.T) {
 }
 
 func TestAccTencentCloudClbListenerTCPWithTCP(t *testing.T) {
-	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckCassette(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckClbListenerDestroy,
 		Steps: []resource.TestStep{
@@ -147,9 +142,8 @@ func TestAccTencentCloudClbListenerTCPWithTCP(t *testing.T) {
 }
 
 func TestAccTencentCloudClbListenerTCPWithHTTP(t *testing.T) {
-	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckCassette(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckClbListenerDestroy,
 		Steps: []resource.TestStep{
@@ -210,9 +204,8 @@ func TestAccTencentCloudClbListenerTCPWithHTTP(t *testing.T) {
 }
 
 func TestAccTencentCloudClbListenerTCPWithCustomer(t *testing.T) {
-	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckCassette(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckClbListenerDestroy,
 		Steps: []resource.TestStep{
@@ -268,10 +261,8 @@ func TestAccTencentCloudClbListenerTCPWithCustomer(t *testing.T) {
 }
 
 func TestAccTencentCloudClbListener_https(t *testing.T) {
-	t.Parallel()
-
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckCassette(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckClbListenerDestroy,
 		Steps: []resource.TestStep{
@@ -309,10 +300,8 @@ func TestAccTencentCloudClbListener_https(t *testing.T) {
 }
 
 func TestAccTencentCloudClbListener_tcpssl(t *testing.T) {
-	t.Parallel()
-
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckCassette(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckClbListenerDestroy,
 		Steps: []resource.TestStep{
@@ -51,10 +51,6 @@ EOT
   }
 }
 ```
-
-```
-terraform import tencentcloud_clb_replace_cert_for_lbs.replace_cert_for_lbs replace_cert_for_lbs_id
-```
 */
 package tencentcloud
 
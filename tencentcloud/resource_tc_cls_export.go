@@ -28,9 +28,7 @@ package tencentcloud
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -38,14 +36,14 @@ import (
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+var clsExportId = helper.NewIdSchema("topic_id", "export_id")
+
 func resourceTencentCloudClsExport() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsExportCreate,
-		Read:   resourceTencentCloudClsExportRead,
-		Delete: resourceTencentCloudClsExportDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudClsExportCreate,
+		Read:     resourceTencentCloudClsExportRead,
+		Delete:   resourceTencentCloudClsExportDelete,
+		Importer: clsExportId.Importer(nil),
 		Schema: map[string]*schema.Schema{
 			"topic_id": {
 				Required:    true,
@@ -156,7 +154,7 @@ func resourceTencentCloudClsExportCreate(d *schema.ResourceData, meta interface{
 	}
 
 	exportId = *response.Response.ExportId
-	d.SetId(topicId + FILED_SP + exportId)
+	d.SetId(clsExportId.Format(topicId, exportId))
 
 	return resourceTencentCloudClsExportRead(d, meta)
 }
@@ -171,9 +169,9 @@ func resourceTencentCloudClsExportRead(d *schema.ResourceData, meta interface{})
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	idSplit := strings.Split(d.Id(), FILED_SP)
-	if len(idSplit) != 2 {
-		return fmt.Errorf("id is broken,%s", d.Id())
+	idSplit, err := clsExportId.Parse(d.Id())
+	if err != nil {
+		return err
 	}
 	topicId := idSplit[0]
 	exportId := idSplit[1]
@@ -228,8 +226,10 @@ func resourceTencentCloudClsExportDelete(d *schema.ResourceData, meta interface{
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
 	service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
-	idSplit := strings.Split(d.Id(), FILED_SP)
-
+	idSplit, err := clsExportId.Parse(d.Id())
+	if err != nil {
+		return err
+	}
 	exportId := idSplit[1]
 
 	if err := service.DeleteClsExportById(ctx, exportId); err != nil {
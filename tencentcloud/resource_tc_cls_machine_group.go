@@ -271,8 +271,24 @@ func resourceTencentCloudClsMachineGroupUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("tags") {
-		tags := d.Get("tags").(map[string]interface{})
+	if d.HasChanges("tags", "tags_all") {
+		// the ignored tags are kept, as the tags of request replace all the tags
+		var remoteTags map[string]string
+		if getIgnoreTags(meta) != nil {
+			ctx := context.WithValue(context.TODO(), logIdKey, logId)
+			service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
+			machineGroup, err := service.DescribeClsMachineGroupById(ctx, d.Id())
+			if err != nil {
+				return err
+			}
+			if machineGroup != nil {
+				remoteTags = make(map[string]string, len(machineGroup.Tags))
+				for _, tag := range machineGroup.Tags {
+					remoteTags[*tag.Key] = *tag.Value
+				}
+			}
+		}
+		tags := mergeIgnoredTags(meta, d.Get("tags").(map[string]interface{}), remoteTags)
 		request.Tags = make([]*cls.Tag, 0, len(tags))
 		for k, v := range tags {
 			key := k
@@ -228,9 +228,25 @@ func resourceTencentCloudClsTopicUpdate(d *schema.ResourceData, meta interface{}
 		request.TopicName = helper.String(d.Get("topic_name").(string))
 	}
 
-	if d.HasChange("tags") {
-
-		tags := d.Get("tags").(map[string]interface{})
+	if d.HasChanges("tags", "tags_all") {
+
+		// the ignored tags are kept, as the tags of request replace all the tags
+		var remoteTags map[string]string
+		if getIgnoreTags(meta) != nil {
+			ctx := context.WithValue(context.TODO(), logIdKey, logId)
+			service := ClsService{client: meta.(*TencentCloudClient).apiV3Conn}
+			topic, err := service.DescribeClsTopicById(ctx, d.Id())
+			if err != nil {
+				return err
+			}
+			if topic != nil {
+				remoteTags = make(map[string]string, len(topic.Tags))
+				for _, tag := range topic.Tags {
+					remoteTags[*tag.Key] = *tag.Value
+				}
+			}
+		}
+		tags := mergeIgnoredTags(meta, d.Get("tags").(map[string]interface{}), remoteTags)
 		request.Tags = make([]*cls.Tag, 0, len(tags))
 		for k, v := range tags {
 			key := k
@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	cls "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cls/v20201016"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/mockapi"
 )
 
 func init() {
@@ -84,6 +88,60 @@ func TestAccTencentCloudClsTopic_basic(t *testing.T) {
 	})
 }
 
+// ModifyTopic replaces all the tags of the topic, the tags added outside terraform must be kept if ignored
+func TestClsTopicKeepsIgnoredTags(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	topic := &cls.TopicInfo{TopicId: helper.String("topic-1"), LogsetId: helper.String("logset-1")}
+	server.Handle("cls", "CreateTopic", func(request *mockapi.Request) (interface{}, error) {
+		var params cls.CreateTopicRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		topic.TopicName, topic.Tags = params.TopicName, params.Tags
+		return map[string]interface{}{"TopicId": topic.TopicId}, nil
+	})
+	server.Handle("cls", "DescribeTopics", func(request *mockapi.Request) (interface{}, error) {
+		return map[string]interface{}{"Topics": []*cls.TopicInfo{topic}, "TotalCount": 1}, nil
+	})
+	server.Handle("cls", "ModifyTopic", func(request *mockapi.Request) (interface{}, error) {
+		var params cls.ModifyTopicRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		if params.Tags != nil {
+			topic.Tags = params.Tags
+		}
+		return map[string]interface{}{}, nil
+	})
+
+	meta := testMockapiMeta(server)
+	meta.apiV3Conn.Endpoints["cls"] = server.URL
+	meta.ignoreTags = &ignoreTagsConfig{keyPrefixes: []string{"tke-"}}
+	config := map[string]interface{}{
+		"logset_id":  "logset-1",
+		"topic_name": "tf-topic-test",
+		"tags":       map[string]interface{}{"app": "web"},
+	}
+	state := testMockapiApply(t, meta, "tencentcloud_cls_topic", nil, config)
+
+	// the tag added outside terraform
+	topic.Tags = append(topic.Tags, &cls.Tag{Key: helper.String("tke-cluster-id"), Value: helper.String("cls-xxx")})
+
+	config["tags"] = map[string]interface{}{"app": "api"}
+	state = testMockapiApply(t, meta, "tencentcloud_cls_topic", state, config)
+	tags := make(map[string]string)
+	for _, tag := range topic.Tags {
+		tags[*tag.Key] = *tag.Value
+	}
+	if expected := map[string]string{"app": "api", "tke-cluster-id": "cls-xxx"}; !reflect.DeepEqual(tags, expected) {
+		t.Errorf("expected tags %v, got %v", expected, tags)
+	}
+	if _, ok := state.Attributes["tags.tke-cluster-id"]; ok {
+		t.Errorf("unexpected ignored tag in state %v", state.Attributes)
+	}
+}
+
 func testAccCheckClsTopicExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		logId := getLogId(contextNil)
@@ -1157,11 +1157,23 @@ func resourceTencentCloudCosBucketUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		bucket := d.Id()
-
 		cosService := CosService{client: meta.(*TencentCloudClient).apiV3Conn}
-		if err := cosService.SetBucketTags(ctx, bucket, helper.GetTags(d, "tags")); err != nil {
+
+		// the ignored tags are kept, as PutBucketTagging replaces all the tags
+		var remoteTags map[string]string
+		if getIgnoreTags(meta) != nil {
+			var err error
+			if remoteTags, err = cosService.GetBucketTags(ctx, bucket); err != nil {
+				return err
+			}
+		}
+		tags := make(map[string]string)
+		for key, val := range mergeIgnoredTags(meta, d.Get("tags").(map[string]interface{}), remoteTags) {
+			tags[key] = val.(string)
+		}
+		if err := cosService.SetBucketTags(ctx, bucket, tags); err != nil {
 			return err
 		}
 
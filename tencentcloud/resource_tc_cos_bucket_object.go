@@ -310,8 +310,16 @@ func resourceTencentCloudCosBucketObjectUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("tags") {
-		v := d.Get("tags").(map[string]interface{})
+	if d.HasChanges("tags", "tags_all") {
+		// the ignored tags are kept, as PutObjectTagging replaces all the tags
+		var remoteTags map[string]string
+		if getIgnoreTags(meta) != nil {
+			var err error
+			if remoteTags, err = cosService.GetObjectTags(ctx, bucket, key); err != nil {
+				return err
+			}
+		}
+		v := mergeIgnoredTags(meta, d.Get("tags").(map[string]interface{}), remoteTags)
 		tags := make(map[string]string)
 		for key, val := range v {
 			tags[key] = val.(string)
@@ -65,10 +65,8 @@ func testSweepCosBuckets(region string) error {
 }
 
 func TestAccTencentCloudCosBucketResource_basic(t *testing.T) {
-	t.Parallel()
-
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckCassette(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckCosBucketDestroy,
 		Steps: []resource.TestStep{
@@ -102,10 +100,8 @@ func TestAccTencentCloudCosBucketResource_basic(t *testing.T) {
 }
 
 func TestAccTencentCloudCosBucketResource_ACL(t *testing.T) {
-	t.Parallel()
-
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckCassette(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckCosBucketDestroy,
 		Steps: []resource.TestStep{
@@ -137,10 +133,8 @@ func TestAccTencentCloudCosBucketResource_ACL(t *testing.T) {
 }
 
 func TestAccTencentCloudCosBucketResource_tags(t *testing.T) {
-	t.Parallel()
-
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckCassette(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckCosBucketDestroy,
 		Steps: []resource.TestStep{
@@ -171,10 +165,8 @@ func TestAccTencentCloudCosBucketResource_tags(t *testing.T) {
 }
 
 func TestAccTencentCloudCosBucketResource_cors(t *testing.T) {
-	t.Parallel()
-
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckCassette(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckCosBucketDestroy,
 		Steps: []resource.TestStep{
@@ -220,10 +212,8 @@ func TestAccTencentCloudCosBucketResource_cors(t *testing.T) {
 }
 
 func TestAccTencentCloudCosBucketResource_lifecycle(t *testing.T) {
-	t.Parallel()
-
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckCassette(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckCosBucketDestroy,
 		Steps: []resource.TestStep{
@@ -290,10 +280,8 @@ func TestAccTencentCloudCosBucketResource_lifecycle(t *testing.T) {
 }
 
 func TestAccTencentCloudCosBucketResource_website(t *testing.T) {
-	t.Parallel()
-
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckCassette(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckCosBucketDestroy,
 		Steps: []resource.TestStep{
@@ -329,10 +317,8 @@ func TestAccTencentCloudCosBucketResource_website(t *testing.T) {
 }
 
 func TestAccTencentCloudCosBucketResource_MAZ(t *testing.T) {
-	t.Parallel()
-
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckCassette(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckCosBucketDestroy,
 		Steps: []resource.TestStep{
@@ -354,10 +340,8 @@ func TestAccTencentCloudCosBucketResource_MAZ(t *testing.T) {
 }
 
 func TestAccTencentCloudCosBucketResource_originPull(t *testing.T) {
-	t.Parallel()
-
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckCassette(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckCosBucketDestroy,
 		Steps: []resource.TestStep{
@@ -448,10 +432,8 @@ func TestAccTencentCloudCosBucket_originDomain(t *testing.T) {
 */
 
 func TestAccTencentCloudCosBucketResource_replication(t *testing.T) {
-	t.Parallel()
-
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
+		PreCheck:     func() { testAccPreCheckCassette(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckCosBucketDestroy,
 		Steps: []resource.TestStep{
@@ -5,7 +5,7 @@ Example Usage
 
 ```hcl
 resource "tencentcloud_cvm_renew_instance" "renew_instance" {
-  instance_ids =
+  instance_id = "ins-xxxxxxxx"
   instance_charge_prepaid {
 	period = 1
 	renew_flag = "NOTIFY_AND_AUTO_RENEW"
@@ -23,7 +23,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudCynosdbAuditLogFile() *schema.Resource {
@@ -324,7 +323,6 @@ func resourceTencentCloudCynosdbAuditLogFileCreate(d *schema.ResourceData, meta
 		request := cynosdb.NewDescribeAuditLogFilesRequest()
 		request.InstanceId = helper.String(instanceId)
 		request.FileName = response.Response.FileName
-		ratelimit.Check(request.GetAction())
 		result, e := meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().DescribeAuditLogFiles(request)
 		if e != nil {
 			return retryError(e)
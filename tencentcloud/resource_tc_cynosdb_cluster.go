@@ -71,7 +71,6 @@ import (
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cynosdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cynosdb/v20190107"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudCynosdbCluster() *schema.Resource {
@@ -80,6 +79,11 @@ func resourceTencentCloudCynosdbCluster() *schema.Resource {
 		Read:   resourceTencentCloudCynosdbClusterRead,
 		Update: resourceTencentCloudCynosdbClusterUpdate,
 		Delete: resourceTencentCloudCynosdbClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -193,8 +197,7 @@ func resourceTencentCloudCynosdbClusterCreate(d *schema.ResourceData, meta inter
 
 	var response *cynosdb.CreateClustersResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	err = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 		response, err = meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().CreateClusters(request)
 		if err != nil {
 			if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -218,8 +221,7 @@ func resourceTencentCloudCynosdbClusterCreate(d *schema.ResourceData, meta inter
 	dealReq := cynosdb.NewDescribeResourcesByDealNameRequest()
 	dealRes := cynosdb.NewDescribeResourcesByDealNameResponse()
 	dealReq.DealName = dealName
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	err = retryOperation(meta, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		dealRes, err = meta.(*TencentCloudClient).apiV3Conn.UseCynosdbClient().DescribeResourcesByDealName(dealReq)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -572,7 +574,7 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 			return err
 		}
 
-		errUpdate := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		errUpdate := retryOperation(meta, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			_, infos, has, e := cynosdbService.DescribeInstanceById(ctx, instanceId)
 			if e != nil {
 				return resource.NonRetryableError(e)
@@ -649,7 +651,7 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 		var (
 			asyncRequestId string
 		)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			aReqId, modifyErr := cynosdbService.ModifyClusterParam(ctx, request)
 			if modifyErr != nil {
 				err := modifyErr.(*sdkErrors.TencentCloudSDKError)
@@ -667,7 +669,7 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 
 		mysqlService := MysqlService{client: client}
 
-		_ = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		_ = retryOperation(meta, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
 			if err != nil {
 				return resource.NonRetryableError(err)
@@ -689,8 +691,8 @@ func resourceTencentCloudCynosdbClusterUpdate(d *schema.ResourceData, meta inter
 	}
 
 	// update tags
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldTags, newTags := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("cynosdb", "cluster", region, clusterId)
@@ -800,7 +802,7 @@ func resourceTencentCloudCynosdbClusterDelete(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	conf := BuildStateChangeConf([]string{}, []string{"isolated"}, 2*readRetryTimeout, time.Second, cynosdbService.CynosdbInstanceIsolateStateRefreshFunc(d.Id(), []string{}))
+	conf := BuildStateChangeConf([]string{}, []string{"isolated"}, d.Timeout(schema.TimeoutDelete), time.Second, cynosdbService.CynosdbInstanceIsolateStateRefreshFunc(d.Id(), []string{}))
 
 	if _, e := conf.WaitForState(); e != nil {
 		return e
@@ -811,7 +813,7 @@ func resourceTencentCloudCynosdbClusterDelete(d *schema.ResourceData, meta inter
 			return err
 		}
 
-		conf := BuildStateChangeConf([]string{}, []string{"offlined"}, 2*readRetryTimeout, time.Second, cynosdbService.CynosdbInstanceOfflineStateRefreshFunc(d.Id(), []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"offlined"}, d.Timeout(schema.TimeoutDelete), time.Second, cynosdbService.CynosdbInstanceOfflineStateRefreshFunc(d.Id(), []string{}))
 
 		if _, e := conf.WaitForState(); e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
@@ -77,6 +77,33 @@ resource "tencentcloud_dayu_ddos_policy_v2" "ddos_v2" {
 	}
 }
 
+```
+
+Import
+
+Dayu DDoS policy v2 can be imported using the id `<resource_id>#<business>`, e.g.
+
+```
+$ terraform import tencentcloud_dayu_ddos_policy_v2.ddos_v2 bgpip-000004xf#bgpip
+```
+
+The id `<resource_type>#<policy_id>` of `tencentcloud_dayu_ddos_policy` is accepted as well when the policy is bound to
+exactly one resource instance, so the policy of the instance can be moved to this resource with an `import` block and a
+`removed` block, e.g.
+
+```hcl
+import {
+  to = tencentcloud_dayu_ddos_policy_v2.ddos_v2
+  id = "bgpip#policy-0000001"
+}
+
+removed {
+  from = tencentcloud_dayu_ddos_policy.test_policy
+
+  lifecycle {
+    destroy = false
+  }
+}
 ```
 */
 package tencentcloud
@@ -87,6 +114,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	antiddos "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/antiddos/v20200309"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -98,6 +126,9 @@ func resourceTencentCloudDayuDdosPolicyV2() *schema.Resource {
 		Read:   resourceTencentCloudDayuDdosPolicyV2Read,
 		Update: resourceTencentCloudDayuDdosPolicyV2Update,
 		Delete: resourceTencentCloudDayuDdosPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudDayuDdosPolicyV2Import,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -704,6 +735,47 @@ func resourceTencentCloudDayuDdosPolicyV2Create(d *schema.ResourceData, meta int
 	return resourceTencentCloudDayuDdosPolicyV2Read(d, meta)
 }
 
+// resourceTencentCloudDayuDdosPolicyV2Import imports the policy by the id of this resource, or by the id of
+// `tencentcloud_dayu_ddos_policy` whose policy is bound to one resource instance
+func resourceTencentCloudDayuDdosPolicyV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	items := strings.Split(d.Id(), FILED_SP)
+	if len(items) != 2 || items[0] == "" || items[1] == "" {
+		return nil, fmt.Errorf("invalid id `%s` of DDoS policy, expected `<resource_id>#<business>` or `<resource_type>#<policy_id>`", d.Id())
+	}
+	if IsContains(DAYU_RESOURCE_TYPE, items[0]) {
+		resourceType, policyId := items[0], items[1]
+		service := DayuService{client: meta.(*TencentCloudClient).apiV3Conn}
+		var boundResources []*string
+		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			policy, has, err := service.DescribeDdosPolicy(ctx, resourceType, policyId)
+			if err != nil {
+				return retryError(err)
+			}
+			if !has {
+				return resource.NonRetryableError(fmt.Errorf("DDoS policy %s of %s is not found", policyId, resourceType))
+			}
+			boundResources = policy.BoundResources
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(boundResources) != 1 || boundResources[0] == nil {
+			return nil, fmt.Errorf("DDoS policy %s is bound to %d resource instances, import each instance with `<resource_id>#<business>`",
+				policyId, len(boundResources))
+		}
+		items = []string{*boundResources[0], resourceType}
+		d.SetId(strings.Join(items, FILED_SP))
+	}
+
+	_ = d.Set("resource_id", items[0])
+	_ = d.Set("business", items[1])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudDayuDdosPolicyV2Read(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_dayu_ddos_policy_v2.read")()
 	logId := getLogId(contextNil)
@@ -8,6 +8,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/mockapi"
 )
 
 func TestAccTencentCloudDayuDdosPolicyV2Resource(t *testing.T) {
@@ -33,6 +36,40 @@ func TestAccTencentCloudDayuDdosPolicyV2Resource(t *testing.T) {
 	})
 }
 
+func TestDayuDdosPolicyV2Import(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	server.Handle("dayu", "DescribeDDoSPolicy", func(request *mockapi.Request) (interface{}, error) {
+		return map[string]interface{}{"DDosPolicyList": []*dayu.DDosPolicy{
+			{PolicyId: helper.String("policy-single"), BoundResources: helper.Strings([]string{"bgpip-000004xf"})},
+			{PolicyId: helper.String("policy-shared"), BoundResources: helper.Strings([]string{"bgpip-000004xf", "bgpip-000004xg"})},
+		}}, nil
+	})
+	meta := testMockapiMeta(server)
+	meta.apiV3Conn.Endpoints["dayu"] = server.URL
+
+	r := Provider().ResourcesMap["tencentcloud_dayu_ddos_policy_v2"]
+	for _, id := range []string{"bgpip-000004xf#bgpip", "bgpip#policy-single"} {
+		d := r.TestResourceData()
+		d.SetId(id)
+		if _, err := r.Importer.StateContext(context.TODO(), d, meta); err != nil {
+			t.Fatalf("unexpected error of %s: %v", id, err)
+		}
+		if d.Id() != "bgpip-000004xf#bgpip" || d.Get("resource_id") != "bgpip-000004xf" || d.Get("business") != "bgpip" {
+			t.Errorf("unexpected import of %s: %s %v", id, d.Id(), d.State().Attributes)
+		}
+	}
+
+	// the policy bound to several instances can not be moved to one of them
+	for _, id := range []string{"bgpip#policy-shared", "bgpip#policy-missing", "bgpip-000004xf"} {
+		d := r.TestResourceData()
+		d.SetId(id)
+		if _, err := r.Importer.StateContext(context.TODO(), d, meta); err == nil {
+			t.Errorf("expected error of %s", id)
+		}
+	}
+}
+
 func testAccCheckDayuDdosPolicyV2Destroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tencentcloud_dayu_ddos_policy_v2" {
@@ -32,6 +32,32 @@ resource "tencentcloud_dayu_l4_rule" "test_rule" {
   }
 }
 ```
+
+Import
+
+Dayu layer 4 rule v2 can be imported using the id `<business>#<resource_id>#<vpn>#<virtual_port>`, e.g.
+
+```
+$ terraform import tencentcloud_dayu_l4_rule_v2.test_rule bgpip#bgpip-00000294#162.62.163.50#2020
+```
+
+The id `<resource_type>#<resource_id>#<rule_id>` of `tencentcloud_dayu_l4_rule` is accepted as well, so a rule managed by
+`tencentcloud_dayu_l4_rule` can be moved to this resource with an `import` block and a `removed` block, e.g.
+
+```hcl
+import {
+  to = tencentcloud_dayu_l4_rule_v2.test_rule
+  id = "bgpip#bgpip-00000294#rule-00000001"
+}
+
+removed {
+  from = tencentcloud_dayu_l4_rule.test_rule
+
+  lifecycle {
+    destroy = false
+  }
+}
+```
 */
 package tencentcloud
 
@@ -52,6 +78,9 @@ func resourceTencentCloudDayuL4RuleV2() *schema.Resource {
 		Create: resourceTencentCloudDayuL4RuleCreateV2,
 		Read:   resourceTencentCloudDayuL4RuleReadV2,
 		Delete: resourceTencentCloudDayuL4RuleDeleteV2,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudDayuL4RuleImportV2,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"business": {
@@ -186,6 +215,57 @@ func resourceTencentCloudDayuL4RuleCreateV2(d *schema.ResourceData, meta interfa
 	return resourceTencentCloudDayuL4RuleReadV2(d, meta)
 }
 
+// resourceTencentCloudDayuL4RuleImportV2 imports the rule by the id of this resource, or by the id of `tencentcloud_dayu_l4_rule`
+func resourceTencentCloudDayuL4RuleImportV2(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	items := strings.Split(d.Id(), FILED_SP)
+	for _, item := range items {
+		if item == "" {
+			items = nil
+			break
+		}
+	}
+	switch len(items) {
+	case 4:
+	case 3:
+		service := DayuService{
+			client: meta.(*TencentCloudClient).apiV3Conn,
+		}
+		var rule *dayu.NewL4RuleEntry
+		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			result, err := service.DescribeNewL4RuleById(ctx, items[0], items[1], items[2])
+			if err != nil {
+				return retryError(err)
+			}
+			rule = result
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil || rule.Ip == nil || rule.VirtualPort == nil {
+			return nil, fmt.Errorf("dayu L4 rule %s of %s is not found", items[2], items[1])
+		}
+		items = []string{items[0], items[1], *rule.Ip, strconv.FormatUint(*rule.VirtualPort, 10)}
+		d.SetId(strings.Join(items, FILED_SP))
+	default:
+		return nil, fmt.Errorf("invalid id `%s` of dayu L4 rule, expected `<business>#<resource_id>#<vpn>#<virtual_port>` "+
+			"or `<resource_type>#<resource_id>#<rule_id>`", d.Id())
+	}
+	virtualPort, err := strconv.Atoi(items[3])
+	if err != nil {
+		return nil, fmt.Errorf("invalid virtual port `%s` of dayu L4 rule: %v", items[3], err)
+	}
+
+	_ = d.Set("business", items[0])
+	_ = d.Set("resource_id", items[1])
+	_ = d.Set("vpn", items[2])
+	_ = d.Set("virtual_port", virtualPort)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudDayuL4RuleReadV2(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_dayu_l4_rule.read")()
 	logId := getLogId(contextNil)
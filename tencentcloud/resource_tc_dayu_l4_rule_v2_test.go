@@ -10,6 +10,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/mockapi"
 )
 
 var testDayuL4RuleV2ResourceNameTCP = "tencentcloud_dayu_l4_rule_v2"
@@ -39,6 +42,68 @@ func TestAccTencentCloudDayuL4RuleV2Resource_TCP(t *testing.T) {
 	})
 }
 
+func TestDayuL4RuleV2Import(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	// the rule of tencentcloud_dayu_l4_rule is on the second page
+	rules := make([]*dayu.NewL4RuleEntry, 0, 21)
+	for i := 0; i < 20; i++ {
+		rules = append(rules, &dayu.NewL4RuleEntry{
+			Id:          helper.String("bgpip-00000294"),
+			Ip:          helper.String("162.62.163.50"),
+			VirtualPort: helper.IntUint64(1000 + i),
+			RuleId:      helper.String(fmt.Sprintf("rule-%d", i)),
+		})
+	}
+	rules = append(rules, &dayu.NewL4RuleEntry{
+		Id:          helper.String("bgpip-00000294"),
+		Ip:          helper.String("162.62.163.51"),
+		VirtualPort: helper.IntUint64(2020),
+		RuleId:      helper.String("rule-legacy"),
+	})
+	server.Handle("dayu", "DescribeNewL4Rules", func(request *mockapi.Request) (interface{}, error) {
+		params := dayu.NewDescribeNewL4RulesRequest()
+		if err := request.Bind(params); err != nil {
+			return nil, err
+		}
+		offset, limit := int(*params.Offset), int(*params.Limit)
+		if offset > len(rules) {
+			offset = len(rules)
+		}
+		if offset+limit > len(rules) {
+			limit = len(rules) - offset
+		}
+		return map[string]interface{}{"Rules": rules[offset : offset+limit], "Total": len(rules)}, nil
+	})
+	meta := testMockapiMeta(server)
+	meta.apiV3Conn.Endpoints["dayu"] = server.URL
+
+	r := Provider().ResourcesMap["tencentcloud_dayu_l4_rule_v2"]
+	for id, expected := range map[string]string{
+		"bgpip#bgpip-00000294#162.62.163.50#2020": "bgpip#bgpip-00000294#162.62.163.50#2020",
+		"bgpip#bgpip-00000294#rule-legacy":        "bgpip#bgpip-00000294#162.62.163.51#2020",
+	} {
+		d := r.TestResourceData()
+		d.SetId(id)
+		if _, err := r.Importer.StateContext(context.TODO(), d, meta); err != nil {
+			t.Fatalf("unexpected error of %s: %v", id, err)
+		}
+		items := strings.Split(expected, FILED_SP)
+		if d.Id() != expected || d.Get("business") != items[0] || d.Get("resource_id") != items[1] ||
+			d.Get("vpn") != items[2] || strconv.Itoa(d.Get("virtual_port").(int)) != items[3] {
+			t.Errorf("unexpected import of %s: %s %v", id, d.Id(), d.State().Attributes)
+		}
+	}
+
+	for _, id := range []string{"bgpip#bgpip-00000294#rule-missing", "bgpip#bgpip-00000294", "bgpip##162.62.163.50#2020"} {
+		d := r.TestResourceData()
+		d.SetId(id)
+		if _, err := r.Importer.StateContext(context.TODO(), d, meta); err == nil {
+			t.Errorf("expected error of %s", id)
+		}
+	}
+}
+
 func testAccCheckDayuL4RuleV2Destroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != testDayuL4RuleV2ResourceNameTCP {
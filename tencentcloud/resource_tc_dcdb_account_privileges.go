@@ -5,10 +5,10 @@ Example Usage
 
 ```hcl
 resource "tencentcloud_dcdb_account_privileges" "account_privileges" {
-  instance_id = "%s"
+  instance_id = "dcdbt-ow7t8lmc"
   account {
 		user = "tf_test"
-		host = "%s"
+		host = "127.0.0.1"
   }
   global_privileges = ["SHOW DATABASES","SHOW VIEW"]
   database_privileges {
@@ -22,6 +22,7 @@ resource "tencentcloud_dcdb_account_privileges" "account_privileges" {
 		privileges = ["SELECT","INSERT","UPDATE","DELETE","CREATE"]
 
   }
+}
 ```
 
 Import
@@ -41,7 +41,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudEipAssociation() *schema.Resource {
@@ -178,7 +177,6 @@ func resourceTencentCloudEipAssociationCreate(d *schema.ResourceData, meta inter
 	}
 	if needRequest {
 		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err := meta.(*TencentCloudClient).apiV3Conn.UseVpcClient().AssociateAddress(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -5,7 +5,7 @@ Example Usage
 
 ```hcl
 resource "tencentcloud_eip_normal_address_return" "normal_address_return" {
-  address_ips =
+  address_ips = ["127.0.0.1"]
 }
 ```
 */
@@ -156,13 +156,13 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	es "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/es/v20180416"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudElasticsearchInstance() *schema.Resource {
@@ -171,6 +171,10 @@ func resourceTencentCloudElasticsearchInstance() *schema.Resource {
 		Read:   resourceTencentCloudElasticsearchInstanceRead,
 		Update: resourceTencentCloudElasticsearchInstanceUpdate,
 		Delete: resourceTencentCloudElasticsearchInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
 			State: helper.ImportWithDefaultValue(map[string]interface{}{
 				"basic_security_type": ES_BASIC_SECURITY_TYPE_OFF,
@@ -508,8 +512,7 @@ func resourceTencentCloudElasticsearchInstanceCreate(d *schema.ResourceData, met
 	}
 
 	instanceId := ""
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 		response, err := meta.(*TencentCloudClient).apiV3Conn.UseEsClient().CreateInstance(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -525,7 +528,7 @@ func resourceTencentCloudElasticsearchInstanceCreate(d *schema.ResourceData, met
 	d.SetId(instanceId)
 
 	instanceEmptyRetries := 5
-	err = resource.Retry(15*readRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, errRet := elasticsearchService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -563,7 +566,7 @@ func resourceTencentCloudElasticsearchInstanceCreate(d *schema.ResourceData, met
 		}
 	}
 
-	err = resource.Retry(writeRetryTimeout*2, func() *resource.RetryError {
+	err = retryOperation(meta, writeRetryTimeout*2, func() *resource.RetryError {
 		errRet := elasticsearchService.UpdateInstance(ctx, instanceId, "", "", 0, nil, nil, &esAcl)
 		if errRet != nil {
 			return retryError(errRet)
@@ -601,7 +604,7 @@ func resourceTencentCloudElasticsearchInstanceRead(d *schema.ResourceData, meta
 
 	var instance *es.InstanceInfo
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 		instance, errRet = elasticsearchService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -705,7 +708,7 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 	if d.HasChange("instance_name") {
 		instanceName := d.Get("instance_name").(string)
 		// Update operation support at most one item at the same time
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			errRet := elasticsearchService.UpdateInstance(ctx, instanceId, instanceName, "", 0, nil, nil, nil)
 			if errRet != nil {
 				return retryError(errRet)
@@ -718,7 +721,7 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 	}
 	if d.HasChange("password") {
 		password := d.Get("password").(string)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			errRet := elasticsearchService.UpdateInstance(ctx, instanceId, "", password, 0, nil, nil, nil)
 			if errRet != nil {
 				return retryError(errRet)
@@ -732,7 +735,7 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 
 	if d.HasChange("version") {
 		version := d.Get("version").(string)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			errRet := elasticsearchService.UpdateInstanceVersion(ctx, instanceId, version)
 			if errRet != nil {
 				return retryError(errRet)
@@ -751,7 +754,7 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 
 	if d.HasChange("license_type") {
 		licenseType := d.Get("license_type").(string)
-		err := resource.Retry(writeRetryTimeout*2, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout*2, func() *resource.RetryError {
 			errRet := elasticsearchService.UpdateInstanceLicense(ctx, instanceId, licenseType)
 			if errRet != nil {
 				return retryError(errRet)
@@ -772,7 +775,7 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 		basicSecurityType := d.Get("basic_security_type").(int)
 		licenseType := d.Get("license_type").(string)
 		licenseTypeUpgrading := licenseType != "oss"
-		err := resource.Retry(writeRetryTimeout*2, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout*2, func() *resource.RetryError {
 			errRet := elasticsearchService.UpdateInstance(ctx, instanceId, "", "", int64(basicSecurityType), nil, nil, nil)
 			if errRet != nil {
 				err := errRet.(*sdkErrors.TencentCloudSDKError)
@@ -803,7 +806,7 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 				NodeNum:  helper.IntUint64(value["node_num"].(int)),
 				NodeType: helper.String(value["node_type"].(string)),
 			}
-			err = resource.Retry(writeRetryTimeout*2, func() *resource.RetryError {
+			err = retryOperation(meta, writeRetryTimeout*2, func() *resource.RetryError {
 				errRet := elasticsearchService.UpdateInstance(ctx, instanceId, "", "", 0, nil, info, nil)
 				if errRet != nil {
 					return retryError(errRet)
@@ -842,7 +845,7 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 			}
 			nodeInfoList = append(nodeInfoList, &dataDisk)
 		}
-		err := resource.Retry(writeRetryTimeout*2, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout*2, func() *resource.RetryError {
 			errRet := elasticsearchService.UpdateInstance(ctx, instanceId, "", "", 0, nodeInfoList, nil, nil)
 			if errRet != nil {
 				return retryError(errRet)
@@ -857,8 +860,8 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 			return err
 		}
 	}
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldInterface, newInterface := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagService := TagService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
@@ -887,7 +890,7 @@ func resourceTencentCloudElasticsearchInstanceUpdate(d *schema.ResourceData, met
 			}
 		}
 
-		err := resource.Retry(writeRetryTimeout*2, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout*2, func() *resource.RetryError {
 			errRet := elasticsearchService.UpdateInstance(ctx, instanceId, "", "", 0, nil, nil, &esAcl)
 			if errRet != nil {
 				return retryError(errRet)
@@ -913,7 +916,7 @@ func resourceTencentCloudElasticsearchInstanceDelete(d *schema.ResourceData, met
 	elasticsearchService := ElasticsearchService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 		errRet := elasticsearchService.DeleteInstance(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet)
@@ -924,7 +927,7 @@ func resourceTencentCloudElasticsearchInstanceDelete(d *schema.ResourceData, met
 		return err
 	}
 
-	err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, errRet := elasticsearchService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
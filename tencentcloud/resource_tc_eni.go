@@ -626,8 +626,8 @@ func resourceTencentCloudEniUpdate(d *schema.ResourceData, m interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldTags, newTags := getTagsChange(d, m)
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("vpc", "eni", region, id)
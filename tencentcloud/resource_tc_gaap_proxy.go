@@ -364,8 +364,8 @@ func resourceTencentCloudGaapProxyUpdate(d *schema.ResourceData, m interface{})
 
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldTags, newTags := getTagsChange(d, m)
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		tagService := TagService{client: m.(*TencentCloudClient).apiV3Conn}
@@ -220,8 +220,8 @@ func resourceTencentCloudGaapRealserverUpdate(d *schema.ResourceData, m interfac
 
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldTags, newTags := getTagsChange(d, m)
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		tagService := TagService{client: m.(*TencentCloudClient).apiV3Conn}
@@ -110,12 +110,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudInstance() *schema.Resource {
@@ -124,6 +124,26 @@ func resourceTencentCloudInstance() *schema.Resource {
 		Read:   resourceTencentCloudInstanceRead,
 		Update: resourceTencentCloudInstanceUpdate,
 		Delete: resourceTencentCloudInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		CustomizeDiff: customdiff.All(
+			customDiffOnCreate(
+				customDiffRequiredWhen("instance_charge_type", []string{CVM_CHARGE_TYPE_PREPAID}, "instance_charge_type_prepaid_period"),
+				customDiffRequiredWhen("instance_charge_type", []string{CVM_CHARGE_TYPE_CDHPAID}, "cdh_instance_type", "cdh_host_id"),
+				customDiffAllowedWhen("instance_charge_type", []string{CVM_CHARGE_TYPE_PREPAID}, "instance_charge_type_prepaid_period"),
+				customDiffAllowedWhen("instance_charge_type", []string{CVM_CHARGE_TYPE_SPOTPAID}, "spot_instance_type", "spot_max_price"),
+				customDiffAllowedWhen("instance_charge_type", []string{CVM_CHARGE_TYPE_CDHPAID}, "cdh_instance_type", "cdh_host_id"),
+			),
+			customDiffUpdatableWhen("internet_charge_type", []string{
+				CVM_INTERNET_CHARGE_TYPE_TRAFFIC_POSTPAID,
+				CVM_INTERNET_CHARGE_TYPE_BANDWIDTH_POSTPAID,
+				CVM_INTERNET_CHARGE_TYPE_BANDWIDTH_PACKAGE,
+			}, "internet_max_bandwidth_out"),
+			customDiffNoDecrease("system_disk_size"),
+		),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -735,8 +755,7 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 
 	instanceId := ""
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check("create")
+	err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RunInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -765,7 +784,7 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 	//get system disk ID and data disk ID
 	var systemDiskId string
 	var dataDiskIds []string
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, errRet := cvmService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -834,7 +853,7 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 			return err
 		}
 
-		err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			instance, errRet := cvmService.DescribeInstanceById(ctx, instanceId)
 			if errRet != nil {
 				return retryError(errRet, InternalError)
@@ -873,7 +892,7 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 	cbsService := CbsService{client: client}
 	var instance *cvm.Instance
 	var errRet error
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 		instance, errRet = cvmService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -894,7 +913,7 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 
 	var cvmImages []string
 	var response *cvm.DescribeImagesResponse
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 		request := cvm.NewDescribeImagesRequest()
 		response, errRet = client.UseCvmClient().DescribeImages(request)
 		if errRet != nil {
@@ -980,7 +999,7 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 				diskSizeMap[*id] = helper.Int64Uint64(*size)
 			}
 		}
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 			disks, err := cbsService.DescribeDiskList(ctx, diskIds)
 			if err != nil {
 				return resource.NonRetryableError(err)
@@ -1106,7 +1125,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 			return err
 		}
 		// query cvm status
-		err = waitForOperationFinished(d, meta, 5*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+		err = waitForOperationFinished(d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 		if err != nil {
 			return err
 		}
@@ -1132,7 +1151,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 			return err
 		}
 		// query cvm status
-		err = waitForOperationFinished(d, meta, 5*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+		err = waitForOperationFinished(d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 		if err != nil {
 			return err
 		}
@@ -1147,7 +1166,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		}
 
 		//check success
-		err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+		err = waitForOperationFinished(d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 		if err != nil {
 			return err
 		}
@@ -1272,7 +1291,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 			if err != nil {
 				return err
 			}
-			err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+			err = waitForOperationFinished(d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 			if err != nil {
 				return err
 			}
@@ -1288,7 +1307,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 				if err != nil {
 					return err
 				}
-				err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+				err = waitForOperationFinished(d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 				if err != nil {
 					return err
 				}
@@ -1299,7 +1318,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 				if err != nil {
 					return err
 				}
-				err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+				err = waitForOperationFinished(d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 				if err != nil {
 					return err
 				}
@@ -1323,7 +1342,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 				if err != nil {
 					return err
 				}
-				err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+				err = waitForOperationFinished(d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 				if err != nil {
 					return err
 				}
@@ -1333,7 +1352,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 				if err != nil {
 					return err
 				}
-				err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+				err = waitForOperationFinished(d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 				if err != nil {
 					return err
 				}
@@ -1399,7 +1418,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 			return fmt.Errorf("an error occurred when modifying system_disk, reason: %s", err.Error())
 		}
 
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 			instance, err := cvmService.DescribeInstanceById(ctx, instanceId)
 			if err != nil {
 				return resource.NonRetryableError(err)
@@ -1433,7 +1452,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 			return err
 		}
 
-		err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+		err = waitForOperationFinished(d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 		if err != nil {
 			return err
 		}
@@ -1445,7 +1464,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 			return err
 		}
 
-		err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+		err = waitForOperationFinished(d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 		if err != nil {
 			return err
 		}
@@ -1461,8 +1480,8 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldInterface, newInterface := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagService := TagService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
@@ -1511,7 +1530,7 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 			return err
 		}
 
-		err = waitForOperationFinished(d, meta, 2*readRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false)
+		err = waitForOperationFinished(d, meta, d.Timeout(schema.TimeoutUpdate), CVM_LATEST_OPERATION_STATE_OPERATING, false)
 		if err != nil {
 			return err
 		}
@@ -1537,7 +1556,7 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 		errRet := cvmService.DeleteInstance(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet)
@@ -1552,7 +1571,7 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 	notExist := false
 
 	//check exist
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, errRet := cvmService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -1576,7 +1595,7 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 	}
 
 	// exist in recycle, delete again
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 		errRet := cvmService.DeleteInstance(ctx, instanceId)
 		//when state is terminating, do not delete but check exist
 		if errRet != nil {
@@ -1597,7 +1616,7 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 	}
 
 	//describe and check not exist
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		instance, errRet := cvmService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -1618,7 +1637,7 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 			deleteWithInstance := value["delete_with_instance"].(bool)
 			if deleteWithInstance {
 				cbsService := CbsService{client: meta.(*TencentCloudClient).apiV3Conn}
-				err := resource.Retry(readRetryTimeout*2, func() *resource.RetryError {
+				err := retryOperation(meta, readRetryTimeout*2, func() *resource.RetryError {
 					diskInfo, e := cbsService.DescribeDiskById(ctx, diskId)
 					if e != nil {
 						return retryError(e, InternalError)
@@ -1632,7 +1651,7 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 					log.Printf("[CRITAL]%s delete cbs failed, reason:%s\n ", logId, err.Error())
 					return err
 				}
-				err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+				err = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 					e := cbsService.DeleteDiskById(ctx, diskId)
 					if e != nil {
 						return retryError(e, InternalError)
@@ -1643,7 +1662,7 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 					log.Printf("[CRITAL]%s delete cbs failed, reason:%s\n ", logId, err.Error())
 					return err
 				}
-				err = resource.Retry(readRetryTimeout*2, func() *resource.RetryError {
+				err = retryOperation(meta, readRetryTimeout*2, func() *resource.RetryError {
 					diskInfo, e := cbsService.DescribeDiskById(ctx, diskId)
 					if e != nil {
 						return retryError(e, InternalError)
@@ -1657,7 +1676,7 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 					log.Printf("[CRITAL]%s read cbs status failed, reason:%s\n ", logId, err.Error())
 					return err
 				}
-				err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+				err = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 					e := cbsService.DeleteDiskById(ctx, diskId)
 					if e != nil {
 						return retryError(e, InternalError)
@@ -1668,7 +1687,7 @@ func resourceTencentCloudInstanceDelete(d *schema.ResourceData, meta interface{}
 					log.Printf("[CRITAL]%s delete cbs failed, reason:%s\n ", logId, err.Error())
 					return err
 				}
-				err = resource.Retry(readRetryTimeout*2, func() *resource.RetryError {
+				err = retryOperation(meta, readRetryTimeout*2, func() *resource.RetryError {
 					diskInfo, e := cbsService.DescribeDiskById(ctx, diskId)
 					if e != nil {
 						return retryError(e, InternalError)
@@ -1771,7 +1790,7 @@ func waitForOperationFinished(d *schema.ResourceData, meta interface{}, timeout
 		time.Sleep(time.Second * 10)
 	}
 
-	err := resource.Retry(timeout, func() *resource.RetryError {
+	err := retryOperation(meta, timeout, func() *resource.RetryError {
 		instance, errRet := cvmService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
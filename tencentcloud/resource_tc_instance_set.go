@@ -79,7 +79,6 @@ import (
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudInstanceSet() *schema.Resource {
@@ -542,7 +541,6 @@ func doResourceTencentCloudInstanceSetCreate(d *schema.ResourceData, meta interf
 	instanceIds := make([]*string, 0)
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check("create")
 		response, err := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RunInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -807,7 +805,6 @@ func doResourceTencentCloudInstanceSetUpdate(d *schema.ResourceData, meta interf
 			}
 
 			err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				ratelimit.Check("create")
 				response, err := meta.(*TencentCloudClient).apiV3Conn.UseCvmClient().RunInstances(request)
 				if err != nil {
 					log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
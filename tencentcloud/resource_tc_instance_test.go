@@ -3,8 +3,6 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 	"testing"
 	"time"
 
@@ -13,54 +11,33 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("tencentcloud_instance", &resource.Sweeper{
-		Name: "tencentcloud_instance",
-		F:    testSweepCvmInstance,
+	addTestSweeper(&sweeper{
+		Name:           "tencentcloud_instance",
+		ServiceType:    "cvm",
+		ResourcePrefix: "instance",
+		List: func(ctx context.Context, client *TencentCloudClient) ([]*sweepResource, error) {
+			cvmService := CvmService{client: client.apiV3Conn}
+			instances, err := cvmService.DescribeInstanceByFilter(ctx, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]*sweepResource, 0, len(instances))
+			for _, v := range instances {
+				resources = append(resources, &sweepResource{
+					Id:          *v.InstanceId,
+					Name:        *v.InstanceName,
+					CreatedTime: stringTotime(*v.CreatedTime),
+				})
+			}
+			return resources, nil
+		},
+		Delete: func(ctx context.Context, client *TencentCloudClient, id string) error {
+			cvmService := CvmService{client: client.apiV3Conn}
+			return cvmService.DeleteInstance(ctx, id)
+		},
 	})
 }
 
-func testSweepCvmInstance(region string) error {
-	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-
-	sharedClient, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("getting tencentcloud client error: %s", err.Error())
-	}
-	client := sharedClient.(*TencentCloudClient)
-
-	cvmService := CvmService{
-		client: client.apiV3Conn,
-	}
-
-	instances, err := cvmService.DescribeInstanceByFilter(ctx, nil, nil)
-	if err != nil {
-		return fmt.Errorf("get instance list error: %s", err.Error())
-	}
-
-	for _, v := range instances {
-		instanceId := *v.InstanceId
-		instanceName := *v.InstanceName
-		now := time.Now()
-		createTime := stringTotime(*v.CreatedTime)
-		interval := now.Sub(createTime).Minutes()
-
-		if strings.HasPrefix(instanceName, keepResource) || strings.HasPrefix(instanceName, defaultResource) {
-			continue
-		}
-
-		if needProtect == 1 && int64(interval) < 30 {
-			continue
-		}
-
-		if err = cvmService.DeleteInstance(ctx, instanceId); err != nil {
-			log.Printf("[ERROR] sweep instance %s error: %s", instanceId, err.Error())
-		}
-	}
-
-	return nil
-}
-
 func TestAccTencentCloudInstanceResource_Basic(t *testing.T) {
 	t.Parallel()
 
@@ -186,16 +186,16 @@ func resourceTencentCloudTkeAddonAttachment() *schema.Resource {
 				Description: "Addon current status.",
 			},
 		},
-		Create: resourceTencentCloudTkeAddonAttachmentCreate,
-		Update: resourceTencentCloudTkeAddonAttachmentUpdate,
-		Read:   resourceTencentCloudTkeAddonAttachmentRead,
-		Delete: resourceTencentCloudTkeAddonAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTkeAddonAttachmentCreate,
+		Update:   resourceTencentCloudTkeAddonAttachmentUpdate,
+		Read:     resourceTencentCloudTkeAddonAttachmentRead,
+		Delete:   resourceTencentCloudTkeAddonAttachmentDelete,
+		Importer: tkeAddonAttachmentId.Importer(nil),
 	}
 }
 
+var tkeAddonAttachmentId = helper.NewIdSchema("cluster_id", "addon_name")
+
 func resourceTencentCloudTkeAddonAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.resource_tc_kubernetes_addon_attachment.create")()
 	logId := getLogId(contextNil)
@@ -241,7 +241,7 @@ func resourceTencentCloudTkeAddonAttachmentCreate(d *schema.ResourceData, meta i
 		return err
 	}
 
-	d.SetId(clusterId + FILED_SP + addonName)
+	d.SetId(tkeAddonAttachmentId.Format(clusterId, addonName))
 
 	resData := &AddonResponseData{}
 	reason := "unknown error"
@@ -275,15 +275,14 @@ func resourceTencentCloudTkeAddonAttachmentRead(d *schema.ResourceData, meta int
 
 	id := d.Id()
 	has := false
-	split := strings.Split(id, FILED_SP)
-	if len(split) < 2 {
-		return fmt.Errorf("id expected format: cluster_id#addon_name but no addon_name provided")
+	split, err := tkeAddonAttachmentId.Parse(id)
+	if err != nil {
+		return err
 	}
 	clusterId := split[0]
 	addonName := split[1]
 
 	var (
-		err               error
 		response          string
 		addonResponseData = &AddonResponseData{}
 	)
@@ -338,15 +337,16 @@ func resourceTencentCloudTkeAddonAttachmentUpdate(d *schema.ResourceData, meta i
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 
+	split, err := tkeAddonAttachmentId.Parse(d.Id())
+	if err != nil {
+		return err
+	}
 	var (
-		id        = d.Id()
-		split     = strings.Split(id, FILED_SP)
 		clusterId = split[0]
 		addonName = split[1]
 		version   = d.Get("version").(string)
 		values    = d.Get("values").([]interface{})
 		reqBody   = d.Get("request_body").(string)
-		err       error
 	)
 
 	if d.HasChange("request_body") && reqBody == "" || d.HasChange("version") || d.HasChange("values") {
@@ -372,9 +372,11 @@ func resourceTencentCloudTkeAddonAttachmentDelete(d *schema.ResourceData, meta i
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 	service := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
 
+	split, err := tkeAddonAttachmentId.Parse(d.Id())
+	if err != nil {
+		return err
+	}
 	var (
-		id        = d.Id()
-		split     = strings.Split(id, FILED_SP)
 		clusterId = split[0]
 		addonName = split[1]
 		has       bool
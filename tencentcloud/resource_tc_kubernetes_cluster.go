@@ -6,6 +6,8 @@ Provide a resource to create a kubernetes cluster.
 ~> **NOTE:** We recommend this usage that uses the `tencentcloud_kubernetes_cluster` resource to create a cluster without any `worker_config`, then adds nodes by the `tencentcloud_kubernetes_node_pool` resource.
 It's more flexible than managing worker config directly with `tencentcloud_kubernetes_cluster`, `tencentcloud_kubernetes_scale_worker`, or existing node management of `tencentcloud_kubernetes_attachment`. The reason is that `worker_config` is unchangeable and may cause the whole cluster resource to `ForceNew`.
 
+~> **NOTE:** The new `cluster_version` is checked against the versions the cluster can upgrade to at plan time. The master is upgraded first, then the instances not in a node pool, and then the node pools one after another, all in the `update` timeout. If a batch fails and `pause_on_failure` is true, the upgrade is paused, resume or abort it in the console before the next apply. The instances left behind the master are kept in `outdated_instance_ids`, and the next apply upgrades them.
+
 Example Usage
 
 Create a basic cluster with two worker nodes
@@ -495,6 +497,28 @@ resource "tencentcloud_kubernetes_cluster" "managed_cluster" {
 }
 ```
 
+Upgrade the cluster and then its nodes in batches
+
+```hcl
+resource "tencentcloud_kubernetes_cluster" "managed_cluster" {
+  # ...your basic fields
+
+  cluster_version                  = "1.24.4"
+  upgrade_instances_follow_cluster = true
+
+  upgrade_settings {
+    node_upgrade_type     = "in_place"
+    batch_size            = 2
+    max_not_ready_percent = 0.2
+    pause_on_failure      = true
+  }
+
+  timeouts {
+    update = "5h"
+  }
+}
+```
+
 Using ops options
 ```
 resource "tencentcloud_kubernetes_cluster" "managed_cluster" {
@@ -527,12 +551,15 @@ import (
 	"log"
 	"math"
 	"net"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
@@ -1022,6 +1049,48 @@ func resourceTencentCloudTkeCluster() *schema.Resource {
 			Default:     false,
 			Description: "Indicates whether upgrade all instances when cluster_version change. Default is false.",
 		},
+		"upgrade_settings": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Settings of the upgrade of the instances after the master is upgraded to `cluster_version`, it can only be set when `upgrade_instances_follow_cluster` is true.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"node_upgrade_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      TKE_NODE_UPGRADE_TYPE_IN_PLACE,
+						ValidateFunc: validateAllowedStringValue(TKE_NODE_UPGRADE_TYPES),
+						Description:  "How the instances are upgraded. Valid values: `in_place`: upgrade the components of the instances in place, `reset`: reinstall the instances with the new version, which replaces the data of the system disk. Default is `in_place`.",
+					},
+					"batch_size": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validateIntegerMin(0),
+						Description:  "Number of instances upgraded in a batch. The instances not in a node pool are upgraded first, then the node pools one after another, and a batch never spans two node pools. 0 means all the instances of a node pool are upgraded in one batch. Default is 0.",
+					},
+					"max_not_ready_percent": {
+						Type:         schema.TypeFloat,
+						Optional:     true,
+						ValidateFunc: validation.FloatBetween(0, 1),
+						Description:  "The max ratio of the pods which are not ready during the upgrade of a batch, e.g. 0.2. The default of TKE is used if it is not set.",
+					},
+					"pause_on_failure": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+						Description: "Whether to pause the upgrade when a batch fails, so it can be resumed or aborted in the console after the investigation. If it is false, the failed batch is aborted and the next batches go on, the failed instances are reported at the end. Default is true.",
+					},
+				},
+			},
+		},
+		"outdated_instance_ids": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "IDs of the instances whose version is behind the master, which are upgraded by the next apply. It is only set when `upgrade_instances_follow_cluster` is true.",
+		},
 		"cluster_ipvs": {
 			Type:        schema.TypeBool,
 			ForceNew:    true,
@@ -1587,6 +1656,25 @@ func resourceTencentCloudTkeCluster() *schema.Resource {
 		Read:   resourceTencentCloudTkeClusterRead,
 		Update: resourceTencentCloudTkeClusterUpdate,
 		Delete: resourceTencentCloudTkeClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(3 * time.Hour),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+		CustomizeDiff: customdiff.All(
+			customDiffOnCreate(
+				customDiffRequiredWhen("network_type", []string{TKE_CLUSTER_NETWORK_TYPE_VPC_CNI}, "service_cidr", "eni_subnet_ids"),
+				customDiffRequiredWhen("network_type", []string{TKE_CLUSTER_NETWORK_TYPE_GR}, "cluster_cidr"),
+				customDiffRequiredWhen("cluster_deploy_type", []string{TKE_DEPLOY_TYPE_INDEPENDENT}, "master_config"),
+				customDiffConflictsWhen("cluster_deploy_type", []string{TKE_DEPLOY_TYPE_MANAGED}, "master_config"),
+				customDiffConflictsWith("exist_instance", "master_config", "worker_config"),
+				customDiffAllowedWhen("cluster_intranet", []string{"true"}, "cluster_intranet_subnet_id"),
+			),
+			customDiffRequiredWhen("cluster_intranet", []string{"true"}, "cluster_intranet_subnet_id"),
+			customDiffAllowedWhen("upgrade_instances_follow_cluster", []string{"true"}, "upgrade_settings"),
+			tkeClusterVersionCustomizeDiff,
+			tkeClusterUpgradeCustomizeDiff,
+		),
 		Schema: schemaBody,
 	}
 }
@@ -1941,30 +2029,186 @@ func tkeGetAuthOptions(d *schema.ResourceData) *tke.ModifyClusterAuthenticationO
 	return request
 }
 
-// upgradeClusterInstances upgrade instances, upgrade type try seq:major, hot.
-func upgradeClusterInstances(tkeService TkeService, ctx context.Context, id string) error {
+// tkeClusterVersionCustomizeDiff checks the new `cluster_version` is one of the versions the cluster can upgrade to
+func tkeClusterVersionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("cluster_version") || !d.NewValueKnown("cluster_version") {
+		return nil
+	}
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	version := d.Get("cluster_version").(string)
+
+	tkeService := TkeService{client: meta.(*TencentCloudClient).apiV3Conn}
+	result, err := tkeService.DescribeKubernetesAvailableClusterVersionsByFilter(ctx, map[string]interface{}{
+		"cluster_id": helper.String(d.Id()),
+	})
+	if err != nil || result == nil {
+		// the version is checked on apply anyway
+		log.Printf("[WARN]%s check cluster version skipped, reason:%v", logId, err)
+		return nil
+	}
+
+	versions := make([]string, 0, len(result.Versions))
+	for _, v := range result.Versions {
+		if v == nil {
+			continue
+		}
+		if *v == version {
+			return nil
+		}
+		versions = append(versions, *v)
+	}
+	if len(versions) == 0 {
+		return fmt.Errorf("`cluster_version` %s is not available, cluster %s can not be upgraded for now", version, d.Id())
+	}
+	return fmt.Errorf("`cluster_version` %s is not available, cluster %s can be upgraded to %s, see the data source `tencentcloud_kubernetes_available_cluster_versions`",
+		version, d.Id(), strings.Join(versions, ", "))
+}
+
+// tkeClusterUpgradeCustomizeDiff plans the upgrade of the instances when `cluster_version` changes, or when the
+// instances left by a failed upgrade are still behind the master
+func tkeClusterUpgradeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.Get("upgrade_instances_follow_cluster").(bool) &&
+		(d.HasChange("cluster_version") || len(d.Get("outdated_instance_ids").([]interface{})) > 0) {
+		return d.SetNewComputed("outdated_instance_ids")
+	}
+	return nil
+}
+
+// tkeNodeUpgradeSettings is the `upgrade_settings` of the cluster
+type tkeNodeUpgradeSettings struct {
+	upgradeType        string
+	batchSize          int
+	maxNotReadyPercent float64
+	pauseOnFailure     bool
+}
+
+func getTkeNodeUpgradeSettings(d *schema.ResourceData) tkeNodeUpgradeSettings {
+	settings := tkeNodeUpgradeSettings{upgradeType: TKE_NODE_UPGRADE_TYPE_IN_PLACE, pauseOnFailure: true}
+	if dMap, ok := helper.InterfacesHeadMap(d, "upgrade_settings"); ok {
+		settings.upgradeType = dMap["node_upgrade_type"].(string)
+		settings.batchSize = dMap["batch_size"].(int)
+		settings.maxNotReadyPercent = dMap["max_not_ready_percent"].(float64)
+		settings.pauseOnFailure = dMap["pause_on_failure"].(bool)
+	}
+	return settings
+}
+
+// tkeNodeUpgradeBatches splits the instances into the batches of the upgrade, the instances not in a node pool are
+// upgraded first, then the node pools in the order of their ids, a batch never spans two node pools
+func tkeNodeUpgradeBatches(instanceIds []string, nodePools map[string]string, batchSize int) [][]string {
+	groups := make(map[string][]string)
+	poolIds := make([]string, 0)
+	for _, instanceId := range instanceIds {
+		poolId := nodePools[instanceId]
+		if _, ok := groups[poolId]; !ok {
+			poolIds = append(poolIds, poolId)
+		}
+		groups[poolId] = append(groups[poolId], instanceId)
+	}
+	sort.Strings(poolIds)
+
+	batches := make([][]string, 0)
+	for _, poolId := range poolIds {
+		group := groups[poolId]
+		size := batchSize
+		if size <= 0 {
+			size = len(group)
+		}
+		for start := 0; start < len(group); start += size {
+			end := start + size
+			if end > len(group) {
+				end = len(group)
+			}
+			batches = append(batches, group[start:end])
+		}
+	}
+	return batches
+}
+
+// upgradeClusterInstances upgrades the instances to the version of the master in batches, the in-place upgrade tries
+// the major upgrade first and then the hot upgrade. When a batch fails, the task of the upgrade is paused if
+// pauseOnFailure is set, otherwise it is aborted and the next batches go on.
+func upgradeClusterInstances(tkeService TkeService, ctx context.Context, id string, settings tkeNodeUpgradeSettings, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
 	// get all available instances for upgrade
-	upgradeType := "major"
-	instanceIds, err := tkeService.CheckInstancesUpgradeAble(ctx, id, upgradeType)
+	upgradeType, instanceIds, err := describeTkeClusterOutdatedInstances(tkeService, ctx, id, settings.upgradeType)
 	if err != nil {
 		return err
 	}
+	log.Println("instancesIds for upgrade:", instanceIds)
 	if len(instanceIds) == 0 {
-		upgradeType = "hot"
-		instanceIds, err = tkeService.CheckInstancesUpgradeAble(ctx, id, upgradeType)
-		if err != nil {
-			return err
+		return nil
+	}
+
+	_, workers, err := tkeService.DescribeClusterInstances(ctx, id)
+	if err != nil {
+		return err
+	}
+	nodePools := make(map[string]string, len(workers))
+	for _, worker := range workers {
+		nodePools[worker.InstanceId] = worker.NodePoolId
+	}
+
+	var failed []string
+	batches := tkeNodeUpgradeBatches(instanceIds, nodePools, settings.batchSize)
+	for i, batch := range batches {
+		if !time.Now().Before(deadline) {
+			var left []string
+			for _, b := range batches[i:] {
+				left = append(left, b...)
+			}
+			return fmt.Errorf("upgrade instances of cluster %s timed out, the instances left are %s", id, strings.Join(left, ", "))
+		}
+
+		err := upgradeClusterInstancesBatch(tkeService, ctx, id, upgradeType, batch, settings.maxNotReadyPercent, time.Until(deadline))
+		if err == nil {
+			continue
+		}
+		if settings.pauseOnFailure {
+			if inErr := tkeService.OperateClusterInstancesUpgrade(ctx, id, "pause"); inErr != nil {
+				log.Printf("[WARN] pause the upgrade of cluster %s failed, reason:%v", id, inErr)
+			}
+			return fmt.Errorf("upgrade instances %s of cluster %s failed, the upgrade is paused, resume or abort it in the console: %v",
+				strings.Join(batch, ", "), id, err)
+		}
+		if inErr := tkeService.OperateClusterInstancesUpgrade(ctx, id, "abort"); inErr != nil {
+			return inErr
 		}
+		log.Printf("[WARN] upgrade instances %v of cluster %s failed and aborted, reason:%v", batch, id, err)
+		failed = append(failed, batch...)
 	}
-	log.Println("instancesIds for upgrade:", instanceIds)
-	instNum := len(instanceIds)
-	if instNum == 0 {
-		return nil
+	if len(failed) > 0 {
+		return fmt.Errorf("upgrade instances %s of cluster %s failed", strings.Join(failed, ", "), id)
+	}
+
+	return nil
+}
+
+// describeTkeClusterOutdatedInstances returns the instances whose version is behind the master and the upgrade type
+// of the API to upgrade them by nodeUpgradeType, the in-place upgrade tries the major upgrade first and then the hot
+// upgrade
+func describeTkeClusterOutdatedInstances(tkeService TkeService, ctx context.Context, id string, nodeUpgradeType string) (upgradeType string, instanceIds []string, errRet error) {
+	upgradeType = nodeUpgradeType
+	if upgradeType == TKE_NODE_UPGRADE_TYPE_IN_PLACE {
+		upgradeType = "major"
+	}
+	instanceIds, errRet = tkeService.CheckInstancesUpgradeAble(ctx, id, upgradeType)
+	if errRet != nil {
+		return
+	}
+	if len(instanceIds) == 0 && nodeUpgradeType == TKE_NODE_UPGRADE_TYPE_IN_PLACE {
+		upgradeType = "hot"
+		instanceIds, errRet = tkeService.CheckInstancesUpgradeAble(ctx, id, upgradeType)
 	}
+	return
+}
 
-	// upgrade instances
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		inErr := tkeService.UpgradeClusterInstances(ctx, id, upgradeType, instanceIds)
+// upgradeClusterInstancesBatch creates the task to upgrade the instances and waits until it is done
+func upgradeClusterInstancesBatch(tkeService TkeService, ctx context.Context, id string, upgradeType string, instanceIds []string, maxNotReadyPercent float64, timeout time.Duration) error {
+	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		inErr := tkeService.UpgradeClusterInstances(ctx, id, upgradeType, instanceIds, maxNotReadyPercent)
 		if inErr != nil {
 			return retryError(inErr)
 		}
@@ -1974,9 +2218,7 @@ func upgradeClusterInstances(tkeService TkeService, ctx context.Context, id stri
 		return err
 	}
 
-	// check update status: upgrade instance one by one, so timeout depend on instance number.
-	timeout := readRetryTimeout * time.Duration(instNum)
-	err = resource.Retry(timeout, func() *resource.RetryError {
+	return resource.Retry(timeout, func() *resource.RetryError {
 		done, inErr := tkeService.GetUpgradeInstanceResult(ctx, id)
 		if inErr != nil {
 			return retryError(inErr)
@@ -1987,11 +2229,6 @@ func upgradeClusterInstances(tkeService TkeService, ctx context.Context, id stri
 			return resource.RetryableError(fmt.Errorf("cluster %s, retry...", id))
 		}
 	})
-	if err != nil {
-		return err
-	}
-
-	return nil
 }
 
 func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface{}) error {
@@ -2306,7 +2543,7 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 
 	if err != nil {
 		// create often cost more than 20 Minutes.
-		err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			_, _, err = service.DescribeClusterInstances(ctx, d.Id())
 
 			if e, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -2334,7 +2571,7 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 
 	//intranet
 	if clusterIntranet {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			inErr := service.CreateClusterEndpoint(ctx, id, intranetSubnetId, clusterInternetSecurityGroup, false, clusterIntranetDomain, "")
 			if inErr != nil {
 				return retryError(inErr)
@@ -2344,7 +2581,7 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 		if err != nil {
 			return err
 		}
-		err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			status, message, inErr := service.DescribeClusterEndpointStatus(ctx, id, false)
 			if inErr != nil {
 				return retryError(inErr)
@@ -2365,7 +2602,7 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 	}
 
 	if clusterInternet {
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			inErr := service.CreateClusterEndpoint(ctx, id, "", clusterInternetSecurityGroup, true, clusterInternetDomain, "")
 			if inErr != nil {
 				return retryError(inErr)
@@ -2375,7 +2612,7 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 		if err != nil {
 			return err
 		}
-		err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			status, message, inErr := service.DescribeClusterEndpointStatus(ctx, id, true)
 			if inErr != nil {
 				return retryError(inErr)
@@ -2398,7 +2635,7 @@ func resourceTencentCloudTkeClusterCreate(d *schema.ResourceData, meta interface
 	//Modify node pool global config
 	if _, ok := d.GetOk("node_pool_global_config"); ok {
 		request := tkeGetNodePoolGlobalConfig(d)
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			inErr := service.ModifyClusterNodePoolGlobalConfig(ctx, request)
 			if inErr != nil {
 				return retryError(inErr)
@@ -2477,7 +2714,7 @@ func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}
 
 	info, has, err := service.DescribeCluster(ctx, d.Id())
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 			info, has, err = service.DescribeCluster(ctx, d.Id())
 			if err != nil {
 				return retryError(err)
@@ -2509,6 +2746,17 @@ func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}
 	_ = d.Set("cluster_os", newOs)
 	_ = d.Set("cluster_deploy_type", info.DeployType)
 	_ = d.Set("cluster_version", info.ClusterVersion)
+
+	// the instances left by a failed upgrade are kept in `outdated_instance_ids`, so the next apply resumes it
+	outdated := make([]string, 0)
+	if d.Get("upgrade_instances_follow_cluster").(bool) {
+		_, instanceIds, err := describeTkeClusterOutdatedInstances(service, ctx, d.Id(), getTkeNodeUpgradeSettings(d).upgradeType)
+		if err != nil {
+			return err
+		}
+		outdated = append(outdated, instanceIds...)
+	}
+	_ = d.Set("outdated_instance_ids", outdated)
 	_ = d.Set("cluster_ipvs", info.Ipvs)
 	_ = d.Set("vpc_id", info.VpcId)
 	_ = d.Set("project_id", info.ProjectId)
@@ -2529,7 +2777,7 @@ func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}
 
 	config, err := service.DescribeClusterConfig(ctx, d.Id(), true)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 			config, err = service.DescribeClusterConfig(ctx, d.Id(), true)
 			if err != nil {
 				return retryError(err)
@@ -2546,7 +2794,7 @@ func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}
 
 	intranetConfig, err := service.DescribeClusterConfig(ctx, d.Id(), false)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 			intranetConfig, err = service.DescribeClusterConfig(ctx, d.Id(), false)
 			if err != nil {
 				return retryError(err)
@@ -2563,7 +2811,7 @@ func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}
 
 	_, workers, err := service.DescribeClusterInstances(ctx, d.Id())
 	if err != nil {
-		err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, 10*readRetryTimeout, func() *resource.RetryError {
 			_, workers, err = service.DescribeClusterInstances(ctx, d.Id())
 
 			if e, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -2597,7 +2845,7 @@ func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}
 	securityRet, err := service.DescribeClusterSecurity(ctx, d.Id())
 
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 			securityRet, err = service.DescribeClusterSecurity(ctx, d.Id())
 			if e, ok := err.(*errors.TencentCloudSDKError); ok {
 				if e.GetCode() == "InternalError.ClusterNotFound" {
@@ -2649,7 +2897,7 @@ func resourceTencentCloudTkeClusterRead(d *schema.ResourceData, meta interface{}
 	//}
 
 	var globalConfig *tke.ClusterAsGroupOption
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 		globalConfig, err = service.DescribeClusterNodePoolGlobalConfig(ctx, d.Id())
 		if e, ok := err.(*errors.TencentCloudSDKError); ok {
 			if e.GetCode() == "InternalError.ClusterNotFound" {
@@ -2695,8 +2943,8 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 	region := client.Region
 	d.Partial(true)
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldTags, newTags := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("ccs", "cluster", region, id)
@@ -2786,7 +3034,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 			clusterLevel = ""
 		}
 
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			err := tkeService.ModifyClusterAttribute(ctx, id, projectId, clusterName, clusterDesc, clusterLevel, autoUpgradeClusterLevel)
 			if err != nil {
 				// create and update immediately may cause cluster level syntax error, this error can wait until cluster level state normal
@@ -2801,6 +3049,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 	}
 
 	//upgrade k8s cluster version
+	upgradeStart := time.Now()
 	if d.HasChange("cluster_version") {
 		newVersion := d.Get("cluster_version").(string)
 		isOk, err := tkeService.CheckClusterVersion(ctx, id, newVersion)
@@ -2814,7 +3063,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 		if !ok {
 			extraArgs = nil
 		}
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			inErr := tkeService.ModifyClusterVersion(ctx, id, newVersion, extraArgs)
 			if inErr != nil {
 				return retryError(inErr)
@@ -2825,7 +3074,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 			return err
 		}
 		//check status
-		err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			ins, has, inErr := tkeService.DescribeCluster(ctx, id)
 			if inErr != nil {
 				return retryError(inErr)
@@ -2842,24 +3091,23 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 		if err != nil {
 			return err
 		}
+	}
 
-		// upgrade instances version
-		upgrade := false
-		if v, ok := d.GetOk("upgrade_instances_follow_cluster"); ok {
-			upgrade = v.(bool)
-		}
-		if upgrade {
-			err := upgradeClusterInstances(tkeService, ctx, id)
-			if err != nil {
-				return err
-			}
+	// upgrade instances version, the instances left by a failed upgrade are upgraded even if the master is not
+	outdated, _ := d.GetChange("outdated_instance_ids")
+	if d.Get("upgrade_instances_follow_cluster").(bool) &&
+		(d.HasChange("cluster_version") || len(outdated.([]interface{})) > 0) {
+		timeout := d.Timeout(schema.TimeoutUpdate) - time.Since(upgradeStart)
+		err := upgradeClusterInstances(tkeService, ctx, id, getTkeNodeUpgradeSettings(d), timeout)
+		if err != nil {
+			return err
 		}
 	}
 
 	// update node pool global config
 	if d.HasChange("node_pool_global_config") {
 		request := tkeGetNodePoolGlobalConfig(d)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			inErr := tkeService.ModifyClusterNodePoolGlobalConfig(ctx, request)
 			if inErr != nil {
 				return retryError(inErr)
@@ -2874,7 +3122,7 @@ func resourceTencentCloudTkeClusterUpdate(d *schema.ResourceData, meta interface
 
 	if d.HasChange("auth_options") {
 		request := tkeGetAuthOptions(d)
-		err := resource.Retry(3*writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, 3*writeRetryTimeout, func() *resource.RetryError {
 			inErr := tkeService.ModifyClusterAuthenticationOptions(ctx, request)
 			if inErr != nil {
 				return retryError(inErr)
@@ -3035,7 +3283,7 @@ func resourceTencentCloudTkeClusterDelete(d *schema.ResourceData, meta interface
 		deleteAuditLogSetAndTopic = v["delete_audit_log_and_topic"].(bool)
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 		if deleteEventLogSetAndTopic && enableEventLog {
 			err := service.SwitchEventPersistence(ctx, d.Id(), "", "", false, true)
 			if e, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -3076,7 +3324,7 @@ func resourceTencentCloudTkeClusterDelete(d *schema.ResourceData, meta interface
 	_, _, err = service.DescribeClusterInstances(ctx, d.Id())
 
 	if err != nil {
-		err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			_, _, err = service.DescribeClusterInstances(ctx, d.Id())
 			if e, ok := err.(*errors.TencentCloudSDKError); ok {
 				if e.GetCode() == "InvalidParameter.ClusterNotFound" {
@@ -13,8 +13,8 @@ resource "tencentcloud_kubernetes_cluster_endpoint" "foo" {
   cluster_internet = true
   cluster_intranet = true
   # managed_cluster_internet_security_policies = [
-    "192.168.0.0/24"
-  ]
+  #   "192.168.0.0/24"
+  # ]
   cluster_intranet_subnet_id = "subnet-xxxxxxxx"
   depends_on = [
 	tencentcloud_kubernetes_node_pool.pool1
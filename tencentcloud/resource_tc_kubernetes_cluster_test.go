@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/mockapi"
 
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
@@ -216,6 +219,219 @@ func TestUnitTkeAddonDiff(t *testing.T) {
 	})
 }
 
+func TestTkeClusterVersionCustomizeDiff(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	meta := testMockapiMeta(server)
+	meta.apiV3Conn.Endpoints["tke"] = server.URL
+
+	server.Handle("tke", "DescribeAvailableClusterVersion", func(request *mockapi.Request) (interface{}, error) {
+		var params tke.DescribeAvailableClusterVersionRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		if params.ClusterId == nil || *params.ClusterId != "cls-1" {
+			return nil, mockapi.NewError("InvalidParameter", "cluster id is not set")
+		}
+		return map[string]interface{}{"Versions": []string{"1.24.4", "1.26.1"}}, nil
+	})
+
+	r := resourceTencentCloudTkeCluster()
+	d := r.TestResourceData()
+	d.SetId("cls-1")
+	_ = d.Set("vpc_id", "vpc-1")
+	_ = d.Set("cluster_version", "1.22.5")
+	state := d.State()
+
+	for _, c := range []struct {
+		version  string
+		expected string
+	}{
+		{"1.22.5", ""},
+		{"1.24.4", ""},
+		{"1.28.3", "`cluster_version` 1.28.3 is not available, cluster cls-1 can be upgraded to 1.24.4, 1.26.1"},
+	} {
+		raw := map[string]interface{}{"vpc_id": "vpc-1", "cluster_version": c.version}
+		_, err := testCustomizeDiff(t, r, state, raw, meta)
+		if c.expected == "" && err != nil {
+			t.Errorf("%s: unexpected error: %v", c.version, err)
+		}
+		if c.expected != "" && (err == nil || !strings.Contains(err.Error(), c.expected)) {
+			t.Errorf("%s: expected error %q, got %v", c.version, c.expected, err)
+		}
+	}
+
+	raw := map[string]interface{}{
+		"vpc_id":           "vpc-1",
+		"cluster_version":  "1.22.5",
+		"upgrade_settings": []interface{}{map[string]interface{}{"batch_size": 1}},
+	}
+	if _, err := testCustomizeDiff(t, r, state, raw, meta); err == nil || !strings.Contains(err.Error(), "`upgrade_settings` can only be set") {
+		t.Errorf("expected error of `upgrade_settings` without `upgrade_instances_follow_cluster`, got %v", err)
+	}
+}
+
+func TestTkeClusterUpgradeCustomizeDiff(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	meta := testMockapiMeta(server)
+	meta.apiV3Conn.Endpoints["tke"] = server.URL
+	server.Handle("tke", "DescribeAvailableClusterVersion", func(request *mockapi.Request) (interface{}, error) {
+		return map[string]interface{}{"Versions": []string{"1.24.4"}}, nil
+	})
+
+	r := resourceTencentCloudTkeCluster()
+	d := r.TestResourceData()
+	d.SetId("cls-1")
+	_ = d.Set("vpc_id", "vpc-1")
+	_ = d.Set("cluster_version", "1.22.5")
+	_ = d.Set("upgrade_instances_follow_cluster", true)
+	_ = d.Set("outdated_instance_ids", []interface{}{})
+	raw := map[string]interface{}{"vpc_id": "vpc-1", "cluster_version": "1.22.5", "upgrade_instances_follow_cluster": true}
+
+	for _, c := range []struct {
+		name     string
+		version  string
+		outdated []interface{}
+		upgrade  bool
+	}{
+		{"up to date", "1.22.5", []interface{}{}, false},
+		{"new version", "1.24.4", []interface{}{}, true},
+		// the master is upgraded by a failed apply, but some instances are not
+		{"instances left", "1.22.5", []interface{}{"ins-1"}, true},
+	} {
+		_ = d.Set("outdated_instance_ids", c.outdated)
+		raw["cluster_version"] = c.version
+		diff, err := testCustomizeDiff(t, r, d.State(), raw, meta)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+			continue
+		}
+		upgrade := diff != nil && diff.Attributes["outdated_instance_ids.#"] != nil && diff.Attributes["outdated_instance_ids.#"].NewComputed
+		if upgrade != c.upgrade {
+			t.Errorf("%s: expected the upgrade of the instances to be planned %v, got %v", c.name, c.upgrade, diff)
+		}
+	}
+}
+
+func TestTkeNodeUpgradeBatches(t *testing.T) {
+	nodePools := map[string]string{"ins-2": "np-2", "ins-3": "np-2", "ins-4": "np-2", "ins-5": "np-1"}
+	instanceIds := []string{"ins-2", "ins-1", "ins-3", "ins-5", "ins-4"}
+	for _, c := range []struct {
+		batchSize int
+		expected  string
+	}{
+		{0, "[[ins-1] [ins-5] [ins-2 ins-3 ins-4]]"},
+		{1, "[[ins-1] [ins-5] [ins-2] [ins-3] [ins-4]]"},
+		{2, "[[ins-1] [ins-5] [ins-2 ins-3] [ins-4]]"},
+		{5, "[[ins-1] [ins-5] [ins-2 ins-3 ins-4]]"},
+	} {
+		if batches := fmt.Sprint(tkeNodeUpgradeBatches(instanceIds, nodePools, c.batchSize)); batches != c.expected {
+			t.Errorf("batch size %d: expected %s, got %s", c.batchSize, c.expected, batches)
+		}
+	}
+}
+
+func TestUpgradeClusterInstances(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	meta := testMockapiMeta(server)
+	meta.apiV3Conn.Endpoints["tke"] = server.URL
+	tkeService := TkeService{client: meta.apiV3Conn}
+
+	var (
+		failing    string
+		batch      []string
+		batches    []string
+		operations []string
+	)
+	server.Handle("tke", "CheckInstancesUpgradeAble", func(request *mockapi.Request) (interface{}, error) {
+		var params tke.CheckInstancesUpgradeAbleRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		if *params.UpgradeType == "major" {
+			return map[string]interface{}{"UpgradeAbleInstances": []interface{}{}, "Total": 0}, nil
+		}
+		instances := make([]interface{}, 0)
+		for _, instanceId := range []string{"ins-1", "ins-2", "ins-3", "ins-4", "ins-5"} {
+			instances = append(instances, map[string]interface{}{"InstanceId": instanceId, "Version": "1.22.5"})
+		}
+		return map[string]interface{}{"UpgradeAbleInstances": instances, "Total": len(instances)}, nil
+	})
+	server.Handle("tke", "DescribeClusterInstances", func(request *mockapi.Request) (interface{}, error) {
+		set := make([]interface{}, 0)
+		for instanceId, nodePoolId := range map[string]string{"ins-1": "", "ins-2": "np-2", "ins-3": "np-2", "ins-4": "np-2", "ins-5": "np-1"} {
+			set = append(set, map[string]interface{}{
+				"InstanceId": instanceId, "InstanceRole": TKE_ROLE_WORKER, "InstanceState": "running",
+				"FailedReason": "", "NodePoolId": nodePoolId,
+			})
+		}
+		return map[string]interface{}{"TotalCount": len(set), "InstanceSet": set}, nil
+	})
+	server.Handle("tke", "UpgradeClusterInstances", func(request *mockapi.Request) (interface{}, error) {
+		var params tke.UpgradeClusterInstancesRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		if *params.Operation != "create" {
+			operations = append(operations, *params.Operation)
+			return map[string]interface{}{}, nil
+		}
+		if *params.UpgradeType != "hot" || params.MaxNotReadyPercent == nil || *params.MaxNotReadyPercent != 0.2 {
+			return nil, mockapi.NewError("InvalidParameter", "unexpected upgrade type %s", *params.UpgradeType)
+		}
+		batch = batch[:0]
+		for _, instanceId := range params.InstanceIds {
+			batch = append(batch, *instanceId)
+		}
+		batches = append(batches, strings.Join(batch, ","))
+		return map[string]interface{}{}, nil
+	})
+	server.Handle("tke", "GetUpgradeInstanceProgress", func(request *mockapi.Request) (interface{}, error) {
+		for _, instanceId := range batch {
+			if instanceId == failing {
+				return map[string]interface{}{"Total": len(batch), "Done": 0, "LifeState": "paused"}, nil
+			}
+		}
+		return map[string]interface{}{"Total": len(batch), "Done": len(batch), "LifeState": "done"}, nil
+	})
+
+	settings := tkeNodeUpgradeSettings{
+		upgradeType:        TKE_NODE_UPGRADE_TYPE_IN_PLACE,
+		batchSize:          2,
+		maxNotReadyPercent: 0.2,
+		pauseOnFailure:     true,
+	}
+	if err := upgradeClusterInstances(tkeService, context.TODO(), "cls-1", settings, time.Minute); err != nil {
+		t.Fatalf("upgrade instances failed: %v", err)
+	}
+	if expected := "ins-1 ins-5 ins-2,ins-3 ins-4"; strings.Join(batches, " ") != expected {
+		t.Errorf("expected batches %s, got %v", expected, batches)
+	}
+
+	// the upgrade stops at the failed batch
+	failing, batches = "ins-5", nil
+	err := upgradeClusterInstances(tkeService, context.TODO(), "cls-1", settings, time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "upgrade instances ins-5 of cluster cls-1 failed, the upgrade is paused") {
+		t.Errorf("expected error of the paused upgrade, got %v", err)
+	}
+	if strings.Join(batches, " ") != "ins-1 ins-5" || strings.Join(operations, ",") != "pause" {
+		t.Errorf("expected the upgrade to be paused after ins-5, got batches %v, operations %v", batches, operations)
+	}
+
+	// the failed batch is aborted and the next batches go on
+	failing, batches, operations = "ins-3", nil, nil
+	settings.pauseOnFailure = false
+	err = upgradeClusterInstances(tkeService, context.TODO(), "cls-1", settings, time.Minute)
+	if err == nil || err.Error() != "upgrade instances ins-2, ins-3 of cluster cls-1 failed" {
+		t.Errorf("expected error of the failed instances, got %v", err)
+	}
+	if strings.Join(batches, " ") != "ins-1 ins-5 ins-2,ins-3 ins-4" || strings.Join(operations, ",") != "abort" {
+		t.Errorf("expected the failed batch to be aborted, got batches %v, operations %v", batches, operations)
+	}
+}
+
 func testAccCheckTkeDestroy(s *terraform.State) error {
 	logId := getLogId(contextNil)
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
@@ -7,6 +7,8 @@ Provide a resource to create an auto scaling group for kubernetes cluster.
 
 ~> **NOTE:**  In order to ensure the integrity of customer data, if the cvm instance was destroyed due to shrinking, it will keep the cbs associate with cvm by default. If you want to destroy together, please set `delete_with_instance` to `true`.
 
+~> **NOTE:** When `upgrade_settings.replace_nodes` is `true`, changing `node_os`, `node_os_type`, or the instance type or system disk of `auto_scaling_config` replaces the existing nodes in batches: the desired capacity is raised by `max_surge`, then the old nodes are cordoned, drained and removed. The old nodes are the ones whose image, instance type or system disk differ from the launch configuration, the data disks are not compared since the disks attached later are listed with them. The cluster autoscaler may change the desired capacity during the replacement if `enable_auto_scale` is `true`. If the replacement fails, like when the pods of a node are not evicted in `drain_timeout`, the desired capacity is restored, the old nodes left are kept in `outdated_node_ids`, and the next apply replaces them.
+
 Example Usage
 
 ```hcl
@@ -142,6 +144,35 @@ resource "tencentcloud_kubernetes_node_pool" "mynodepool" {
 
 }
 
+```
+
+Replacing the existing nodes in batches
+```hcl
+resource "tencentcloud_kubernetes_node_pool" "mynodepool" {
+  name                 = "mynodepool"
+  cluster_id           = tencentcloud_kubernetes_cluster.managed_cluster.id
+  max_size             = 6
+  min_size             = 1
+  vpc_id               = data.tencentcloud_vpc_subnets.vpc.instance_list.0.vpc_id
+  subnet_ids           = [data.tencentcloud_vpc_subnets.vpc.instance_list.0.subnet_id]
+  desired_capacity     = 4
+  enable_auto_scale    = false
+  node_os              = "tlinux3.1x86_64"
+
+  auto_scaling_config {
+    instance_type      = var.default_instance_type
+    system_disk_type   = "CLOUD_PREMIUM"
+    system_disk_size   = "50"
+    security_group_ids = ["sg-24vswocp"]
+  }
+
+  upgrade_settings {
+    replace_nodes   = true
+    max_surge       = 1
+    max_unavailable = 0
+    drain_timeout   = 600
+  }
+}
 ```
 */
 package tencentcloud
@@ -151,11 +182,14 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
@@ -190,8 +224,7 @@ func composedKubernetesAsScalingConfigPara() map[string]*schema.Schema {
 		"instance_type": {
 			Type:        schema.TypeString,
 			Required:    true,
-			ForceNew:    true,
-			Description: "Specified types of CVM instance.",
+			Description: "Specified types of CVM instance. The node pool is replaced when it changes, unless `upgrade_settings.replace_nodes` is `true`.",
 		},
 		"backup_instance_types": {
 			Type:        schema.TypeList,
@@ -381,10 +414,16 @@ func composedKubernetesAsScalingConfigPara() map[string]*schema.Schema {
 
 func resourceTencentCloudKubernetesNodePool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKubernetesNodePoolCreate,
-		Read:   resourceKubernetesNodePoolRead,
-		Delete: resourceKubernetesNodePoolDelete,
-		Update: resourceKubernetesNodePoolUpdate,
+		Create:        resourceKubernetesNodePoolCreate,
+		Read:          resourceKubernetesNodePoolRead,
+		Delete:        resourceKubernetesNodePoolDelete,
+		UpdateContext: resourceKubernetesNodePoolUpdateContext,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(3 * time.Hour),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		CustomizeDiff: resourceKubernetesNodePoolCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -514,6 +553,49 @@ func resourceTencentCloudKubernetesNodePool() *schema.Resource {
 				},
 				Description: "Taints of kubernetes node pool created nodes.",
 			},
+			"upgrade_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"replace_nodes": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to replace the existing nodes in batches when `node_os`, `node_os_type`, or the instance type or system disk of `auto_scaling_config` change. Only the nodes created after the change use the new settings by default. Default is `false`.",
+						},
+						"max_surge": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validateIntegerInRange(0, 2000),
+							Description:  "Number of the new nodes created above the desired capacity in each batch of the replacement, it is limited by `max_size`. Default is `1`.",
+						},
+						"max_unavailable": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validateIntegerInRange(0, 2000),
+							Description:  "Number of the old nodes removed below the desired capacity in each batch of the replacement, it is limited by `min_size`. Default is `0`.",
+						},
+						"drain_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      300,
+							ValidateFunc: validateIntegerInRange(0, 3600),
+							Description:  "Seconds to wait for the pods on an old node to be evicted. If they are not evicted in it, like the ones protected by a PodDisruptionBudget, the replacement fails and the node is kept in `outdated_node_ids`, unless `force_remove` is `true`. Default is `300`.",
+						},
+						"force_remove": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to remove an old node with a warning when its pods are not evicted in `drain_timeout`, which ignores the PodDisruptionBudgets. Default is `false`.",
+						},
+					},
+				},
+				Description: "Settings of replacing the existing nodes. The nodes are cordoned and drained through the API server of the cluster, whose internet or intranet endpoint must be reachable by the provider.",
+			},
 			"delete_keep_instance": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -606,6 +688,12 @@ func resourceTencentCloudKubernetesNodePool() *schema.Resource {
 				Computed:    true,
 				Description: "The auto scaling group ID.",
 			},
+			"outdated_node_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the nodes whose image, instance type or system disk differ from the launch configuration, which are replaced by the next apply. It is only set when `upgrade_settings.replace_nodes` is `true`.",
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -1014,7 +1102,7 @@ func resourceKubernetesNodePoolRead(d *schema.ResourceData, meta interface{}) er
 
 	_, has, err := service.DescribeCluster(ctx, clusterId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 			_, has, err = service.DescribeCluster(ctx, clusterId)
 			if err != nil {
 				return retryError(err)
@@ -1039,7 +1127,7 @@ func resourceKubernetesNodePoolRead(d *schema.ResourceData, meta interface{}) er
 		nodePool *tke.NodePool
 	)
 
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 		nodePool, has, err = service.DescribeNodePool(ctx, clusterId, nodePoolId)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -1217,10 +1305,18 @@ func resourceKubernetesNodePoolRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	outdated := make([]string, 0)
+	if hasLC > 0 && d.Get("upgrade_settings.0.replace_nodes").(bool) {
+		if outdated, err = describeNodePoolOutdatedNodes(ctx, &service, clusterId, nodePoolId, launchCfg); err != nil {
+			return err
+		}
+	}
+	_ = d.Set("outdated_node_ids", outdated)
+
 	// Relative scaling group status
 	asg, hasAsg, err := asService.DescribeAutoScalingGroupById(ctx, *nodePool.AutoscalingGroupId)
 	if err != nil {
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 			asg, hasAsg, err = asService.DescribeAutoScalingGroupById(ctx, *nodePool.AutoscalingGroupId)
 			if err != nil {
 				return retryError(err)
@@ -1321,7 +1417,7 @@ func resourceKubernetesNodePoolCreate(d *schema.ResourceData, meta interface{})
 	d.SetId(clusterId + FILED_SP + nodePoolId)
 
 	// wait for status ok
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		nodePool, _, errRet := service.DescribeNodePool(ctx, clusterId, nodePoolId)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -1404,7 +1500,7 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 	// modify min/max first will cause error, this case must upgrade desired first
 	if d.HasChange("desired_capacity") || !desiredCapacityOutRange(d) {
 		desiredCapacity := int64(d.Get("desired_capacity").(int))
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			errRet := service.ModifyClusterNodePoolDesiredCapacity(ctx, clusterId, nodePoolId, desiredCapacity)
 			if errRet != nil {
 				return retryError(errRet)
@@ -1428,6 +1524,8 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 		"enable_auto_scale",
 		"node_os_type",
 		"node_os",
+		"tags",
+		"tags_all",
 	) {
 		maxSize := int64(d.Get("max_size").(int))
 		minSize := int64(d.Get("min_size").(int))
@@ -1438,8 +1536,31 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 		nodeOsType := d.Get("node_os_type").(string)
 		labels := GetTkeLabels(d, "labels")
 		taints := GetTkeTaints(d, "taints")
-		tags := helper.GetTags(d, "tags")
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		// the ignored tags are kept, as ModifyClusterNodePool replaces all the tags
+		var remoteTags map[string]string
+		if getIgnoreTags(meta) != nil {
+			err := retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
+				nodePool, _, errRet := service.DescribeNodePool(ctx, clusterId, nodePoolId)
+				if errRet != nil {
+					return retryError(errRet)
+				}
+				if nodePool != nil {
+					remoteTags = make(map[string]string, len(nodePool.Tags))
+					for _, tag := range nodePool.Tags {
+						remoteTags[*tag.Key] = *tag.Value
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		tags := make(map[string]string)
+		for k, v := range mergeIgnoredTags(meta, d.Get("tags").(map[string]interface{}), remoteTags) {
+			tags[k] = v.(string)
+		}
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			errRet := service.ModifyClusterNodePool(ctx, clusterId, nodePoolId, name, enableAutoScale, minSize, maxSize, nodeOs, nodeOsType, labels, taints, tags)
 			if errRet != nil {
 				return retryError(errRet)
@@ -1498,7 +1619,7 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 			request.TerminationPolicies = helper.InterfacesStringsPoint(v.([]interface{}))
 		}
 
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			errRet := asService.ModifyAutoScalingGroup(ctx, request)
 			if errRet != nil {
 				return retryError(errRet)
@@ -1514,7 +1635,7 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 
 	if d.HasChange("desired_capacity") && !capacityHasChanged {
 		desiredCapacity := int64(d.Get("desired_capacity").(int))
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			errRet := service.ModifyClusterNodePoolDesiredCapacity(ctx, clusterId, nodePoolId, desiredCapacity)
 			if errRet != nil {
 				return retryError(errRet)
@@ -1526,9 +1647,9 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("auto_scaling_config.0.backup_instance_types") {
+	if d.HasChanges("auto_scaling_config.0.instance_type", "auto_scaling_config.0.backup_instance_types") {
 		instanceTypes := getNodePoolInstanceTypes(d)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			errRet := service.ModifyClusterNodePoolInstanceTypes(ctx, clusterId, nodePoolId, instanceTypes)
 			if errRet != nil {
 				return retryError(errRet)
@@ -1545,6 +1666,335 @@ func resourceKubernetesNodePoolUpdate(d *schema.ResourceData, meta interface{})
 	return resourceKubernetesNodePoolRead(d, meta)
 }
 
+// nodePoolReplacementKeys are the arguments which only apply to the nodes created after they change,
+// the existing nodes are replaced for them when `upgrade_settings.0.replace_nodes` is true
+var nodePoolReplacementKeys = []string{
+	"node_os",
+	"node_os_type",
+	"auto_scaling_config.0.instance_type",
+	"auto_scaling_config.0.system_disk_type",
+	"auto_scaling_config.0.system_disk_size",
+}
+
+func resourceKubernetesNodePoolCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	replaceNodes := d.Get("upgrade_settings.0.replace_nodes").(bool)
+
+	// the instance type of the launch configuration is updatable, but the existing nodes are only replaced on demand
+	if d.Id() != "" && d.HasChange("auto_scaling_config.0.instance_type") && !replaceNodes {
+		if err := d.ForceNew("auto_scaling_config.0.instance_type"); err != nil {
+			return err
+		}
+	}
+
+	if replaceNodes && d.Get("upgrade_settings.0.max_surge").(int) == 0 && d.Get("upgrade_settings.0.max_unavailable").(int) == 0 {
+		return fmt.Errorf("`upgrade_settings.0.max_surge` and `upgrade_settings.0.max_unavailable` can not be both 0 when `upgrade_settings.0.replace_nodes` is true")
+	}
+
+	// plan the replacement when the nodes change, or when the old nodes are left by a failed replacement
+	if d.Id() != "" && replaceNodes &&
+		(d.HasChanges(nodePoolReplacementKeys...) || len(d.Get("outdated_node_ids").([]interface{})) > 0) {
+		return d.SetNewComputed("outdated_node_ids")
+	}
+	return nil
+}
+
+// resourceKubernetesNodePoolUpdateContext updates the node pool and replaces its existing nodes if needed, the
+// warnings and the progress of the replacement are returned as diagnostics
+func resourceKubernetesNodePoolUpdateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	meta = contextMeta(ctx, meta)
+	outdated, _ := d.GetChange("outdated_node_ids")
+	replaceNodes := d.Get("upgrade_settings.0.replace_nodes").(bool) &&
+		(d.HasChanges(nodePoolReplacementKeys...) || len(outdated.([]interface{})) > 0)
+
+	if err := resourceKubernetesNodePoolUpdate(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	if !replaceNodes {
+		return nil
+	}
+
+	// the old nodes left by a failed replacement are kept in `outdated_node_ids`, so the next apply resumes it
+	diags := replaceKubernetesNodePoolNodes(d, meta)
+	return append(diags, diag.FromErr(resourceKubernetesNodePoolRead(d, meta))...)
+}
+
+// describeNodePoolOutdatedNodes returns the IDs of the nodes of the node pool whose image, instance type or system
+// disk differ from the launch configuration. The launch configuration of a node pool is modified in place, so the nodes
+// are compared with its current settings.
+func describeNodePoolOutdatedNodes(ctx context.Context, service *TkeService, clusterId, nodePoolId string, launchConfig *as.LaunchConfiguration) ([]string, error) {
+	nodes, err := service.DescribeNodePoolInstances(ctx, clusterId, nodePoolId)
+	if err != nil {
+		return nil, err
+	}
+	outdated := make([]string, 0, len(nodes))
+	if len(nodes) == 0 {
+		return outdated, nil
+	}
+
+	cvmService := CvmService{client: service.client}
+	instances := make(map[string]*cvm.Instance, len(nodes))
+	// DescribeInstances accepts at most 100 instance ids
+	for i := 0; i < len(nodes); i += 100 {
+		instanceIds := make([]*string, 0, 100)
+		for j := i; j < len(nodes) && j < i+100; j++ {
+			instanceIds = append(instanceIds, helper.String(nodes[j].InstanceId))
+		}
+		set, err := cvmService.DescribeInstanceByFilter(ctx, instanceIds, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range set {
+			instances[*instance.InstanceId] = instance
+		}
+	}
+	for _, node := range nodes {
+		// the instances which are not found are being created or deleted
+		if instance, ok := instances[node.InstanceId]; ok && nodePoolNodeOutdated(instance, launchConfig) {
+			outdated = append(outdated, node.InstanceId)
+		}
+	}
+	return outdated, nil
+}
+
+// nodePoolNodeOutdated returns whether the image, the instance type or the system disk of the instance differ from
+// the launch configuration, the instance types of the launch configuration include the backup ones. The data disks
+// are not compared, since the elastic disks attached to a node later, like the persistent volumes, are listed with
+// the ones created with it.
+func nodePoolNodeOutdated(instance *cvm.Instance, launchConfig *as.LaunchConfiguration) bool {
+	if launchConfig.ImageId != nil && helper.PString(instance.ImageId) != *launchConfig.ImageId {
+		return true
+	}
+
+	instanceTypes := launchConfig.InstanceTypes
+	if len(instanceTypes) == 0 && launchConfig.InstanceType != nil {
+		instanceTypes = []*string{launchConfig.InstanceType}
+	}
+	if len(instanceTypes) > 0 {
+		matched := false
+		for _, instanceType := range instanceTypes {
+			if helper.PString(instanceType) == helper.PString(instance.InstanceType) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return true
+		}
+	}
+
+	if launchConfig.SystemDisk != nil && instance.SystemDisk != nil {
+		if launchConfig.SystemDisk.DiskType != nil && *launchConfig.SystemDisk.DiskType != helper.PString(instance.SystemDisk.DiskType) {
+			return true
+		}
+		if launchConfig.SystemDisk.DiskSize != nil && instance.SystemDisk.DiskSize != nil &&
+			int64(*launchConfig.SystemDisk.DiskSize) != *instance.SystemDisk.DiskSize {
+			return true
+		}
+	}
+	return false
+}
+
+// nodePoolReplacementBatch returns the number of the new nodes created above the desired capacity and the number of
+// the old nodes removed below it in a batch, which are limited by the max size and the min size of the node pool
+func nodePoolReplacementBatch(desired, minSize, maxSize int64, maxSurge, maxUnavailable int) (surge, unavailable int64) {
+	surge = int64(maxSurge)
+	if surge > maxSize-desired {
+		surge = maxSize - desired
+	}
+	unavailable = int64(maxUnavailable)
+	if unavailable > desired-minSize {
+		unavailable = desired - minSize
+	}
+	if surge < 0 {
+		surge = 0
+	}
+	if unavailable < 0 {
+		unavailable = 0
+	}
+	return
+}
+
+// replaceKubernetesNodePoolNodes replaces the old nodes of the node pool in batches by `upgrade_settings`. In
+// each batch, the desired capacity is raised by `max_surge` and the new nodes are waited to run, then at most
+// `max_surge` + `max_unavailable` old nodes are cordoned, drained and removed from the scaling group. At last the
+// desired capacity is restored.
+func replaceKubernetesNodePoolNodes(d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		logId          = getLogId(contextNil)
+		ctx            = context.WithValue(context.TODO(), logIdKey, logId)
+		client         = meta.(*TencentCloudClient).apiV3Conn
+		service        = TkeService{client: client}
+		asService      = AsService{client: client}
+		items          = strings.Split(d.Id(), FILED_SP)
+		maxSurge       = d.Get("upgrade_settings.0.max_surge").(int)
+		maxUnavailable = d.Get("upgrade_settings.0.max_unavailable").(int)
+		drainTimeout   = time.Duration(d.Get("upgrade_settings.0.drain_timeout").(int)) * time.Second
+		forceRemove    = d.Get("upgrade_settings.0.force_remove").(bool)
+		timeout        = d.Timeout(schema.TimeoutUpdate)
+	)
+	if len(items) != 2 {
+		return diag.Errorf("resource_tc_kubernetes_node_pool id  is broken")
+	}
+	clusterId := items[0]
+	nodePoolId := items[1]
+
+	nodePool, has, err := service.DescribeNodePool(ctx, clusterId, nodePoolId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !has {
+		return diag.Errorf("node pool %s is not found", nodePoolId)
+	}
+	launchConfig, hasLC, err := asService.DescribeLaunchConfigurationById(ctx, helper.PString(nodePool.LaunchConfigurationId))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if hasLC == 0 {
+		return diag.Errorf("launch configuration of node pool %s is not found", nodePoolId)
+	}
+	old, err := describeNodePoolOutdatedNodes(ctx, &service, clusterId, nodePoolId, launchConfig)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(old) == 0 {
+		return nil
+	}
+
+	var (
+		total          = len(old)
+		scalingGroupId = *nodePool.AutoscalingGroupId
+		desired        = *nodePool.DesiredNodesNum
+		initial        = desired
+	)
+	failed := func(err error) diag.Diagnostics {
+		// the desired capacity raised for the batch is restored, otherwise the next apply can not raise it again
+		if desired != initial {
+			if e := modifyNodePoolDesiredCapacity(ctx, meta, &service, clusterId, nodePoolId, initial); e != nil {
+				log.Printf("[CRITAL]%s restore desired capacity of node pool [%s] failed, reason:%+v", logId, nodePoolId, e)
+			}
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Replacing the nodes of node pool %s failed", nodePoolId),
+			Detail: fmt.Sprintf("%d of %d nodes are replaced, the old nodes left are %s: %v",
+				total-len(old), total, strings.Join(old, ", "), err),
+		})
+	}
+
+	surge, unavailable := nodePoolReplacementBatch(desired, *nodePool.MinNodesNum, *nodePool.MaxNodesNum, maxSurge, maxUnavailable)
+	if surge+unavailable == 0 {
+		return failed(fmt.Errorf("no node can be added or removed in a batch, `max_size` must be greater than the desired capacity %d, or `min_size` be less than it", desired))
+	}
+
+	kubernetes, err := service.DescribeClusterKubernetesClient(ctx, clusterId)
+	if err != nil {
+		return failed(fmt.Errorf("the nodes can not be drained: %v", err))
+	}
+
+	for len(old) > 0 {
+		target := initial + surge
+		if desired != target {
+			if err := modifyNodePoolDesiredCapacity(ctx, meta, &service, clusterId, nodePoolId, target); err != nil {
+				return failed(err)
+			}
+			desired = target
+		}
+		if err := waitNodePoolNodesRunning(ctx, meta, &service, clusterId, nodePoolId, old, target-int64(len(old)), timeout); err != nil {
+			return failed(err)
+		}
+
+		size := int(surge + unavailable)
+		if size > len(old) {
+			size = len(old)
+		}
+		batch := old[:size]
+		nodeNames := make([]string, len(batch))
+		for i, instanceId := range batch {
+			if nodeNames[i], err = kubernetes.cordonNode(instanceId); err != nil {
+				return failed(fmt.Errorf("cordon node %s failed: %v", instanceId, err))
+			}
+		}
+		for i, instanceId := range batch {
+			if nodeNames[i] == "" {
+				continue
+			}
+			err := kubernetes.drainNode(nodeNames[i], drainTimeout)
+			if e, ok := err.(*drainTimeoutError); ok && forceRemove {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Node %s of node pool %s is removed before it is drained", instanceId, nodePoolId),
+					Detail:   e.Error(),
+				})
+			} else if err != nil {
+				return failed(fmt.Errorf("drain node %s failed: %v", instanceId, err))
+			}
+		}
+
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
+			if e := asService.RemoveInstances(ctx, scalingGroupId, batch); e != nil {
+				return retryError(e)
+			}
+			return nil
+		})
+		if err != nil {
+			return failed(fmt.Errorf("remove nodes %s failed: %v", strings.Join(batch, ", "), err))
+		}
+		desired -= int64(size)
+		old = old[size:]
+		log.Printf("[DEBUG]%s node pool [%s] replaced %d of %d nodes", logId, nodePoolId, total-len(old), total)
+	}
+
+	if desired != initial {
+		if err := modifyNodePoolDesiredCapacity(ctx, meta, &service, clusterId, nodePoolId, initial); err != nil {
+			return failed(err)
+		}
+		if err := waitNodePoolNodesRunning(ctx, meta, &service, clusterId, nodePoolId, nil, initial, timeout); err != nil {
+			return failed(err)
+		}
+	}
+	return diags
+}
+
+func modifyNodePoolDesiredCapacity(ctx context.Context, meta interface{}, service *TkeService, clusterId, nodePoolId string, desired int64) error {
+	return retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
+		if err := service.ModifyClusterNodePoolDesiredCapacity(ctx, clusterId, nodePoolId, desired); err != nil {
+			return retryError(err)
+		}
+		return nil
+	})
+}
+
+// waitNodePoolNodesRunning waits until count nodes of the node pool other than the old ones are running,
+// it fails when any of them fails
+func waitNodePoolNodesRunning(ctx context.Context, meta interface{}, service *TkeService, clusterId, nodePoolId string, old []string, count int64, timeout time.Duration) error {
+	skip := make(map[string]bool, len(old))
+	for _, instanceId := range old {
+		skip[instanceId] = true
+	}
+	return retryOperation(meta, timeout, func() *resource.RetryError {
+		instances, err := service.DescribeNodePoolInstances(ctx, clusterId, nodePoolId)
+		if err != nil {
+			return retryError(err, InternalError)
+		}
+		var running int64
+		for _, instance := range instances {
+			if skip[instance.InstanceId] {
+				continue
+			}
+			if instance.InstanceState == "failed" {
+				return resource.NonRetryableError(fmt.Errorf("node %s failed: %s", instance.InstanceId, instance.FailedReason))
+			}
+			if instance.InstanceState == "running" {
+				running++
+			}
+		}
+		if running < count {
+			return resource.RetryableError(fmt.Errorf("%d of %d new nodes of node pool %s are running", running, count, nodePoolId))
+		}
+		return nil
+	})
+}
+
 func resourceKubernetesNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_kubernetes_node_pool.delete")()
 
@@ -1568,7 +2018,7 @@ func resourceKubernetesNodePoolDelete(d *schema.ResourceData, meta interface{})
 
 	//delete as group
 	hasDelete := false
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 		err := service.DeleteClusterNodePool(ctx, clusterId, nodePoolId, deleteKeepInstance)
 
 		if sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1592,7 +2042,7 @@ func resourceKubernetesNodePoolDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	// wait for delete ok
-	err = resource.Retry(5*readRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		nodePool, has, errRet := service.DescribeNodePool(ctx, clusterId, nodePoolId)
 		if errRet != nil {
 			errCode := errRet.(*sdkErrors.TencentCloudSDKError).Code
@@ -2,15 +2,25 @@ package tencentcloud
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/mockapi"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -543,3 +553,423 @@ resource "tencentcloud_kubernetes_node_pool" "np_test" {
   }
 }
 `
+
+func TestKubernetesNodePoolCustomizeDiff(t *testing.T) {
+	r := resourceTencentCloudKubernetesNodePool()
+	d := r.TestResourceData()
+	d.SetId("cls-1" + FILED_SP + "np-1")
+	_ = d.Set("cluster_id", "cls-1")
+	_ = d.Set("name", "np")
+	_ = d.Set("max_size", 3)
+	_ = d.Set("min_size", 1)
+	_ = d.Set("vpc_id", "vpc-1")
+	_ = d.Set("retry_policy", SCALING_GROUP_RETRY_POLICY_IMMEDIATE_RETRY)
+	_ = d.Set("unschedulable", 0)
+	_ = d.Set("auto_scaling_config", []interface{}{map[string]interface{}{
+		"instance_type":            "S5.MEDIUM2",
+		"enhanced_monitor_service": true,
+	}})
+	state := d.State()
+
+	raw := map[string]interface{}{
+		"cluster_id":          "cls-1",
+		"name":                "np",
+		"max_size":            3,
+		"min_size":            1,
+		"vpc_id":              "vpc-1",
+		"auto_scaling_config": []interface{}{map[string]interface{}{"instance_type": "S5.MEDIUM4"}},
+	}
+	diff, err := testCustomizeDiff(t, r, state, raw, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !diff.RequiresNew() || !diff.Attributes["auto_scaling_config.0.instance_type"].RequiresNew {
+		t.Errorf("expected the node pool to be replaced when the instance type changes")
+	}
+
+	raw["upgrade_settings"] = []interface{}{map[string]interface{}{"replace_nodes": true}}
+	diff, err = testCustomizeDiff(t, r, state, raw, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff.RequiresNew() {
+		t.Errorf("expected the instance type to be updated when the nodes are replaced")
+	}
+
+	if !diff.Attributes["outdated_node_ids.#"].NewComputed {
+		t.Errorf("expected the nodes to be replaced when the instance type changes")
+	}
+
+	raw["upgrade_settings"] = []interface{}{map[string]interface{}{"replace_nodes": true, "max_surge": 0}}
+	if _, err := testCustomizeDiff(t, r, state, raw, nil); err == nil || !strings.Contains(err.Error(), "can not be both 0") {
+		t.Errorf("expected error of the empty batch, got %v", err)
+	}
+
+	// the old nodes left by a failed replacement are replaced by the next apply
+	_ = d.Set("node_os", "tlinux2.4x86_64")
+	_ = d.Set("node_os_type", "GENERAL")
+	_ = d.Set("auto_scaling_config", []interface{}{map[string]interface{}{
+		"instance_type":            "S5.MEDIUM2",
+		"system_disk_type":         SYSTEM_DISK_TYPE_CLOUD_PREMIUM,
+		"system_disk_size":         50,
+		"enhanced_monitor_service": true,
+	}})
+	_ = d.Set("outdated_node_ids", []interface{}{})
+	raw["auto_scaling_config"] = []interface{}{map[string]interface{}{"instance_type": "S5.MEDIUM2"}}
+	raw["upgrade_settings"] = []interface{}{map[string]interface{}{"replace_nodes": true}}
+	diff, err = testCustomizeDiff(t, r, d.State(), raw, nil)
+	if err != nil || (diff != nil && diff.Attributes["outdated_node_ids.#"] != nil) {
+		t.Errorf("expected no replacement without the old nodes, got %v, %v", diff, err)
+	}
+	_ = d.Set("outdated_node_ids", []interface{}{"ins-old-1"})
+	diff, err = testCustomizeDiff(t, r, d.State(), raw, nil)
+	if err != nil || diff == nil || !diff.Attributes["outdated_node_ids.#"].NewComputed {
+		t.Errorf("expected the old nodes to be replaced, got %v, %v", diff, err)
+	}
+}
+
+func TestNodePoolReplacementBatch(t *testing.T) {
+	for _, c := range []struct {
+		desired, minSize, maxSize  int64
+		maxSurge, maxUnavailable   int
+		expectedSurge, unavailable int64
+	}{
+		{3, 1, 6, 1, 0, 1, 0},
+		{3, 1, 6, 2, 1, 2, 1},
+		{3, 1, 4, 2, 0, 1, 0},
+		{3, 3, 3, 1, 1, 0, 0},
+		{3, 0, 3, 1, 5, 0, 3},
+	} {
+		surge, unavailable := nodePoolReplacementBatch(c.desired, c.minSize, c.maxSize, c.maxSurge, c.maxUnavailable)
+		if surge != c.expectedSurge || unavailable != c.unavailable {
+			t.Errorf("batch of %+v: expected %d, %d, got %d, %d", c, c.expectedSurge, c.unavailable, surge, unavailable)
+		}
+	}
+}
+
+// testKubernetesAPI is a fake API server of the nodes, the pods and the tokens of the service accounts, the pods
+// named `pdb-*` refuse the eviction
+type testKubernetesAPI struct {
+	mutex  sync.Mutex
+	server *httptest.Server
+	// nodes are the unschedulable of the nodes by the instance id
+	nodes map[string]bool
+	// pods are the names of the pods by the instance id of their nodes
+	pods map[string][]string
+	// serviceAccounts are the expiration seconds of the last token requests by namespace/name
+	serviceAccounts map[string]int
+}
+
+func newTestKubernetesAPI() *testKubernetesAPI {
+	me := &testKubernetesAPI{nodes: make(map[string]bool), pods: make(map[string][]string), serviceAccounts: make(map[string]int)}
+	me.server = httptest.NewTLSServer(http.HandlerFunc(me.serveHTTP))
+	return me
+}
+
+func (me *testKubernetesAPI) addNode(instanceId string, pods ...string) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.nodes[instanceId] = false
+	me.pods[instanceId] = pods
+}
+
+func (me *testKubernetesAPI) addServiceAccount(namespace, name string) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	me.serviceAccounts[namespace+"/"+name] = 0
+}
+
+func (me *testKubernetesAPI) kubeconfig() string {
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: me.server.Certificate().Raw})
+	return fmt.Sprintf(`apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: %s
+    server: %s
+  name: cls-1
+contexts:
+- context:
+    cluster: cls-1
+    user: admin
+  name: cls-1-context-default
+current-context: cls-1-context-default
+kind: Config
+users:
+- name: admin
+  user:
+    token: token-1
+`, base64.StdEncoding.EncodeToString(ca), me.server.URL)
+}
+
+func (me *testKubernetesAPI) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+	if r.Header.Get("Authorization") != "Bearer token-1" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/nodes":
+		items := make([]interface{}, 0)
+		for instanceId, unschedulable := range me.nodes {
+			items = append(items, map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "node-" + instanceId},
+				"spec":     map[string]interface{}{"providerID": "qcloud:///800002/" + instanceId, "unschedulable": unschedulable},
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"items": items})
+	case r.Method == http.MethodPatch && len(parts) == 4 && parts[2] == "nodes":
+		instanceId := strings.TrimPrefix(parts[3], "node-")
+		var patch struct {
+			Spec struct {
+				Unschedulable bool `json:"unschedulable"`
+			} `json:"spec"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&patch)
+		me.nodes[instanceId] = patch.Spec.Unschedulable
+		_, _ = w.Write([]byte("{}"))
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/pods":
+		instanceId := strings.TrimPrefix(strings.TrimPrefix(r.URL.Query().Get("fieldSelector"), "spec.nodeName="), "node-")
+		items := []interface{}{map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":            "daemon",
+				"namespace":       "kube-system",
+				"ownerReferences": []interface{}{map[string]interface{}{"kind": "DaemonSet"}},
+			},
+		}}
+		for _, pod := range me.pods[instanceId] {
+			items = append(items, map[string]interface{}{"metadata": map[string]interface{}{"name": pod, "namespace": "default"}})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"items": items})
+	case r.Method == http.MethodPost && len(parts) == 7 && parts[6] == "eviction":
+		if strings.HasPrefix(parts[5], "pdb-") {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message":"Cannot evict pod as it would violate the pod's disruption budget."}`))
+			return
+		}
+		for instanceId, pods := range me.pods {
+			for i, pod := range pods {
+				if pod == parts[5] {
+					me.pods[instanceId] = append(pods[:i:i], pods[i+1:]...)
+				}
+			}
+		}
+		_, _ = w.Write([]byte("{}"))
+	case r.Method == http.MethodPost && len(parts) == 7 && parts[4] == "serviceaccounts" && parts[6] == "token":
+		key := parts[3] + "/" + parts[5]
+		if _, ok := me.serviceAccounts[key]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"serviceaccounts \"` + parts[5] + `\" not found"}`))
+			return
+		}
+		var request struct {
+			Spec struct {
+				ExpirationSeconds int `json:"expirationSeconds"`
+			} `json:"spec"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		me.serviceAccounts[key] = request.Spec.ExpirationSeconds
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": map[string]interface{}{
+			"token":               "sa-token-" + parts[5],
+			"expirationTimestamp": "2026-01-01T01:00:00Z",
+		}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestReplaceKubernetesNodePoolNodes(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	meta := testMockapiMeta(server)
+	meta.apiV3Conn.Endpoints["tke"] = server.URL
+	meta.apiV3Conn.Endpoints["as"] = server.URL
+
+	kubernetes := newTestKubernetesAPI()
+	defer kubernetes.server.Close()
+	// trust the certificate of the fake API server
+	meta.apiV3Conn.HTTPTransport = kubernetes.server.Client().Transport
+
+	var (
+		instances = []string{"ins-old-1", "ins-current", "ins-old-2", "ins-old-3"}
+		desired   = int64(4)
+		maxSize   = int64(5)
+		removed   []string
+		newNodes  int
+	)
+	kubernetes.addNode("ins-old-1", "app-1")
+	kubernetes.addNode("ins-current")
+	kubernetes.addNode("ins-old-2", "app-2", "pdb-1")
+	kubernetes.addNode("ins-old-3")
+
+	server.Handle("tke", "DescribeClusters", func(request *mockapi.Request) (interface{}, error) {
+		return map[string]interface{}{"TotalCount": 1, "Clusters": []interface{}{map[string]interface{}{
+			"ClusterId": "cls-1", "ClusterName": "cls", "ClusterDescription": "", "ClusterVersion": "1.26.1",
+			"ClusterOs": "tlinux2.4x86_64", "ClusterType": "MANAGED_CLUSTER", "ClusterStatus": "Running",
+			"ProjectId": 0, "ClusterNodeNum": 3,
+			"ClusterNetworkSettings": map[string]interface{}{
+				"VpcId": "vpc-1", "ClusterCIDR": "172.16.0.0/16", "IgnoreClusterCIDRConflict": false,
+				"MaxClusterServiceNum": 256, "MaxNodePodNum": 64, "Ipvs": false,
+			},
+		}}}, nil
+	})
+	server.Handle("tke", "DescribeClusterNodePoolDetail", func(request *mockapi.Request) (interface{}, error) {
+		return map[string]interface{}{"NodePool": map[string]interface{}{
+			"NodePoolId": "np-1", "AutoscalingGroupId": "asg-1", "LaunchConfigurationId": "asc-1", "LifeState": "normal",
+			"AutoscalingGroupStatus": "enabled", "DesiredNodesNum": desired, "MinNodesNum": 1, "MaxNodesNum": maxSize,
+		}}, nil
+	})
+	server.Handle("as", "DescribeLaunchConfigurations", func(request *mockapi.Request) (interface{}, error) {
+		return map[string]interface{}{"TotalCount": 1, "LaunchConfigurationSet": []interface{}{map[string]interface{}{
+			"LaunchConfigurationId": "asc-1", "ImageId": "img-new", "InstanceTypes": []interface{}{"S5.MEDIUM2", "S5.MEDIUM4"},
+			"SystemDisk": map[string]interface{}{"DiskType": "CLOUD_PREMIUM", "DiskSize": 50},
+		}}}, nil
+	})
+	// the old nodes are created with the previous image, the others with the image of the launch configuration, and
+	// a persistent volume is attached to the current node
+	server.Handle("cvm", "DescribeInstances", func(request *mockapi.Request) (interface{}, error) {
+		var params cvm.DescribeInstancesRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		set := make([]interface{}, 0, len(params.InstanceIds))
+		for _, instanceId := range params.InstanceIds {
+			imageId := "img-new"
+			if strings.HasPrefix(*instanceId, "ins-old-") {
+				imageId = "img-old"
+			}
+			dataDisks := []interface{}{}
+			if *instanceId == "ins-current" {
+				dataDisks = append(dataDisks, map[string]interface{}{"DiskId": "disk-pv-1", "DiskType": "CLOUD_SSD", "DiskSize": 100})
+			}
+			set = append(set, map[string]interface{}{
+				"InstanceId": *instanceId, "ImageId": imageId, "InstanceType": "S5.MEDIUM4",
+				"SystemDisk": map[string]interface{}{"DiskType": "CLOUD_PREMIUM", "DiskSize": 50},
+				"DataDisks":  dataDisks,
+			})
+		}
+		return map[string]interface{}{"TotalCount": len(set), "InstanceSet": set}, nil
+	})
+	server.Handle("tke", "DescribeClusterInstances", func(request *mockapi.Request) (interface{}, error) {
+		set := make([]interface{}, 0, len(instances))
+		for _, instanceId := range instances {
+			set = append(set, map[string]interface{}{
+				"InstanceId": instanceId, "InstanceRole": TKE_ROLE_WORKER, "InstanceState": "running",
+				"FailedReason": "", "NodePoolId": "np-1",
+			})
+		}
+		return map[string]interface{}{"TotalCount": len(set), "InstanceSet": set}, nil
+	})
+	server.Handle("tke", "DescribeClusterKubeconfig", func(request *mockapi.Request) (interface{}, error) {
+		return map[string]interface{}{"Kubeconfig": kubernetes.kubeconfig()}, nil
+	})
+	server.Handle("tke", "ModifyNodePoolDesiredCapacityAboutAsg", func(request *mockapi.Request) (interface{}, error) {
+		var params tke.ModifyNodePoolDesiredCapacityAboutAsgRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		if *params.DesiredCapacity > maxSize {
+			return nil, mockapi.NewError("InvalidParameter", "desired capacity %d exceeds the max size", *params.DesiredCapacity)
+		}
+		for ; desired < *params.DesiredCapacity; desired++ {
+			newNodes++
+			instanceId := fmt.Sprintf("ins-new-%d", newNodes)
+			instances = append(instances, instanceId)
+			kubernetes.addNode(instanceId)
+		}
+		// the newest instances are terminated when the desired capacity decreases
+		for ; desired > *params.DesiredCapacity; desired-- {
+			instances = instances[:len(instances)-1]
+		}
+		return map[string]interface{}{}, nil
+	})
+	server.Handle("as", "RemoveInstances", func(request *mockapi.Request) (interface{}, error) {
+		var params as.RemoveInstancesRequestParams
+		if err := request.Bind(&params); err != nil {
+			return nil, err
+		}
+		kubernetes.mutex.Lock()
+		defer kubernetes.mutex.Unlock()
+		for _, instanceId := range params.InstanceIds {
+			if !kubernetes.nodes[*instanceId] {
+				return nil, mockapi.NewError("InvalidParameter", "node %s is not cordoned", *instanceId)
+			}
+			for _, pod := range kubernetes.pods[*instanceId] {
+				if !strings.HasPrefix(pod, "pdb-") {
+					return nil, mockapi.NewError("InvalidParameter", "node %s is not drained", *instanceId)
+				}
+			}
+			for i := range instances {
+				if instances[i] == *instanceId {
+					instances = append(instances[:i], instances[i+1:]...)
+					break
+				}
+			}
+			removed = append(removed, *instanceId)
+			desired--
+		}
+		return map[string]interface{}{"ActivityId": "asa-1"}, nil
+	})
+	server.Handle("as", "DescribeAutoScalingActivities", func(request *mockapi.Request) (interface{}, error) {
+		return map[string]interface{}{"TotalCount": 1, "ActivitySet": []interface{}{map[string]interface{}{
+			"ActivityId": "asa-1", "StatusCode": SCALING_GROUP_ACTIVITY_STATUS_SUCCESSFUL,
+		}}}, nil
+	})
+
+	r := resourceTencentCloudKubernetesNodePool()
+	d := r.TestResourceData()
+	d.SetId("cls-1" + FILED_SP + "np-1")
+	_ = d.Set("upgrade_settings", []interface{}{map[string]interface{}{
+		"replace_nodes":   true,
+		"max_surge":       2,
+		"max_unavailable": 0,
+		"drain_timeout":   1,
+	}})
+
+	// the node whose pods are protected by the PodDisruptionBudget is not removed
+	diags := replaceKubernetesNodePoolNodes(d, meta)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail, "1 of 3 nodes are replaced") || !strings.Contains(diags[0].Detail, "default/pdb-1") {
+		t.Fatalf("expected error of the drain timeout of ins-old-2, got %v", diags)
+	}
+	// max_surge is limited to 1 by max_size, and the node of the current image is kept
+	if strings.Join(removed, ",") != "ins-old-1" || newNodes != 2 {
+		t.Errorf("expected the old nodes to be replaced one by one, removed %v, created %d", removed, newNodes)
+	}
+	if desired != 4 || strings.Join(instances, ",") != "ins-current,ins-old-2,ins-old-3,ins-new-1" {
+		t.Errorf("expected the desired capacity to be restored, got %d of %v", desired, instances)
+	}
+
+	// the next apply resumes the replacement, and removes the node when it is forced to
+	_ = d.Set("upgrade_settings", []interface{}{map[string]interface{}{
+		"replace_nodes":   true,
+		"max_surge":       2,
+		"max_unavailable": 0,
+		"drain_timeout":   1,
+		"force_remove":    true,
+	}})
+	diags = replaceKubernetesNodePoolNodes(d, meta)
+	if diags.HasError() {
+		t.Fatalf("replace nodes failed: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "default/pdb-1") {
+		t.Errorf("expected the warning of the drain timeout of ins-old-2, got %v", diags)
+	}
+	if strings.Join(removed, ",") != "ins-old-1,ins-old-2,ins-old-3" || newNodes != 4 {
+		t.Errorf("expected the old nodes left to be replaced, removed %v, created %d", removed, newNodes)
+	}
+	if desired != 4 || len(instances) != 4 {
+		t.Errorf("expected the desired capacity to be restored, got %d of %v", desired, instances)
+	}
+
+	// the pool can neither grow nor shrink
+	maxSize = 5
+	desired = 5
+	instances = append(instances, "ins-old-4")
+	_ = d.Set("upgrade_settings", []interface{}{map[string]interface{}{"replace_nodes": true, "max_surge": 1}})
+	diags = replaceKubernetesNodePoolNodes(d, meta)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail, "0 of 1 nodes are replaced") {
+		t.Errorf("expected error of the empty batch, got %v", diags)
+	}
+}
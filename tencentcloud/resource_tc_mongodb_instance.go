@@ -34,12 +34,12 @@ import (
 	"log"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mongodb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mongodb/v20190725"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudMongodbInstance() *schema.Resource {
@@ -78,6 +78,9 @@ func resourceTencentCloudMongodbInstance() *schema.Resource {
 		Read:   resourceTencentCloudMongodbInstanceRead,
 		Update: resourceTencentCloudMongodbInstanceUpdate,
 		Delete: resourceTencentCloudMongodbInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -173,8 +176,7 @@ func mongodbCreateInstanceByUse(ctx context.Context, d *schema.ResourceData, met
 
 	var response *mongodb.CreateDBInstanceHourResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	err = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateDBInstanceHour(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -204,8 +206,7 @@ func mongodbCreateInstanceByMonth(ctx context.Context, d *schema.ResourceData, m
 
 	var response *mongodb.CreateDBInstanceResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
+	err = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMongodbClient().CreateDBInstance(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -410,7 +411,7 @@ func resourceTencentCloudMongodbInstanceUpdate(d *schema.ResourceData, meta inte
 		}
 
 		// it will take time to wait for memory and volume change even describe request succeeded even the status returned in describe response is running
-		errUpdate := resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
+		errUpdate := retryOperation(meta, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			infos, has, e := mongodbService.DescribeInstanceById(ctx, instanceId)
 			if e != nil {
 				return resource.NonRetryableError(e)
@@ -459,8 +460,8 @@ func resourceTencentCloudMongodbInstanceUpdate(d *schema.ResourceData, meta inte
 
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldTags, newTags := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("mongodb", "instance", region, instanceId)
@@ -235,7 +235,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func AlarmPolicyRule() map[string]*schema.Schema {
@@ -643,7 +642,6 @@ func resourceTencentMonitorAlarmPolicyCreate(d *schema.ResourceData, meta interf
 	var groupId *string
 	var policyId *string
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := monitorService.client.UseMonitorClient().CreateAlarmPolicy(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -681,7 +679,6 @@ func resourceTencentMonitorAlarmPolicyCreate(d *schema.ResourceData, meta interf
 		request.Tag = tagSet[0]
 
 		if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err := monitorService.client.UseMonitorClient().BindingPolicyTag(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -717,7 +714,6 @@ func resourceTencentMonitorAlarmPolicyRead(d *schema.ResourceData, meta interfac
 	request.Module = helper.String("monitor")
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := monitorService.client.UseMonitorClient().DescribeAlarmPolicy(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -882,7 +878,6 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		request.Value = helper.String(value)
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyInfo(request); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -901,7 +896,6 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		request.Value = helper.String(value)
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyInfo(request); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -920,7 +914,6 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		request.Enable = helper.IntInt64(enable)
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyStatus(request); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -1053,7 +1046,6 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		}
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyCondition(request); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -1077,7 +1069,6 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 		}
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyNotice(request); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -1103,7 +1094,6 @@ func resourceTencentMonitorAlarmPolicyUpdate(d *schema.ResourceData, meta interf
 			request.TriggerTasks = tasks
 		}
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			if _, err := monitorService.client.UseMonitorClient().ModifyAlarmPolicyTasks(request); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -1127,7 +1117,6 @@ func resourceTencentMonitorAlarmPolicyDelete(d *schema.ResourceData, meta interf
 	request.PolicyIds = policyIds
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if _, err := monitorService.client.UseMonitorClient().DeleteAlarmPolicy(request); err != nil {
 			return retryError(err, InternalError)
 		}
@@ -47,7 +47,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudMonitorBindingAlarmReceiver() *schema.Resource {
@@ -179,7 +178,6 @@ func resourceTencentMonitorBindingAlarmReceiverCreate(d *schema.ResourceData, me
 	}
 
 	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().ModifyAlarmReceivers(request); err != nil {
 			return retryError(err, InternalError)
 		}
@@ -285,7 +283,6 @@ func resourceTencentMonitorBindingAlarmReceiverUpdate(d *schema.ResourceData, me
 	}
 
 	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().ModifyAlarmReceivers(request); err != nil {
 			return retryError(err, InternalError)
 		}
@@ -321,7 +318,6 @@ func resourceTencentMonitorBindingAlarmReceiverDelete(d *schema.ResourceData, me
 	request.GroupId = &groupId
 	request.Module = helper.String("monitor")
 	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().ModifyAlarmReceivers(request); err != nil {
 			return retryError(err, InternalError)
 		}
@@ -74,7 +74,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudMonitorPolicyBindingObject() *schema.Resource {
@@ -166,7 +165,6 @@ func resourceTencentMonitorPolicyBindingObjectCreate(d *schema.ResourceData, met
 
 	request.Module = helper.String("monitor")
 	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().BindingPolicyObject(request); err != nil {
 			return retryError(err, InternalError)
 		}
@@ -276,7 +274,6 @@ func resourceTencentMonitorPolicyBindingObjectDelete(d *schema.ResourceData, met
 	request.UniqueId = uniqueIds
 
 	if err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if _, err = monitorService.client.UseMonitorClient().UnBindingPolicyObject(request); err != nil {
 			return retryError(err, InternalError)
 		}
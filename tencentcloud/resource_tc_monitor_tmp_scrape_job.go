@@ -20,7 +20,7 @@ Import
 
 monitor tmpScrapeJob can be imported using the id, e.g.
 ```
-$ terraform import tencentcloud_monitor_tmp_scrape_job.tmpScrapeJob tmpScrapeJob_id
+$ terraform import tencentcloud_monitor_tmp_scrape_job.tmpScrapeJob job_id#instance_id#agent_id
 ```
 */
 package tencentcloud
@@ -29,7 +29,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -37,15 +36,15 @@ import (
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+var monitorTmpScrapeJobId = helper.NewIdSchema("job_id", "instance_id", "agent_id")
+
 func resourceTencentCloudMonitorTmpScrapeJob() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorTmpScrapeJobRead,
-		Create: resourceTencentCloudMonitorTmpScrapeJobCreate,
-		Update: resourceTencentCloudMonitorTmpScrapeJobUpdate,
-		Delete: resourceTencentCloudMonitorTmpScrapeJobDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudMonitorTmpScrapeJobRead,
+		Create:   resourceTencentCloudMonitorTmpScrapeJobCreate,
+		Update:   resourceTencentCloudMonitorTmpScrapeJobUpdate,
+		Delete:   resourceTencentCloudMonitorTmpScrapeJobDelete,
+		Importer: monitorTmpScrapeJobId.Importer(nil),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -115,7 +114,7 @@ func resourceTencentCloudMonitorTmpScrapeJobCreate(d *schema.ResourceData, meta
 
 	tmpScrapeJobId := *response.Response.JobId
 
-	d.SetId(strings.Join([]string{tmpScrapeJobId, instanceId, agentId}, FILED_SP))
+	d.SetId(monitorTmpScrapeJobId.Format(tmpScrapeJobId, instanceId, agentId))
 
 	return resourceTencentCloudMonitorTmpScrapeJobRead(d, meta)
 }
@@ -130,6 +129,10 @@ func resourceTencentCloudMonitorTmpScrapeJobRead(d *schema.ResourceData, meta in
 	service := MonitorService{client: meta.(*TencentCloudClient).apiV3Conn}
 
 	tmpScrapeJobId := d.Id()
+	ids, err := monitorTmpScrapeJobId.Parse(tmpScrapeJobId)
+	if err != nil {
+		return err
+	}
 
 	tmpScrapeJob, err := service.DescribeMonitorTmpScrapeJob(ctx, tmpScrapeJobId)
 
@@ -142,7 +145,7 @@ func resourceTencentCloudMonitorTmpScrapeJobRead(d *schema.ResourceData, meta in
 		return fmt.Errorf("resource `tmpScrapeJob` %s does not exist", tmpScrapeJobId)
 	}
 
-	_ = d.Set("instance_id", strings.Split(tmpScrapeJobId, FILED_SP)[1])
+	_ = d.Set("instance_id", ids[1])
 	if tmpScrapeJob.AgentId != nil {
 		_ = d.Set("agent_id", tmpScrapeJob.AgentId)
 	}
@@ -162,7 +165,10 @@ func resourceTencentCloudMonitorTmpScrapeJobUpdate(d *schema.ResourceData, meta
 
 	request := monitor.NewUpdatePrometheusScrapeJobRequest()
 
-	ids := strings.Split(d.Id(), FILED_SP)
+	ids, err := monitorTmpScrapeJobId.Parse(d.Id())
+	if err != nil {
+		return err
+	}
 
 	request.JobId = &ids[0]
 	request.InstanceId = &ids[1]
@@ -182,7 +188,7 @@ func resourceTencentCloudMonitorTmpScrapeJobUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 		result, e := meta.(*TencentCloudClient).apiV3Conn.UseMonitorClient().UpdatePrometheusScrapeJob(request)
 		if e != nil {
 			return retryError(e)
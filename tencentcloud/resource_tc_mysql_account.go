@@ -27,22 +27,23 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// mysqlAccountId is the id of mysql account, the host is omitted if it is the default `%`
+var mysqlAccountId = helper.NewIdSchema("mysql_id", "account_name").WithOptional("host")
+
 func resourceTencentCloudMysqlAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlAccountCreate,
-		Read:   resourceTencentCloudMysqlAccountRead,
-		Update: resourceTencentCloudMysqlAccountUpdate,
-		Delete: resourceTencentCloudMysqlAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudMysqlAccountCreate,
+		Read:     resourceTencentCloudMysqlAccountRead,
+		Update:   resourceTencentCloudMysqlAccountUpdate,
+		Delete:   resourceTencentCloudMysqlAccountDelete,
+		Importer: mysqlAccountId.Importer(nil),
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
 				Type:        schema.TypeString,
@@ -127,10 +128,10 @@ func resourceTencentCloudMysqlAccountCreate(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	resourceId := fmt.Sprintf("%s%s%s", mysqlId, FILED_SP, accountName)
+	resourceId := mysqlAccountId.Format(mysqlId, accountName)
 
 	if accountHost != MYSQL_DEFAULT_ACCOUNT_HOST {
-		resourceId += FILED_SP + accountHost
+		resourceId = mysqlAccountId.Format(mysqlId, accountName, accountHost)
 	}
 
 	d.SetId(resourceId)
@@ -147,7 +148,10 @@ func resourceTencentCloudMysqlAccountRead(d *schema.ResourceData, meta interface
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items, err := mysqlAccountId.Parse(d.Id())
+	if err != nil {
+		return err
+	}
 
 	var (
 		mysqlId                      = items[0]
@@ -156,12 +160,12 @@ func resourceTencentCloudMysqlAccountRead(d *schema.ResourceData, meta interface
 		accountInfo *cdb.AccountInfo = nil
 	)
 
-	if len(items) == 3 {
+	if items[2] != "" {
 		accountHost = items[2]
 	}
 
 	var onlineHas = true
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
 		allAccounts, e := mysqlService.DescribeAccounts(ctx, mysqlId)
 		if e != nil {
 			if mysqlService.NotFoundMysqlInstance(e) {
@@ -210,7 +214,10 @@ func resourceTencentCloudMysqlAccountUpdate(d *schema.ResourceData, meta interfa
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items, err := mysqlAccountId.Parse(d.Id())
+	if err != nil {
+		return err
+	}
 
 	var (
 		mysqlId     = items[0]
@@ -218,7 +225,7 @@ func resourceTencentCloudMysqlAccountUpdate(d *schema.ResourceData, meta interfa
 		accountHost = MYSQL_DEFAULT_ACCOUNT_HOST
 	)
 
-	if len(items) == 3 {
+	if items[2] != "" {
 		accountHost = items[2]
 	}
 
@@ -364,14 +371,17 @@ func resourceTencentCloudMysqlAccountDelete(d *schema.ResourceData, meta interfa
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	items := strings.Split(d.Id(), FILED_SP)
+	items, err := mysqlAccountId.Parse(d.Id())
+	if err != nil {
+		return err
+	}
 
 	var (
 		mysqlId     = items[0]
 		accountName = items[1]
 		accountHost = MYSQL_DEFAULT_ACCOUNT_HOST
 	)
-	if len(items) == 3 {
+	if items[2] != "" {
 		accountHost = items[2]
 	}
 
@@ -51,8 +51,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
@@ -335,6 +337,17 @@ func resourceTencentCloudMysqlInstance() *schema.Resource {
 		Read:   resourceTencentCloudMysqlInstanceRead,
 		Update: resourceTencentCloudMysqlInstanceUpdate,
 		Delete: resourceTencentCloudMysqlInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(6 * time.Hour),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		CustomizeDiff: customdiff.All(
+			customDiffOnCreate(
+				customDiffRequiredTogether("vpc_id", "subnet_id"),
+			),
+			mysqlInstanceSpecCustomizeDiff,
+		),
 		Schema: specialInfo,
 		Importer: &schema.ResourceImporter{
 			State: helper.ImportWithDefaultValue(map[string]interface{}{
@@ -589,7 +602,7 @@ func mysqlCreateInstancePayByMonth(ctx context.Context, d *schema.ResourceData,
 	}
 
 	var response *cdb.CreateDBInstanceResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 		// shadowed response will not pass to outside
 		r, inErr := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBInstance(request)
 		if inErr != nil {
@@ -635,7 +648,7 @@ func mysqlCreateInstancePayByUse(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	var response *cdb.CreateDBInstanceHourResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 		// shadowed response will not pass to outside
 		r, inErr := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().CreateDBInstanceHour(request)
 		if inErr != nil {
@@ -667,6 +680,73 @@ func mysqlCreateInstancePayByUse(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
+// mysqlInstanceSpecCustomizeDiff checks `cpu`, `mem_size` and `volume_size` match one of the sell configs of the region.
+// The sell configs are only described when the spec is to be created or changed, and the spec is known.
+func mysqlInstanceSpecCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("mem_size", "cpu", "volume_size", "device_type") {
+		return nil
+	}
+	if !d.NewValueKnown("mem_size") || !d.NewValueKnown("volume_size") {
+		return nil
+	}
+	// skip `cpu` and `device_type` set to the unknown values, they are only unknown until created if not set
+	for _, key := range []string{"cpu", "device_type"} {
+		if !d.NewValueKnown(key) && !diffUnsetInConfig(d, key) {
+			return nil
+		}
+	}
+	logId := getLogId(contextNil)
+	ctx = context.WithValue(ctx, logIdKey, logId)
+	var (
+		memSize    = int64(d.Get("mem_size").(int))
+		volumeSize = int64(d.Get("volume_size").(int))
+		cpu        int64
+		deviceType string
+	)
+	// `cpu` and `device_type` are unknown until created if they are not set
+	if d.NewValueKnown("cpu") {
+		cpu = int64(d.Get("cpu").(int))
+	}
+	if d.NewValueKnown("device_type") {
+		deviceType = d.Get("device_type").(string)
+	}
+
+	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
+	sellConfigures, err := mysqlService.DescribeDBZoneConfig(ctx)
+	if err != nil || sellConfigures == nil {
+		// the API checks the spec on apply anyway
+		log.Printf("[WARN]%s check mysql spec skipped, reason:%v", logId, err)
+		return nil
+	}
+
+	var cpus []string
+	var volumeRange string
+	for _, config := range sellConfigures.Configs {
+		if config.Memory == nil || *config.Memory != memSize || config.Cpu == nil {
+			continue
+		}
+		if deviceType != "" && config.DeviceType != nil && *config.DeviceType != deviceType {
+			continue
+		}
+		cpus = append(cpus, fmt.Sprintf("%d", *config.Cpu))
+		if cpu != 0 && *config.Cpu != cpu {
+			continue
+		}
+		if config.VolumeMin == nil || config.VolumeMax == nil ||
+			(volumeSize >= *config.VolumeMin && volumeSize <= *config.VolumeMax) {
+			return nil
+		}
+		volumeRange = fmt.Sprintf("[%d, %d]", *config.VolumeMin, *config.VolumeMax)
+	}
+	if len(cpus) == 0 {
+		return fmt.Errorf("`mem_size` %d is not supported, see the data source `tencentcloud_mysql_zone_config` for the supported specs", memSize)
+	}
+	if volumeRange == "" {
+		return fmt.Errorf("`cpu` %d does not match `mem_size` %d, the supported `cpu` are %s", cpu, memSize, strings.Join(cpus, ", "))
+	}
+	return fmt.Errorf("`volume_size` %d is out of the range %s of `mem_size` %d", volumeSize, volumeRange, memSize)
+}
+
 func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_mysql_instance.create")()
 
@@ -702,7 +782,7 @@ func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	err := resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err := retryOperation(meta, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		mysqlInfo, err := mysqlService.DescribeDBInstanceById(ctx, mysqlID)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -733,7 +813,7 @@ func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interf
 		if err != nil {
 			return err
 		}
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
 			if err != nil {
 				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
@@ -869,7 +949,7 @@ func resourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interfac
 	var mysqlInfo *cdb.InstanceInfo
 	var e error
 	var onlineHas = true
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 		mysqlInfo, e = tencentMsyqlBasicInfoRead(ctx, d, meta, true)
 		if e != nil {
 			if mysqlService.NotFoundMysqlInstance(e) {
@@ -912,7 +992,7 @@ func resourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interfac
 			cares = append(cares, k)
 		}
 
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 			caresParameters, e := mysqlService.DescribeCaresParameters(ctx, d.Id(), cares)
 			if e != nil {
 				if mysqlService.NotFoundMysqlInstance(e) {
@@ -936,7 +1016,7 @@ func resourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interfac
 			return nil
 		}
 	}
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 		backConfig, e := mysqlService.DescribeDBInstanceConfig(ctx, d.Id())
 		if e != nil {
 			if mysqlService.NotFoundMysqlInstance(e) {
@@ -1035,7 +1115,7 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 			return err
 		}
 
-		err = resource.Retry(6*time.Hour, func() *resource.RetryError {
+		err = retryOperation(meta, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
 
 			if err != nil {
@@ -1113,7 +1193,7 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 			return err
 		}
 
-		err = resource.Retry(6*time.Hour, func() *resource.RetryError {
+		err = retryOperation(meta, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
 
 			if err != nil {
@@ -1141,9 +1221,9 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
@@ -1238,7 +1318,7 @@ func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 				log.Printf("[CRITAL]%s update mysql %s fail, reason:%s\n ", logId, tag, err.Error())
 				return err
 			}
-			err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+			err = retryOperation(meta, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
 				if err != nil {
 					if _, ok := err.(*errors.TencentCloudSDKError); !ok {
@@ -1283,7 +1363,7 @@ func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 			log.Printf("[CRITAL]%s update mysql %s fail, reason:%s\n ", logId, tag, err.Error())
 			return err
 		}
-		err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
 			if err != nil {
 				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
@@ -1322,7 +1402,7 @@ func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 			return err
 		}
 
-		err = resource.Retry(10*readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
 			if err != nil {
 				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
@@ -1419,7 +1499,7 @@ func resourceTencentCloudMysqlInstanceDelete(d *schema.ResourceData, meta interf
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 		_, err := mysqlService.IsolateDBInstance(ctx, d.Id())
 		if err != nil {
 			//for the pay order wait
@@ -1436,7 +1516,7 @@ func resourceTencentCloudMysqlInstanceDelete(d *schema.ResourceData, meta interf
 
 	payType := getPayType(d).(int)
 	forceDelete := d.Get("force_delete").(bool)
-	err = resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		mysqlInfo, err := mysqlService.DescribeDBInstanceById(ctx, d.Id())
 
 		if err != nil {
@@ -1475,7 +1555,7 @@ func resourceTencentCloudMysqlInstanceDelete(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	err = resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		mysqlInfo, err := mysqlService.DescribeIsolatedDBInstanceById(ctx, d.Id())
 		if err != nil {
 			if _, ok := err.(*errors.TencentCloudSDKError); !ok {
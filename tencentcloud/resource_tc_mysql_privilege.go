@@ -71,7 +71,6 @@ import (
 	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
 	sdkError "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type resourceTencentCloudMysqlPrivilegeId struct {
@@ -355,7 +354,6 @@ func (me *resourceTencentCloudMysqlPrivilegeId) update(ctx context.Context, d *s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().ModifyAccountPrivileges(request)
 	if err != nil {
 		return err
@@ -477,7 +475,6 @@ func resourceTencentCloudMysqlPrivilegeRead(d *schema.ResourceData, meta interfa
 
 	var response *cdb.DescribeAccountPrivilegesResponse
 	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = meta.(*TencentCloudClient).apiV3Conn.UseMysqlClient().DescribeAccountPrivileges(request)
 		if err != nil {
 			if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
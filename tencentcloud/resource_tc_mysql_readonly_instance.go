@@ -35,6 +35,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -75,6 +76,11 @@ func resourceTencentCloudMysqlReadonlyInstance() *schema.Resource {
 		Read:   resourceTencentCloudMysqlReadonlyInstanceRead,
 		Update: resourceTencentCloudMysqlReadonlyInstanceUpdate,
 		Delete: resourceTencentCloudMysqlReadonlyInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(6 * time.Hour),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Importer: &schema.ResourceImporter{
 			State: helper.ImportWithDefaultValue(map[string]interface{}{
@@ -223,7 +229,7 @@ func resourceTencentCloudMysqlReadonlyInstanceCreate(d *schema.ResourceData, met
 	// the mysql master instance must have a backup before creating a read-only instance
 	masterInstanceId := d.Get("master_instance_id").(string)
 
-	err := resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+	err := retryOperation(meta, 2*readRetryTimeout, func() *resource.RetryError {
 		backups, err := mysqlService.DescribeBackupsByMysqlId(ctx, masterInstanceId, 10)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -255,7 +261,7 @@ func resourceTencentCloudMysqlReadonlyInstanceCreate(d *schema.ResourceData, met
 
 	mysqlID := d.Id()
 
-	err = resource.Retry(4*readRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		mysqlInfo, err := mysqlService.DescribeDBInstanceById(ctx, mysqlID)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -299,7 +305,7 @@ func resourceTencentCloudMysqlReadonlyInstanceRead(d *schema.ResourceData, meta
 	logId := getLogId(contextNil)
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 		mysqlInfo, e := tencentMsyqlBasicInfoRead(ctx, d, meta, false)
 		if e != nil {
 			if mysqlService.NotFoundMysqlInstance(e) {
@@ -448,7 +454,7 @@ func resourceTencentCloudMysqlReadonlyInstanceDelete(d *schema.ResourceData, met
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
 	mysqlService := MysqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 		_, err := mysqlService.IsolateDBInstance(ctx, d.Id())
 		if err != nil {
 			//for the pay order wait
@@ -465,7 +471,7 @@ func resourceTencentCloudMysqlReadonlyInstanceDelete(d *schema.ResourceData, met
 	payType := getPayType(d).(int)
 	forceDelete := d.Get("force_delete").(bool)
 
-	err = resource.Retry(7*readRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		mysqlInfo, err := mysqlService.DescribeDBInstanceById(ctx, d.Id())
 
 		if err != nil {
@@ -128,15 +128,15 @@ import (
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+var natGatewaySnatId = helper.NewIdSchema("nat_gateway_id", "resource_id")
+
 func resourceTencentCloudNatGatewaySnat() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudNatGatewaySnatCreate,
-		Read:   resourceTencentCloudNatGatewaySnatRead,
-		Update: resourceTencentCloudNatGatewaySnatUpdate,
-		Delete: resourceTencentCloudNatGatewaySnatDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudNatGatewaySnatCreate,
+		Read:     resourceTencentCloudNatGatewaySnatRead,
+		Update:   resourceTencentCloudNatGatewaySnatUpdate,
+		Delete:   resourceTencentCloudNatGatewaySnatDelete,
+		Importer: natGatewaySnatId.Importer(nil),
 
 		Schema: NatGatewaySnatPara(),
 	}
@@ -245,7 +245,7 @@ func resourceTencentCloudNatGatewaySnatCreate(d *schema.ResourceData, meta inter
 		return errors.New("[CRITAL] create nat gateway snat failed: read result is empty")
 	}
 	rule := result[len(result)-1]
-	d.SetId(helper.IdFormat(*rule.NatGatewayId, *rule.ResourceId))
+	d.SetId(natGatewaySnatId.Format(*rule.NatGatewayId, *rule.ResourceId))
 
 	return resourceTencentCloudNatGatewaySnatRead(d, meta)
 }
@@ -260,9 +260,9 @@ func resourceTencentCloudNatGatewaySnatRead(d *schema.ResourceData, meta interfa
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id      = d.Id()
 	)
-	compositeId := helper.IdParse(id)
-	if len(compositeId) != 2 {
-		return errors.New("the id format must be '{nat_gateway_id}#{resource_id}'")
+	compositeId, err := natGatewaySnatId.Parse(id)
+	if err != nil {
+		return err
 	}
 
 	err, snatList := service.DescribeNatGatewaySnats(ctx, compositeId[0], nil)
@@ -306,13 +306,13 @@ func resourceTencentCloudNatGatewaySnatUpdate(d *schema.ResourceData, meta inter
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id      = d.Id()
 	)
-	compositeId := helper.IdParse(id)
-	if len(compositeId) != 2 {
-		return errors.New("the id format must be '{nat_gateway_id}#{snat_id}'")
+	compositeId, err := natGatewaySnatId.Parse(id)
+	if err != nil {
+		return err
 	}
 
 	// param valid
-	err := paramValid(d)
+	err = paramValid(d)
 	if err != nil {
 		return err
 	}
@@ -198,6 +198,10 @@ func resourceTencentCloudPostgresqlInstance() *schema.Resource {
 		Read:   resourceTencentCloudPostgresqlInstanceRead,
 		Update: resourceTencentCloudPostgresqlInstanceUpdate,
 		Delete: resourceTencentCLoudPostgresqlInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -556,7 +560,7 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 	}
 
 	// get specCode with engine_version and memory
-	outErr = resource.Retry(readRetryTimeout*5, func() *resource.RetryError {
+	outErr = retryOperation(meta, readRetryTimeout*5, func() *resource.RetryError {
 		speccodes, inErr := postgresqlService.DescribeSpecinfos(ctx, zone)
 		if inErr != nil {
 			return retryError(inErr)
@@ -613,7 +617,7 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 		}
 	}
 
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 		instanceId, inErr = postgresqlService.CreatePostgresqlInstance(ctx,
 			name,
 			dbVersion,
@@ -651,7 +655,7 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 	d.SetId(instanceId)
 
 	// check creation done
-	err := resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
+	err := retryOperation(meta, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, has, err := postgresqlService.DescribePostgresqlInstanceById(ctx, instanceId)
 		if err != nil {
 			return retryError(err)
@@ -684,7 +688,7 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 	}
 
 	if public_access_switch {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			inErr = postgresqlService.ModifyPublicService(ctx, true, instanceId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -702,7 +706,7 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 		return checkErr
 	}
 	// set name
-	outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	outErr = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 		inErr := postgresqlService.ModifyPostgresqlInstanceName(ctx, instanceId, name)
 		if inErr != nil {
 			return retryError(inErr)
@@ -738,7 +742,7 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 	}
 
 	if len(paramEntrys) != 0 {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			inErr := postgresqlService.ModifyPgParams(ctx, instanceId, paramEntrys)
 			if inErr != nil {
 				return retryError(inErr)
@@ -769,7 +773,7 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 		if v, ok := plan["backup_period"].([]interface{}); ok && len(v) > 0 {
 			request.BackupPeriod = helper.InterfacesStringsPoint(v)
 		}
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			err := postgresqlService.ModifyBackupPlan(ctx, request)
 			if err != nil {
 				return retryError(err, postgresql.OPERATIONDENIED_INSTANCESTATUSLIMITOPERROR)
@@ -857,7 +861,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		request.AutoRenewFlag = helper.IntInt64(autoRenew)
 		request.AutoVoucher = helper.IntInt64(autoVoucher)
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().ModifyDBInstanceChargeType(request)
 			if e != nil {
 				return retryError(e)
@@ -873,7 +877,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 
 		// wait unit charge type changing operation of instance done
 		service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-		conf := BuildStateChangeConf([]string{}, []string{"running"}, 2*readRetryTimeout, time.Second, service.PostgresqlDBInstanceStateRefreshFunc(instanceId, []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"running"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.PostgresqlDBInstanceStateRefreshFunc(instanceId, []string{}))
 		if _, e := conf.WaitForState(); e != nil {
 			return e
 		}
@@ -916,7 +920,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		// ip assigned by system
 		request.IsAssignVip = helper.Bool(false)
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().CreateDBInstanceNetworkAccess(request)
 			if e != nil {
 				return retryError(e)
@@ -932,7 +936,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 
 		service := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
 		// wait for new network enabled
-		conf := BuildStateChangeConf([]string{}, []string{"opened"}, 3*readRetryTimeout, time.Second, service.PostgresqlDBInstanceNetworkAccessStateRefreshFunc(instanceId, vpcNew, subnetNew, vipOld, "", []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"opened"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.PostgresqlDBInstanceNetworkAccessStateRefreshFunc(instanceId, vpcNew, subnetNew, vipOld, "", []string{}))
 		if object, e := conf.WaitForState(); e != nil {
 			return e
 		} else {
@@ -942,7 +946,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		}
 
 		// wait unit network changing operation of instance done
-		conf = BuildStateChangeConf([]string{}, []string{"running"}, 3*readRetryTimeout, time.Second, service.PostgresqlDBInstanceStateRefreshFunc(instanceId, []string{}))
+		conf = BuildStateChangeConf([]string{}, []string{"running"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.PostgresqlDBInstanceStateRefreshFunc(instanceId, []string{}))
 		if _, e := conf.WaitForState(); e != nil {
 			return e
 		}
@@ -956,13 +960,13 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		}
 
 		// wait for old network removed
-		conf = BuildStateChangeConf([]string{}, []string{"closed"}, 3*readRetryTimeout, time.Second, service.PostgresqlDBInstanceNetworkAccessStateRefreshFunc(instanceId, vpcOld, subnetOld, vipNew, vipOld, []string{}))
+		conf = BuildStateChangeConf([]string{}, []string{"closed"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.PostgresqlDBInstanceNetworkAccessStateRefreshFunc(instanceId, vpcOld, subnetOld, vipNew, vipOld, []string{}))
 		if _, e := conf.WaitForState(); e != nil {
 			return e
 		}
 
 		// wait unit network changing operation of instance done
-		conf = BuildStateChangeConf([]string{}, []string{"running"}, 3*readRetryTimeout, time.Second, service.PostgresqlDBInstanceStateRefreshFunc(instanceId, []string{}))
+		conf = BuildStateChangeConf([]string{}, []string{"running"}, d.Timeout(schema.TimeoutUpdate), time.Second, service.PostgresqlDBInstanceStateRefreshFunc(instanceId, []string{}))
 		if _, e := conf.WaitForState(); e != nil {
 			return e
 		}
@@ -974,7 +978,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 	// update name
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			inErr = postgresqlService.ModifyPostgresqlInstanceName(ctx, instanceId, name)
 			if inErr != nil {
 				return retryError(inErr)
@@ -996,7 +1000,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 	if d.HasChange("memory") || d.HasChange("storage") {
 		memory := d.Get("memory").(int)
 		storage := d.Get("storage").(int)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			inErr = postgresqlService.UpgradePostgresqlInstance(ctx, instanceId, memory, storage)
 			if inErr != nil {
 				return retryError(inErr)
@@ -1007,7 +1011,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 			return outErr
 		}
 		// Wait for status to processing
-		_ = resource.Retry(time.Second*10, func() *resource.RetryError {
+		_ = retryOperation(meta, time.Second*10, func() *resource.RetryError {
 			instance, _, err := postgresqlService.DescribePostgresqlInstanceById(ctx, instanceId)
 			if err != nil {
 				return retryError(err)
@@ -1029,7 +1033,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 	// update project id
 	if d.HasChange("project_id") {
 		projectId := d.Get("project_id").(int)
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			inErr = postgresqlService.ModifyPostgresqlInstanceProjectId(ctx, instanceId, projectId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -1054,7 +1058,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		if v, ok := d.GetOkExists("public_access_switch"); ok {
 			public_access_switch = v.(bool)
 		}
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			inErr = postgresqlService.ModifyPublicService(ctx, public_access_switch, instanceId)
 			if inErr != nil {
 				return retryError(inErr)
@@ -1075,7 +1079,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 	// update root password
 	if d.HasChange("root_password") {
 		// to avoid other updating process conflicts with updating password, set the password updating with the last step, there is no way to figure out whether changing password is done
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			inErr = postgresqlService.SetPostgresqlInstanceRootPassword(ctx, instanceId, d.Get("root_password").(string))
 			if inErr != nil {
 				return retryError(inErr)
@@ -1154,7 +1158,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 			})
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			if err := postgresqlService.ModifyDBInstanceDeployment(ctx, request); err != nil {
 				return retryError(err, postgresql.OPERATIONDENIED_INSTANCESTATUSLIMITOPERROR)
 			}
@@ -1165,7 +1169,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 			return err
 		}
 
-		err = resource.Retry(readRetryTimeout*10, func() *resource.RetryError {
+		err = retryOperation(meta, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			instance, _, err := postgresqlService.DescribePostgresqlInstanceById(ctx, d.Id())
 			if err != nil {
 				return retryError(err)
@@ -1199,7 +1203,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		switchTag := POSTGRESQL_KERNEL_UPGRADE_IMMEDIATELY
 		upgradeRequest.SwitchTag = helper.IntUint64(switchTag)
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			result, e := meta.(*TencentCloudClient).apiV3Conn.UsePostgresqlClient().UpgradeDBInstanceKernelVersion(upgradeRequest)
 			if e != nil {
 				tcErr := e.(*sdkErrors.TencentCloudSDKError)
@@ -1222,7 +1226,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 
 		// only wait for immediately upgrade mode
 
-		conf := BuildStateChangeConf([]string{}, []string{"running", "isolated", "offline"}, 10*readRetryTimeout, time.Second, postgresqlService.PostgresqlUpgradeKernelVersionRefreshFunc(d.Id(), []string{}))
+		conf := BuildStateChangeConf([]string{}, []string{"running", "isolated", "offline"}, d.Timeout(schema.TimeoutUpdate), time.Second, postgresqlService.PostgresqlUpgradeKernelVersionRefreshFunc(d.Id(), []string{}))
 
 		if _, e := conf.WaitForState(); e != nil {
 			return e
@@ -1230,9 +1234,9 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
@@ -1263,7 +1267,7 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 		return fmt.Errorf("Not support change params contact with data transparent encryption.")
 	}
 	if len(paramEntrys) != 0 {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			inErr := postgresqlService.ModifyPgParams(ctx, instanceId, paramEntrys)
 			if inErr != nil {
 				return retryError(inErr)
@@ -1296,7 +1300,7 @@ func resourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta int
 	)
 	// Check if import
 	postgresqlService := PostgresqlService{client: meta.(*TencentCloudClient).apiV3Conn}
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 		instance, has, inErr = postgresqlService.DescribePostgresqlInstanceById(ctx, d.Id())
 		if inErr != nil {
 			ee, ok := inErr.(*sdkErrors.TencentCloudSDKError)
@@ -1508,7 +1512,7 @@ func resourceTencentCloudPostgresqlInstanceRead(d *schema.ResourceData, meta int
 
 	// pg params
 	var parmas map[string]string
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 		parmas, inErr = postgresqlService.DescribePgParams(ctx, d.Id())
 		if inErr != nil {
 			ee, ok := inErr.(*sdkErrors.TencentCloudSDKError)
@@ -1548,7 +1552,7 @@ func resourceTencentCLoudPostgresqlInstanceDelete(d *schema.ResourceData, meta i
 	var outErr, inErr error
 	var has bool
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 		_, has, inErr = postgresqlService.DescribePostgresqlInstanceById(ctx, d.Id())
 		if inErr != nil {
 			// ResourceNotFound.InstanceNotFoundError
@@ -1576,7 +1580,7 @@ func resourceTencentCLoudPostgresqlInstanceDelete(d *schema.ResourceData, meta i
 
 	outErr = postgresqlService.DeletePostgresqlInstance(ctx, instanceId)
 	if outErr != nil {
-		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		outErr = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			inErr = postgresqlService.DeletePostgresqlInstance(ctx, instanceId)
 			if inErr != nil {
 				// ResourceNotFound.InstanceNotFoundError
@@ -1594,7 +1598,7 @@ func resourceTencentCLoudPostgresqlInstanceDelete(d *schema.ResourceData, meta i
 		return outErr
 	}
 
-	outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	outErr = retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 		_, has, inErr = postgresqlService.DescribePostgresqlInstanceById(ctx, d.Id())
 		if inErr != nil {
 			// ResourceNotFound.InstanceNotFoundError
@@ -573,9 +573,9 @@ func resourceTencentCloudPostgresqlReadOnlyInstanceUpdate(d *schema.ResourceData
 
 	}
 
-	//if d.HasChange("tags") {
+	//if d.HasChanges("tags", "tags_all") {
 	//
-	//	oldValue, newValue := d.GetChange("tags")
+	//	oldValue, newValue := getTagsChange(d, meta)
 	//	replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 	//
 	//	tcClient := meta.(*TencentCloudClient).apiV3Conn
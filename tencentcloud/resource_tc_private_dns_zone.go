@@ -6,7 +6,7 @@ Example Usage
 ```hcl
 resource "tencentcloud_private_dns_zone" "foo" {
   domain = "domain.com"
-  tags {
+  tags = {
     "created_by" : "terraform"
   }
   vpc_set {
@@ -398,8 +398,8 @@ func resourceTencentCloudDPrivateDnsZoneUpdate(d *schema.ResourceData, meta inte
 	tagService := TagService{client: client}
 	region := client.Region
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldTags, newTags := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("privatedns", "zone", region, id)
@@ -241,7 +241,9 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
@@ -262,6 +264,22 @@ func resourceTencentCloudRedisInstance() *schema.Resource {
 		Read:   resourceTencentCloudRedisInstanceRead,
 		Update: resourceTencentCloudRedisInstanceUpdate,
 		Delete: resourceTencentCloudRedisInstanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+		CustomizeDiff: customdiff.All(
+			customDiffOnCreate(
+				customDiffRequiredWhen("charge_type", []string{REDIS_CHARGE_TYPE_PREPAID}, "prepaid_period"),
+				customDiffExactlyOneOf("type_id", "type"),
+				customDiffRequiredWhen("no_auth", []string{"false"}, "password"),
+				customDiffRequiredWhen("no_auth", []string{"true"}, "vpc_id", "subnet_id"),
+			),
+			customDiffAllowedWhen("charge_type", []string{REDIS_CHARGE_TYPE_PREPAID}, "prepaid_period"),
+			customDiffImmutable("prepaid_period"),
+			redisShardNumCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -284,6 +302,10 @@ func resourceTencentCloudRedisInstance() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validateIntegerMin(2),
+				DiffSuppressFunc: func(k, olds, news string, d *schema.ResourceData) bool {
+					// the instance is configured with the deprecated `type`
+					return (news == "" || news == "0") && d.Get("type").(string) != ""
+				},
 				Description:  "Instance type. Available values reference data source `tencentcloud_redis_zone_config` or [document](https://intl.cloud.tencent.com/document/product/239/32069), toggle immediately when modified.",
 			},
 			"redis_shard_num": {
@@ -319,6 +341,10 @@ func resourceTencentCloudRedisInstance() *schema.Resource {
 					errors = append(errors, fmt.Errorf("this redis type %s not support now", value))
 					return
 				},
+				DiffSuppressFunc: func(k, olds, news string, d *schema.ResourceData) bool {
+					// the instance is configured with `type_id`
+					return news == "" && d.Get("type_id").(int) != 0
+				},
 				Deprecated:  "It has been deprecated from version 1.33.1. Please use 'type_id' instead.",
 				Description: "Instance type. Available values: " + typeStr + ", specific region support specific types, need to refer data `tencentcloud_redis_zone_config`.",
 			},
@@ -480,6 +506,32 @@ func resourceTencentCloudRedisInstance() *schema.Resource {
 	}
 }
 
+// redisShardNumCustomizeDiff checks `redis_shard_num` is set only for the cluster types
+func redisShardNumCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("redis_shard_num") || !d.NewValueKnown("type_id") || !d.NewValueKnown("type") {
+		return nil
+	}
+	shardNum := d.Get("redis_shard_num").(int)
+	if shardNum <= 1 {
+		return nil
+	}
+	// both are read from the instance, the deprecated `type` is used when it is the one configured
+	typeId := int64(d.Get("type_id").(int))
+	if typeName := d.Get("type").(string); typeName != "" && (typeId == 0 || d.HasChange("type")) {
+		for id, name := range REDIS_NAMES {
+			if name == typeName {
+				typeId = id
+			}
+		}
+	}
+	for _, id := range REDIS_NON_CLUSTER_TYPE_IDS {
+		if typeId == id {
+			return fmt.Errorf("`redis_shard_num` can only be set for the cluster types, `type_id` %d is not a cluster type", typeId)
+		}
+	}
+	return nil
+}
+
 func resourceTencentCloudRedisInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_redis_instance.create")()
 
@@ -669,7 +721,7 @@ func resourceTencentCloudRedisInstanceCreate(d *schema.ResourceData, meta interf
 		return fmt.Errorf("redis api CreateInstances return empty redis id")
 	}
 	var redisId = *instanceIds[0]
-	_, _, _, err = redisService.CheckRedisOnlineOk(ctx, redisId, 20*readRetryTimeout)
+	_, _, _, err = redisService.CheckRedisOnlineOk(ctx, redisId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create redis task fail, reason:%s\n", logId, err.Error())
@@ -701,7 +753,7 @@ func resourceTencentCloudRedisInstanceRead(d *schema.ResourceData, meta interfac
 		info *redis.InstanceSet
 		e    error
 	)
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryOperation(meta, readRetryTimeout, func() *resource.RetryError {
 		has, _, info, e = service.CheckRedisOnlineOk(ctx, d.Id(), readRetryTimeout*20)
 		if info != nil {
 			if *info.Status == REDIS_STATUS_ISOLATE || *info.Status == REDIS_STATUS_TODELETE {
@@ -741,19 +793,10 @@ func resourceTencentCloudRedisInstanceRead(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return err
 	}
-	// not set field type_id
-	// process import case
-	if d.Get("type_id").(int) == 0 && d.Get("type").(string) != "" {
-		typeName := REDIS_NAMES[*info.Type]
-		if typeName == "" {
-			err = fmt.Errorf("redis read unkwnow type %d", *info.Type)
-			log.Printf("[CRITAL]%s redis read type name error, reason:%s\n", logId, err.Error())
-			return err
-		}
-		_ = d.Set("type", typeName)
-	} else {
-		_ = d.Set("type_id", info.Type)
-	}
+	// both `type_id` and the deprecated `type` are read, so `type` can be replaced with `type_id` in the config, the
+	// one not configured is suppressed in the diff. The types unknown by `type` leave it empty.
+	_ = d.Set("type_id", info.Type)
+	_ = d.Set("type", REDIS_NAMES[*info.Type])
 
 	_ = d.Set("redis_shard_num", info.RedisShardNum)
 	_ = d.Set("redis_replicas_num", info.RedisReplicasNum)
@@ -883,7 +926,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 			return fmt.Errorf("redis mem_size value cannot be set to less than 1")
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			_, err := redisService.UpgradeInstance(ctx, id, newMemSize, redisShardNum, redisReplicasNum, nil)
 			if err != nil {
 				// Upgrade memory will cause instance lock and cannot acknowledge by polling status, wait until lock release
@@ -911,7 +954,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		oReplica, _ := d.GetChange("redis_replicas_num")
 		redisReplicasNum := oReplica.(int)
 		memSize := d.Get("mem_size").(int)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			_, err := redisService.UpgradeInstance(ctx, id, memSize, redisShardNum, redisReplicasNum, nil)
 			if err != nil {
 				// Upgrade memory will cause instance lock and cannot acknowledge by polling status, wait until lock release
@@ -949,7 +992,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		)
 
 		// After redis spec modified, reset password may not successfully response immediately.
-		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			taskId, err = redisService.ResetPassword(ctx, id, password, noAuth)
 			if err != nil {
 				log.Printf("[CRITAL]%s redis change password error, reason:%s\n", logId, err.Error())
@@ -962,7 +1005,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 			return err
 		}
 
-		err = resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
+		err = retryOperation(meta, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			ok, err := redisService.DescribeTaskInfo(ctx, id, taskId)
 			if err != nil {
 				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
@@ -988,7 +1031,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		request := redis.NewApplyParamsTemplateRequest()
 		request.InstanceIds = []*string{&id}
 		request.TemplateId = helper.String(d.Get("params_template_id").(string))
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			_, err := redisService.ApplyParamsTemplate(ctx, request)
 			if err != nil {
 				return retryError(err, redis.FAILEDOPERATION_SYSTEMERROR, redis.RESOURCEUNAVAILABLE_INSTANCELOCKEDERROR)
@@ -1027,7 +1070,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		request.InstanceId = &id
 		request.TargetInstanceType = helper.String(strconv.Itoa(typeId))
 		request.SwitchOption = helper.IntInt64(2)
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().UpgradeInstanceVersion(request)
 			if e != nil {
 				return retryError(e)
@@ -1042,7 +1085,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		}
 
 		service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
-		_, _, _, err = service.CheckRedisOnlineOk(ctx, id, 20*readRetryTimeout)
+		_, _, _, err = service.CheckRedisOnlineOk(ctx, id, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			log.Printf("[CRITAL]%s redis upgradeVersionOperation fail, reason:%s\n", logId, err.Error())
 			return err
@@ -1093,7 +1136,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 			}
 		}
 
-		err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		err := retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			result, e := meta.(*TencentCloudClient).apiV3Conn.UseRedisClient().ModifyNetworkConfig(request)
 			if e != nil {
 				if _, ok := e.(*sdkErrors.TencentCloudSDKError); !ok {
@@ -1112,7 +1155,7 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		}
 
 		service := RedisService{client: meta.(*TencentCloudClient).apiV3Conn}
-		_, _, _, err = service.CheckRedisOnlineOk(ctx, id, 20*readRetryTimeout)
+		_, _, _, err = service.CheckRedisOnlineOk(ctx, id, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			log.Printf("[CRITAL]%s redis networkConfig fail, reason:%s\n", logId, err.Error())
 			return err
@@ -1121,8 +1164,8 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		_ = d.Set("operation_network", operation)
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldTags, newTags := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("redis", "instance", region, id)
@@ -1147,7 +1190,7 @@ func resourceTencentCloudRedisInstanceDelete(d *schema.ResourceData, meta interf
 
 	// Collect infos before deleting action
 	var chargeType string
-	has, _, info, err := service.CheckRedisOnlineOk(ctx, d.Id(), 20*readRetryTimeout)
+	has, _, info, err := service.CheckRedisOnlineOk(ctx, d.Id(), d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		log.Printf("[CRITAL]%s redis querying before deleting task fail, reason:%s\n", logId, err.Error())
@@ -1162,7 +1205,7 @@ func resourceTencentCloudRedisInstanceDelete(d *schema.ResourceData, meta interf
 
 	var wait = func(action string, taskInfo interface{}) (errRet error) {
 
-		errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+		errRet = retryOperation(meta, writeRetryTimeout, func() *resource.RetryError {
 			var ok bool
 			var err error
 			switch v := taskInfo.(type) {
@@ -1209,7 +1252,7 @@ func resourceTencentCloudRedisInstanceDelete(d *schema.ResourceData, meta interf
 		}
 
 		// Deal info only support create and renew and resize, need to check destroy status by describing api.
-		if errDestroyChecking := resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
+		if errDestroyChecking := retryOperation(meta, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 			has, isolated, err := service.CheckRedisDestroyOk(ctx, d.Id())
 			if err != nil {
 				log.Printf("[CRITAL]%s CheckRedisDestroyOk fail, reason:%s\n", logId, err.Error())
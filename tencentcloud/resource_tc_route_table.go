@@ -226,8 +226,8 @@ func resourceTencentCloudVpcRouteTableUpdate(d *schema.ResourceData, meta interf
 
 	}
 
-	if d.HasChange("tags") {
-		oldTags, newTags := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldTags, newTags := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		tagService := TagService{client: meta.(*TencentCloudClient).apiV3Conn}
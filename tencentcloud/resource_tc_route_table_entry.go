@@ -49,7 +49,6 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -59,15 +58,15 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 )
 
+var routeTableEntryId = helper.NewIdSchema("route_entry_id", "route_table_id").WithSeparator(".")
+
 func resourceTencentCloudVpcRouteEntry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcRouteEntryCreate,
-		Read:   resourceTencentCloudVpcRouteEntryRead,
-		Update: resourceTencentCloudVpcRouteEntryUpdate,
-		Delete: resourceTencentCloudVpcRouteEntryDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudVpcRouteEntryCreate,
+		Read:     resourceTencentCloudVpcRouteEntryRead,
+		Update:   resourceTencentCloudVpcRouteEntryUpdate,
+		Delete:   resourceTencentCloudVpcRouteEntryDelete,
+		Importer: routeTableEntryId.Importer(nil),
 
 		Schema: map[string]*schema.Schema{
 			"route_table_id": {
@@ -164,7 +163,7 @@ func resourceTencentCloudVpcRouteEntryCreate(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	d.SetId(fmt.Sprintf("%d.%s", entryId, routeTableId))
+	d.SetId(routeTableEntryId.Format(strconv.FormatInt(entryId, 10), routeTableId))
 
 	if disabled {
 		request := vpc.NewDisableRoutesRequest()
@@ -188,11 +187,11 @@ func resourceTencentCloudVpcRouteEntryRead(d *schema.ResourceData, meta interfac
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	items := strings.Split(d.Id(), ".")
-	if len(items) != 2 {
-		return fmt.Errorf("entry id be destroyed, we can not get route table id")
+	items, err := routeTableEntryId.Parse(d.Id())
+	if err != nil {
+		return err
 	}
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
 		info, has, e := service.DescribeRouteTable(ctx, items[1])
 		if e != nil {
 			return retryError(e)
@@ -235,9 +234,9 @@ func resourceTencentCloudVpcRouteEntryUpdate(d *schema.ResourceData, meta interf
 	client := meta.(*TencentCloudClient).apiV3Conn
 	service := VpcService{client}
 
-	items := strings.Split(d.Id(), ".")
-	if len(items) != 2 {
-		return fmt.Errorf("entry id be destroyed, we can not get route table id")
+	items, err := routeTableEntryId.Parse(d.Id())
+	if err != nil {
+		return err
 	}
 
 	id := items[0]
@@ -264,9 +263,9 @@ func resourceTencentCloudVpcRouteEntryDelete(d *schema.ResourceData, meta interf
 
 	service := VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	items := strings.Split(d.Id(), ".")
-	if len(items) != 2 {
-		return fmt.Errorf("entry id be destroyed, we can not get route table id")
+	items, err := routeTableEntryId.Parse(d.Id())
+	if err != nil {
+		return err
 	}
 
 	routeTableId := items[1]
@@ -1253,7 +1253,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		resp, err := scfService.DescribeFunction(ctx, functionInfo.name, *functionInfo.namespace)
 		if err != nil {
 			log.Printf("[CRITAL]%s get function id failed: %+v", logId, err)
@@ -1261,7 +1261,7 @@ func resourceTencentCloudScfFunctionUpdate(d *schema.ResourceData, m interface{}
 		}
 		functionId := *resp.Response.FunctionId
 
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := getTagsChange(d, m)
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 		resourceName := BuildTagResourceName(SCF_SERVICE, SCF_FUNCTION_RESOURCE, region, functionId)
 
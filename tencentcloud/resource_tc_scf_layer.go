@@ -41,7 +41,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	scf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/scf/v20180416"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func LayerContent() map[string]*schema.Schema {
@@ -216,7 +215,6 @@ func resourceTencentCloudScfLayerCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := scfService.client.UseScfClient().PublishLayerVersion(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -264,7 +262,6 @@ func resourceTencentCloudScfLayerRead(d *schema.ResourceData, meta interface{})
 	layerRequest.LayerVersion = helper.Int64(helper.StrToInt64(layerVersion))
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(layerRequest.GetAction())
 		response, err := scfService.client.UseScfClient().GetLayerVersion(layerRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -327,7 +324,6 @@ func resourceTencentCloudScfLayerDelete(d *schema.ResourceData, meta interface{}
 	request.LayerVersion = helper.Int64(helper.StrToInt64(layerVersion))
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if _, err := scfService.client.UseScfClient().DeleteLayerVersion(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
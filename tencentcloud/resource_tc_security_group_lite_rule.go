@@ -20,7 +20,7 @@ resource "tencentcloud_security_group_lite_rule" "foo" {
     "ACCEPT#sg-7ixn3foj#80-90#TCP",
     "ACCEPT#ipm-epjq5kn0#80-90#TCP",
     "ACCEPT#ipmg-3loavam6#80-90#TCP",
-    "ACCEPT#0.0.0.0/0##ppm-xxxxxxxx"
+    "ACCEPT#0.0.0.0/0##ppm-xxxxxxxx",
     "ACCEPT#0.0.0.0/0##ppmg-xxxxxxxx"
   ]
 
@@ -3,66 +3,41 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 	"testing"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func init() {
-	resource.AddTestSweepers("tencentcloud_security_group", &resource.Sweeper{
-		Name: "tencentcloud_security_group",
-		F:    testSweepSecurityGroups,
+	addTestSweeper(&sweeper{
+		Name:           "tencentcloud_security_group",
+		ServiceType:    "cvm",
+		ResourcePrefix: "sg",
+		Dependencies:   []string{"tencentcloud_instance", "tencentcloud_clb_instance"},
+		List: func(ctx context.Context, client *TencentCloudClient) ([]*sweepResource, error) {
+			service := VpcService{client: client.apiV3Conn}
+			sgs, err := service.DescribeSecurityGroups(ctx, nil, nil, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]*sweepResource, 0, len(sgs))
+			for _, v := range sgs {
+				resources = append(resources, &sweepResource{
+					Id:          *v.SecurityGroupId,
+					Name:        *v.SecurityGroupName,
+					CreatedTime: stringTotime(*v.CreatedTime),
+				})
+			}
+			return resources, nil
+		},
+		Delete: func(ctx context.Context, client *TencentCloudClient, id string) error {
+			service := VpcService{client: client.apiV3Conn}
+			return service.DeleteSecurityGroup(ctx, id)
+		},
 	})
 }
 
-func testSweepSecurityGroups(region string) error {
-	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-
-	sharedClient, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("getting tencentcloud client error: %s", err.Error())
-	}
-	client := sharedClient.(*TencentCloudClient)
-	service := VpcService{
-		client: client.apiV3Conn,
-	}
-
-	sgs, err := service.DescribeSecurityGroups(ctx, nil, nil, nil, nil)
-
-	if err != nil {
-		return fmt.Errorf("DescribeSecurityGroups error: %s", err.Error())
-	}
-
-	for _, v := range sgs {
-		name := *v.SecurityGroupName
-		id := *v.SecurityGroupId
-
-		now := time.Now()
-		createTime := stringTotime(*v.CreatedTime)
-		interval := now.Sub(createTime).Minutes()
-
-		if strings.HasPrefix(name, keepResource) || strings.HasPrefix(name, defaultResource) {
-			continue
-		}
-
-		// less than 30 minute, not delete
-		if needProtect == 1 && int64(interval) < 30 {
-			continue
-		}
-
-		if err := service.DeleteSecurityGroup(ctx, id); err != nil {
-			log.Printf("[ERROR] sweep security group %s error: %s", id, err.Error())
-		}
-	}
-
-	return nil
-}
-
 func TestAccTencentCloudSecurityGroup_basic(t *testing.T) {
 	t.Parallel()
 	var sgId string
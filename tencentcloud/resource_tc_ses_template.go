@@ -7,7 +7,7 @@ Create a ses template instance
 
 ```hcl
 resource "tencentcloud_ses_template" "example" {
-  template_name = "tf_example_ses_temp""
+  template_name = "tf_example_ses_temp"
   template_content {
     text = "example for the ses template"
   }
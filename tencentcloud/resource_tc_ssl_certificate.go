@@ -370,8 +370,8 @@ func resourceTencentCloudSslCertificateUpdate(d *schema.ResourceData, m interfac
 
 	}
 
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldInterface, newInterface := getTagsChange(d, m)
 		replaceTags, deleteTags := diffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagClient := m.(*TencentCloudClient).apiV3Conn
 		tagService := TagService{client: tagClient}
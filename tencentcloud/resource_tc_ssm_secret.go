@@ -310,11 +310,11 @@ func resourceTencentCloudSsmSecretUpdate(d *schema.ResourceData, meta interface{
 
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tcClient := meta.(*TencentCloudClient).apiV3Conn
 		tagService := &TagService{client: tcClient}
 
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 		secretInfo, err := ssmService.DescribeSecretByName(ctx, secretName)
 		if err != nil {
@@ -3,8 +3,6 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 	"testing"
 	"time"
 
@@ -13,56 +11,31 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("tencentcloud_subnet", &resource.Sweeper{
-		Name: "tencentcloud_subnet",
-		F:    testSweepSubnet,
+	addTestSweeper(&sweeper{
+		Name:           "tencentcloud_subnet",
+		ServiceType:    "vpc",
+		ResourcePrefix: "subnet",
+		Dependencies:   []string{"tencentcloud_instance", "tencentcloud_clb_instance"},
+		List: func(ctx context.Context, client *TencentCloudClient) ([]*sweepResource, error) {
+			vpcService := VpcService{client: client.apiV3Conn}
+			instances, err := vpcService.DescribeSubnets(ctx, "", "", "", "",
+				nil, nil, nil, "", "")
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]*sweepResource, 0, len(instances))
+			for _, v := range instances {
+				resources = append(resources, &sweepResource{Id: v.subnetId, Name: v.name, CreatedTime: stringTotime(v.createTime)})
+			}
+			return resources, nil
+		},
+		Delete: func(ctx context.Context, client *TencentCloudClient, id string) error {
+			vpcService := VpcService{client: client.apiV3Conn}
+			return vpcService.DeleteSubnet(ctx, id)
+		},
 	})
 }
 
-func testSweepSubnet(region string) error {
-	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-
-	sharedClient, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("getting tencentcloud client error: %s", err.Error())
-	}
-	client := sharedClient.(*TencentCloudClient)
-
-	vpcService := VpcService{
-		client: client.apiV3Conn,
-	}
-
-	instances, err := vpcService.DescribeSubnets(ctx, "", "", "", "",
-		nil, nil, nil, "", "")
-	if err != nil {
-		return fmt.Errorf("get instance list error: %s", err.Error())
-	}
-
-	for _, v := range instances {
-
-		instanceId := v.subnetId
-		instanceName := v.name
-		now := time.Now()
-		createTime := stringTotime(v.createTime)
-		interval := now.Sub(createTime).Minutes()
-
-		if strings.HasPrefix(instanceName, keepResource) || strings.HasPrefix(instanceName, defaultResource) {
-			continue
-		}
-
-		if needProtect == 1 && int64(interval) < 30 {
-			continue
-		}
-
-		if err = vpcService.DeleteSubnet(ctx, instanceId); err != nil {
-			log.Printf("[ERROR] sweep instance %s error: %s", instanceId, err.Error())
-		}
-	}
-
-	return nil
-}
-
 func TestAccTencentCloudVpcV3SubnetBasic(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
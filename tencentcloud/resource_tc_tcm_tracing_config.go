@@ -14,7 +14,7 @@ resource "tencentcloud_tcm_tracing_config" "tracing_config" {
 	region = "ap-guangzhou"
 	instance_id = "apm-xxx"
   }
-  sampling =
+  sampling = 1
   zipkin {
 	address = "10.10.10.10:9411"
   }
@@ -8,7 +8,7 @@ resource "tencentcloud_tcmq_subscribe" "subscribe" {
   topic_name = "topic_name"
   subscription_name = "subscription_name"
   protocol = "http"
-  endpoint = "http://xxxxxx";
+  endpoint = "http://xxxxxx"
 }
 ```
 
@@ -35,7 +35,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,15 +42,15 @@ import (
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+var tdcpgInstanceId = helper.NewIdSchema("cluster_id", "instance_id")
+
 func resourceTencentCloudTdcpgInstance() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTdcpgInstanceRead,
-		Create: resourceTencentCloudTdcpgInstanceCreate,
-		Update: resourceTencentCloudTdcpgInstanceUpdate,
-		Delete: resourceTencentCloudTdcpgInstanceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudTdcpgInstanceRead,
+		Create:   resourceTencentCloudTdcpgInstanceCreate,
+		Update:   resourceTencentCloudTdcpgInstanceUpdate,
+		Delete:   resourceTencentCloudTdcpgInstanceDelete,
+		Importer: tdcpgInstanceId.Importer(nil),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -155,7 +154,7 @@ func resourceTencentCloudTdcpgInstanceCreate(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	d.SetId(clusterId + FILED_SP + instanceId)
+	d.SetId(tdcpgInstanceId.Format(clusterId, instanceId))
 	return resourceTencentCloudTdcpgInstanceRead(d, meta)
 }
 
@@ -164,17 +163,19 @@ func resourceTencentCloudTdcpgInstanceRead(d *schema.ResourceData, meta interfac
 	defer inconsistentCheck(d, meta)()
 
 	var (
-		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
-		service    = TdcpgService{client: meta.(*TencentCloudClient).apiV3Conn}
-		instance   *tdcpg.Instance
-		ids        = strings.Split(d.Id(), FILED_SP)
-		clusterId  = ids[0]
-		instanceId = ids[1]
+		logId    = getLogId(contextNil)
+		ctx      = context.WithValue(context.TODO(), logIdKey, logId)
+		service  = TdcpgService{client: meta.(*TencentCloudClient).apiV3Conn}
+		instance *tdcpg.Instance
 	)
+	ids, err := tdcpgInstanceId.Parse(d.Id())
+	if err != nil {
+		return err
+	}
+	clusterId, instanceId := ids[0], ids[1]
 
 	// query the instance of cluster
-	err := resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(3*readRetryTimeout, func() *resource.RetryError {
 		instances, e := service.DescribeTdcpgInstance(ctx, &clusterId, &instanceId)
 		if e != nil {
 			return retryError(e)
@@ -228,14 +229,16 @@ func resourceTencentCloudTdcpgInstanceUpdate(d *schema.ResourceData, meta interf
 	defer inconsistentCheck(d, meta)()
 
 	var (
-		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
-		service    = TdcpgService{client: meta.(*TencentCloudClient).apiV3Conn}
-		request    = tdcpg.NewModifyClusterInstancesSpecRequest()
-		ids        = strings.Split(d.Id(), FILED_SP)
-		clusterId  = ids[0]
-		instanceId = ids[1]
+		logId   = getLogId(contextNil)
+		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
+		service = TdcpgService{client: meta.(*TencentCloudClient).apiV3Conn}
+		request = tdcpg.NewModifyClusterInstancesSpecRequest()
 	)
+	ids, err := tdcpgInstanceId.Parse(d.Id())
+	if err != nil {
+		return err
+	}
+	clusterId, instanceId := ids[0], ids[1]
 
 	request.ClusterId = &clusterId
 	request.InstanceIdSet = []*string{helper.String(instanceId)}
@@ -252,7 +255,7 @@ func resourceTencentCloudTdcpgInstanceUpdate(d *schema.ResourceData, meta interf
 		request.OperationTiming = helper.String(v.(string))
 	}
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 		result, e := meta.(*TencentCloudClient).apiV3Conn.UseTdcpgClient().ModifyClusterInstancesSpec(request)
 		if e != nil {
 			return retryError(e)
@@ -301,13 +304,15 @@ func resourceTencentCloudTdcpgInstanceDelete(d *schema.ResourceData, meta interf
 	defer inconsistentCheck(d, meta)()
 
 	var (
-		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
-		service    = TdcpgService{client: meta.(*TencentCloudClient).apiV3Conn}
-		ids        = strings.Split(d.Id(), FILED_SP)
-		clusterId  = ids[0]
-		instanceId = ids[1]
+		logId   = getLogId(contextNil)
+		ctx     = context.WithValue(context.TODO(), logIdKey, logId)
+		service = TdcpgService{client: meta.(*TencentCloudClient).apiV3Conn}
 	)
+	ids, err := tdcpgInstanceId.Parse(d.Id())
+	if err != nil {
+		return err
+	}
+	clusterId, instanceId := ids[0], ids[1]
 
 	if err := service.DeleteTdcpgInstanceById(ctx, &clusterId, &instanceId); err != nil {
 		return err
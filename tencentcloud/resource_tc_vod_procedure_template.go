@@ -107,7 +107,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vod/v20180717"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 func resourceTencentCloudVodProcedureTemplate() *schema.Resource {
@@ -640,7 +639,6 @@ func resourceTencentCloudVodProcedureTemplateCreate(d *schema.ResourceData, meta
 
 	var err error
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err = meta.(*TencentCloudClient).apiV3Conn.UseVodClient().CreateProcedureTemplate(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -901,7 +899,6 @@ func resourceTencentCloudVodProcedureTemplateUpdate(d *schema.ResourceData, meta
 	if changeFlag {
 		var err error
 		err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			_, err = meta.(*TencentCloudClient).apiV3Conn.UseVodClient().ResetProcedureTemplate(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), err.Error())
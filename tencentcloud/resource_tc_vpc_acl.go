@@ -304,12 +304,12 @@ func resourceTencentCloudVpcACLUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		client := meta.(*TencentCloudClient).apiV3Conn
 		tagService := TagService{client: client}
 		region := client.Region
 
-		oldTags, newTags := d.GetChange("tags")
+		oldTags, newTags := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("vpc", "acl", region, id)
@@ -378,10 +378,10 @@ func resourceTencentCloudVpcFlowLogUpdate(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		client := meta.(*TencentCloudClient).apiV3Conn
 		tagService := TagService{client}
-		oldValue, newValue := d.GetChange("tags")
+		oldValue, newValue := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldValue.(map[string]interface{}), newValue.(map[string]interface{}))
 
 		resourceName := BuildTagResourceName("vpc", "fl", client.Region, flowLogId)
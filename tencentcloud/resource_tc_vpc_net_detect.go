@@ -10,7 +10,6 @@ resource "tencentcloud_vpc_net_detect" "net_detect" {
   subnet_id             = "subnet-c1l35990"
   next_hop_destination  = "172.16.128.57"
   next_hop_type         = "NORMAL_CVM"
-  subnet_id             = "subnet-c1l35990"
   detect_destination_ip = [
     "10.0.0.1",
     "10.0.0.2",
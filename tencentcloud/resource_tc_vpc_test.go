@@ -3,8 +3,6 @@ package tencentcloud
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 	"testing"
 	"time"
 
@@ -13,55 +11,30 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("tencentcloud_vpc", &resource.Sweeper{
-		Name: "tencentcloud_vpc",
-		F:    testSweepVpcInstance,
+	addTestSweeper(&sweeper{
+		Name:           "tencentcloud_vpc",
+		ServiceType:    "vpc",
+		ResourcePrefix: "vpc",
+		Dependencies:   []string{"tencentcloud_subnet", "tencentcloud_security_group"},
+		List: func(ctx context.Context, client *TencentCloudClient) ([]*sweepResource, error) {
+			vpcService := VpcService{client: client.apiV3Conn}
+			instances, err := vpcService.DescribeVpcs(ctx, "", "", nil, nil, "", "")
+			if err != nil {
+				return nil, err
+			}
+			resources := make([]*sweepResource, 0, len(instances))
+			for _, v := range instances {
+				resources = append(resources, &sweepResource{Id: v.vpcId, Name: v.name, CreatedTime: stringTotime(v.createTime)})
+			}
+			return resources, nil
+		},
+		Delete: func(ctx context.Context, client *TencentCloudClient, id string) error {
+			vpcService := VpcService{client: client.apiV3Conn}
+			return vpcService.DeleteVpc(ctx, id)
+		},
 	})
 }
 
-func testSweepVpcInstance(region string) error {
-	logId := getLogId(contextNil)
-	ctx := context.WithValue(context.TODO(), logIdKey, logId)
-
-	sharedClient, err := sharedClientForRegion(region)
-	if err != nil {
-		return fmt.Errorf("getting tencentcloud client error: %s", err.Error())
-	}
-	client := sharedClient.(*TencentCloudClient)
-
-	vpcService := VpcService{
-		client: client.apiV3Conn,
-	}
-
-	instances, err := vpcService.DescribeVpcs(ctx, "", "", nil, nil, "", "")
-	if err != nil {
-		return fmt.Errorf("get instance list error: %s", err.Error())
-	}
-
-	for _, v := range instances {
-		instanceId := v.vpcId
-		instanceName := v.name
-
-		now := time.Now()
-
-		createTime := stringTotime(v.createTime)
-		interval := now.Sub(createTime).Minutes()
-		if strings.HasPrefix(instanceName, keepResource) || strings.HasPrefix(instanceName, defaultResource) {
-			continue
-		}
-		// less than 30 minute, not delete
-		if needProtect == 1 && int64(interval) < 30 {
-			continue
-		}
-
-		if err = vpcService.DeleteVpc(ctx, instanceId); err != nil {
-			log.Printf("[ERROR] sweep instance %s error: %s", instanceId, err.Error())
-		}
-	}
-
-	return nil
-}
-
 func TestAccTencentCloudVpcV3Basic(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
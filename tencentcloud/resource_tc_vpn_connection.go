@@ -865,8 +865,8 @@ func resourceTencentCloudVpnConnectionUpdate(d *schema.ResourceData, meta interf
 	time.Sleep(3 * time.Minute)
 
 	//tag
-	if d.HasChange("tags") {
-		oldInterface, newInterface := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oldInterface, newInterface := getTagsChange(d, meta)
 		replaceTags, deleteTags := diffTags(oldInterface.(map[string]interface{}), newInterface.(map[string]interface{}))
 		tagService := TagService{
 			client: meta.(*TencentCloudClient).apiV3Conn,
@@ -26,7 +26,6 @@ package tencentcloud
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 
@@ -35,15 +34,15 @@ import (
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+var vpnGatewayRouteId = helper.NewIdSchema("vpn_gateway_id", "route_id")
+
 func resourceTencentCloudVpnGatewayRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpnGatewayRouteCreate,
-		Read:   resourceTencentCloudVpnGatewayRouteRead,
-		Update: resourceTencentCloudVpnGatewayRouteUpdate,
-		Delete: resourceTencentCloudVpnGatewayRouteDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudVpnGatewayRouteCreate,
+		Read:     resourceTencentCloudVpnGatewayRouteRead,
+		Update:   resourceTencentCloudVpnGatewayRouteUpdate,
+		Delete:   resourceTencentCloudVpnGatewayRouteDelete,
+		Importer: vpnGatewayRouteId.Importer(nil),
 
 		Schema: VpnGatewayRoutePara(),
 	}
@@ -138,7 +137,7 @@ func resourceTencentCloudVpnGatewayRouteCreate(d *schema.ResourceData, meta inte
 	if len(routeList) == 0 {
 		return fmt.Errorf("VPN gateway route id is nil")
 	}
-	d.SetId(helper.IdFormat(vpnGatewayId, *(routeList[0].RouteId)))
+	d.SetId(vpnGatewayRouteId.Format(vpnGatewayId, *(routeList[0].RouteId)))
 
 	//setRouteInfo(d, vpnGatewayId, route)
 	return resourceTencentCloudVpnGatewayRouteRead(d, meta)
@@ -154,9 +153,9 @@ func resourceTencentCloudVpnGatewayRouteRead(d *schema.ResourceData, meta interf
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id      = d.Id()
 	)
-	compositeId := helper.IdParse(id)
-	if len(compositeId) != 2 {
-		return errors.New("the id format must be '{vpn_gateway_id}#{route_id}'")
+	compositeId, err := vpnGatewayRouteId.Parse(id)
+	if err != nil {
+		return err
 	}
 
 	err, routeList := service.DescribeVpnGatewayRoutes(ctx, compositeId[0], nil)
@@ -188,9 +187,9 @@ func resourceTencentCloudVpnGatewayRouteUpdate(d *schema.ResourceData, meta inte
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id      = d.Id()
 	)
-	compositeId := helper.IdParse(id)
-	if len(compositeId) != 2 {
-		return errors.New("the id format must be '{vpn_gateway_id}#{route_id}'")
+	compositeId, err := vpnGatewayRouteId.Parse(id)
+	if err != nil {
+		return err
 	}
 
 	if !d.HasChange("status") {
@@ -218,12 +217,12 @@ func resourceTencentCloudVpnGatewayRouteDelete(d *schema.ResourceData, meta inte
 		service = VpcService{client: meta.(*TencentCloudClient).apiV3Conn}
 		id      = d.Id()
 	)
-	compositeId := helper.IdParse(id)
-	if len(compositeId) != 2 {
-		return errors.New("the id format must be '{vpn_gateway_id}#{route_id}'")
+	compositeId, err := vpnGatewayRouteId.Parse(id)
+	if err != nil {
+		return err
 	}
 
-	err := service.DeleteVpnGatewayRoutes(ctx, compositeId[0], []*string{&compositeId[1]})
+	err = service.DeleteVpnGatewayRoutes(ctx, compositeId[0], []*string{&compositeId[1]})
 	if err != nil {
 		log.Printf("[CRITAL]%s delete VPN gateway routes failed, reason:%s\n", logId, err.Error())
 		return err
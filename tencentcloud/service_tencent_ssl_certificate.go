@@ -9,7 +9,6 @@ import (
 
 	ssl "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssl/v20191205"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type SSLService struct {
@@ -25,7 +24,6 @@ func (me *SSLService) ApplyCertificate(ctx context.Context, request *ssl.ApplyCe
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSSLCertificateClient().ApplyCertificate(request)
 
 	if err != nil {
@@ -48,7 +46,6 @@ func (me *SSLService) ApplyCertificate(ctx context.Context, request *ssl.ApplyCe
 func (me *SSLService) CreateCertificate(ctx context.Context, request *ssl.CreateCertificateRequest) (certificateId, dealId string, errRet error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
 
 	response, err := client.CreateCertificate(request)
 	if err != nil {
@@ -74,7 +71,6 @@ func (me *SSLService) CreateCertificate(ctx context.Context, request *ssl.Create
 func (me *SSLService) CommitCertificateInformation(ctx context.Context, request *ssl.CommitCertificateInformationRequest) (errRet error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
 
 	response, err := client.CommitCertificateInformation(request)
 	if err != nil {
@@ -93,7 +89,6 @@ func (me *SSLService) CommitCertificateInformation(ctx context.Context, request
 func (me *SSLService) DescribeCertificateDetail(ctx context.Context, request *ssl.DescribeCertificateDetailRequest) (response *ssl.DescribeCertificateDetailResponse, err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
 
 	response, err = client.DescribeCertificateDetail(request)
 	if err != nil {
@@ -107,7 +102,6 @@ func (me *SSLService) DescribeCertificateDetail(ctx context.Context, request *ss
 func (me *SSLService) ModifyCertificateAlias(ctx context.Context, request *ssl.ModifyCertificateAliasRequest) (err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
 
 	var response *ssl.ModifyCertificateAliasResponse
 
@@ -127,7 +121,6 @@ func (me *SSLService) ModifyCertificateAlias(ctx context.Context, request *ssl.M
 func (me *SSLService) ModifyCertificateProject(ctx context.Context, request *ssl.ModifyCertificateProjectRequest) (err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
 
 	var response *ssl.ModifyCertificateProjectResponse
 
@@ -154,7 +147,6 @@ func (me *SSLService) ModifyCertificateProject(ctx context.Context, request *ssl
 func (me *SSLService) DeleteCertificate(ctx context.Context, request *ssl.DeleteCertificateRequest) (deleteResult bool, err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
 
 	var response *ssl.DeleteCertificateResponse
 
@@ -175,7 +167,6 @@ func (me *SSLService) DeleteCertificate(ctx context.Context, request *ssl.Delete
 func (me *SSLService) CancelCertificateOrder(ctx context.Context, request *ssl.CancelCertificateOrderRequest) (err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
 
 	var response *ssl.CancelCertificateOrderResponse
 
@@ -195,7 +186,6 @@ func (me *SSLService) CancelCertificateOrder(ctx context.Context, request *ssl.C
 func (me *SSLService) SubmitCertificateInformation(ctx context.Context, request *ssl.SubmitCertificateInformationRequest) (err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
 
 	var response *ssl.SubmitCertificateInformationResponse
 
@@ -215,7 +205,6 @@ func (me *SSLService) SubmitCertificateInformation(ctx context.Context, request
 func (me *SSLService) UploadConfirmLetter(ctx context.Context, request *ssl.UploadConfirmLetterRequest) (err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
 
 	var response *ssl.UploadConfirmLetterResponse
 
@@ -235,7 +224,6 @@ func (me *SSLService) UploadConfirmLetter(ctx context.Context, request *ssl.Uplo
 func (me *SSLService) UploadCertificate(ctx context.Context, request *ssl.UploadCertificateRequest) (id string, err error) {
 	logId := getLogId(ctx)
 	client := me.client.UseSSLCertificateClient()
-	ratelimit.Check(request.GetAction())
 
 	var response *ssl.UploadCertificateResponse
 	response, err = client.UploadCertificate(request)
@@ -271,7 +259,6 @@ func (me *SSLService) DescribeCertificates(ctx context.Context, request *ssl.Des
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
 		response, err = client.DescribeCertificates(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -7,7 +7,6 @@ import (
 	api "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/api/v20201106"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type APIService struct {
@@ -19,7 +18,6 @@ func (me *APIService) DescribeZonesWithProduct(ctx context.Context, product stri
 	request := api.NewDescribeZonesRequest()
 	request.Product = common.StringPtr(product)
 
-	ratelimit.Check(request.GetAction())
 	// API: https://cloud.tencent.com/document/product/1278/55254
 	response, err := me.client.UseApiClient().DescribeZones(request)
 	if err != nil {
@@ -6,7 +6,6 @@ import (
 
 	apm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/apm/v20210622"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type ApmService struct {
@@ -25,8 +24,6 @@ func (me *ApmService) DescribeApmInstanceById(ctx context.Context, instanceId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseApmClient().DescribeApmInstances(request)
 	if err != nil {
 		errRet = err
@@ -54,8 +51,6 @@ func (me *ApmService) DeleteApmInstanceById(ctx context.Context, instanceId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseApmClient().TerminateApmInstance(request)
 	if err != nil {
 		errRet = err
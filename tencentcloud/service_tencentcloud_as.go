@@ -10,7 +10,6 @@ import (
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type AsService struct {
@@ -21,7 +20,6 @@ func (me *AsService) DescribeLaunchConfigurationById(ctx context.Context, config
 	logId := getLogId(ctx)
 	request := as.NewDescribeLaunchConfigurationsRequest()
 	request.LaunchConfigurationIds = []*string{&configurationId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeLaunchConfigurations(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -62,7 +60,6 @@ func (me *AsService) DescribeLaunchConfigurationByFilter(ctx context.Context, co
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseAsClient().DescribeLaunchConfigurations(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -86,7 +83,6 @@ func (me *AsService) DeleteLaunchConfiguration(ctx context.Context, configuratio
 	logId := getLogId(ctx)
 	request := as.NewDeleteLaunchConfigurationRequest()
 	request.LaunchConfigurationId = &configurationId
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseAsClient().DeleteLaunchConfiguration(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -100,7 +96,6 @@ func (me *AsService) DescribeAutoScalingGroupById(ctx context.Context, scalingGr
 	logId := getLogId(ctx)
 	request := as.NewDescribeAutoScalingGroupsRequest()
 	request.AutoScalingGroupIds = []*string{&scalingGroupId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeAutoScalingGroups(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -158,7 +153,6 @@ func (me *AsService) DescribeAutoScalingGroupByFilter(
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseAsClient().DescribeAutoScalingGroups(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -186,7 +180,6 @@ func (me *AsService) ClearScalingGroupInstance(ctx context.Context, scalingGroup
 	request.MinSize = helper.IntUint64(0)
 	request.MaxSize = helper.IntUint64(0)
 	request.DesiredCapacity = helper.IntUint64(0)
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseAsClient().ModifyAutoScalingGroup(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -200,7 +193,6 @@ func (me *AsService) DeleteScalingGroup(ctx context.Context, scalingGroupId stri
 	logId := getLogId(ctx)
 	request := as.NewDeleteAutoScalingGroupRequest()
 	request.AutoScalingGroupId = &scalingGroupId
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseAsClient().DeleteAutoScalingGroup(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -218,7 +210,6 @@ func (me *AsService) AttachInstances(ctx context.Context, scalingGroupId string,
 	for i := range instanceIds {
 		request.InstanceIds = append(request.InstanceIds, &instanceIds[i])
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAsClient().AttachInstances(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -250,7 +241,6 @@ func (me *AsService) DescribeActivityById(ctx context.Context, activityId string
 	logId := getLogId(ctx)
 	request := as.NewDescribeAutoScalingActivitiesRequest()
 	request.ActivityIds = []*string{&activityId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeAutoScalingActivities(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -273,7 +263,6 @@ func (me *AsService) DetachInstances(ctx context.Context, scalingGroupId string,
 	for i := range instanceIds {
 		request.InstanceIds = append(request.InstanceIds, &instanceIds[i])
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DetachInstances(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -301,6 +290,77 @@ func (me *AsService) DetachInstances(ctx context.Context, scalingGroupId string,
 	return nil
 }
 
+// RemoveInstances removes the instances from the scaling group and reduces its desired capacity, the instances
+// created by the scaling group are terminated, and the attached ones are kept
+func (me *AsService) RemoveInstances(ctx context.Context, scalingGroupId string, instanceIds []string) error {
+	logId := getLogId(ctx)
+	request := as.NewRemoveInstancesRequest()
+	request.AutoScalingGroupId = &scalingGroupId
+	request.InstanceIds = make([]*string, 0, len(instanceIds))
+	for i := range instanceIds {
+		request.InstanceIds = append(request.InstanceIds, &instanceIds[i])
+	}
+	response, err := me.client.UseAsClient().RemoveInstances(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	activityId := *response.Response.ActivityId
+
+	err = resource.Retry(4*readRetryTimeout, func() *resource.RetryError {
+		status, err := me.DescribeActivityById(ctx, activityId)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if status == SCALING_GROUP_ACTIVITY_STATUS_INIT || status == SCALING_GROUP_ACTIVITY_STATUS_RUNNING {
+			return resource.RetryableError(fmt.Errorf("remove status is running(%s)", status))
+		}
+		if status == SCALING_GROUP_ACTIVITY_STATUS_SUCCESSFUL {
+			return nil
+		}
+		return resource.NonRetryableError(fmt.Errorf("remove status is failed(%s)", status))
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ScaleOutInstances raises the desired capacity of the scaling group by number and waits for the activity, which
+// succeeds after the new instances pass the launching lifecycle hooks
+func (me *AsService) ScaleOutInstances(ctx context.Context, scalingGroupId string, number int) error {
+	logId := getLogId(ctx)
+	request := as.NewScaleOutInstancesRequest()
+	request.AutoScalingGroupId = &scalingGroupId
+	request.ScaleOutNumber = helper.IntUint64(number)
+	response, err := me.client.UseAsClient().ScaleOutInstances(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	activityId := *response.Response.ActivityId
+
+	err = resource.Retry(4*readRetryTimeout, func() *resource.RetryError {
+		status, err := me.DescribeActivityById(ctx, activityId)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if status == SCALING_GROUP_ACTIVITY_STATUS_INIT || status == SCALING_GROUP_ACTIVITY_STATUS_RUNNING {
+			return resource.RetryableError(fmt.Errorf("scale out status is running(%s)", status))
+		}
+		if status == SCALING_GROUP_ACTIVITY_STATUS_SUCCESSFUL {
+			return nil
+		}
+		return resource.NonRetryableError(fmt.Errorf("scale out status is failed(%s)", status))
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (me *AsService) DescribeAutoScalingAttachment(ctx context.Context, scalingGroupId string, fully bool) (instanceIds []string, errRet error) {
 	logId := getLogId(ctx)
 	request := as.NewDescribeAutoScalingInstancesRequest()
@@ -310,7 +370,6 @@ func (me *AsService) DescribeAutoScalingAttachment(ctx context.Context, scalingG
 			Values: []*string{&scalingGroupId},
 		},
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeAutoScalingInstances(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -332,7 +391,6 @@ func (me *AsService) DescribeScalingPolicyById(ctx context.Context, scalingPolic
 	logId := getLogId(ctx)
 	request := as.NewDescribeScalingPoliciesRequest()
 	request.AutoScalingPolicyIds = []*string{&scalingPolicyId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeScalingPolicies(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -380,7 +438,6 @@ func (me *AsService) DescribeScalingPolicyByFilter(ctx context.Context, policyId
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseAsClient().DescribeScalingPolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -400,11 +457,43 @@ func (me *AsService) DescribeScalingPolicyByFilter(ctx context.Context, policyId
 	return
 }
 
+func (me *AsService) CreateScalingPolicy(ctx context.Context, request *as.CreateScalingPolicyRequest) (scalingPolicyId string, errRet error) {
+	logId := getLogId(ctx)
+	response, err := me.client.UseAsClient().CreateScalingPolicy(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response.Response == nil || response.Response.AutoScalingPolicyId == nil {
+		errRet = fmt.Errorf("scaling policy id is nil")
+		return
+	}
+	scalingPolicyId = *response.Response.AutoScalingPolicyId
+	return
+}
+
+func (me *AsService) ModifyScalingPolicy(ctx context.Context, request *as.ModifyScalingPolicyRequest) error {
+	logId := getLogId(ctx)
+	response, err := me.client.UseAsClient().ModifyScalingPolicy(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	return nil
+}
+
 func (me *AsService) DeleteScalingPolicy(ctx context.Context, scalingPolicyId string) error {
 	logId := getLogId(ctx)
 	request := as.NewDeleteScalingPolicyRequest()
 	request.AutoScalingPolicyId = &scalingPolicyId
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseAsClient().DeleteScalingPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -418,7 +507,6 @@ func (me *AsService) DescribeScheduledActionById(ctx context.Context, scheduledA
 	logId := getLogId(ctx)
 	request := as.NewDescribeScheduledActionsRequest()
 	request.ScheduledActionIds = []*string{&scheduledActionId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeScheduledActions(request)
 	if err != nil {
 		sdkErr, ok := err.(*sdkErrors.TencentCloudSDKError)
@@ -449,7 +537,6 @@ func (me *AsService) ModifyAutoScalingGroup(ctx context.Context, request *as.Mod
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAsClient().ModifyAutoScalingGroup(request)
 
 	if err != nil {
@@ -467,7 +554,6 @@ func (me *AsService) DeleteScheduledAction(ctx context.Context, scheduledActonId
 	logId := getLogId(ctx)
 	request := as.NewDeleteScheduledActionRequest()
 	request.ScheduledActionId = &scheduledActonId
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseAsClient().DeleteScheduledAction(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -481,7 +567,6 @@ func (me *AsService) DescribeLifecycleHookById(ctx context.Context, lifecycleHoo
 	logId := getLogId(ctx)
 	request := as.NewDescribeLifecycleHooksRequest()
 	request.LifecycleHookIds = []*string{&lifecycleHookId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeLifecycleHooks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -501,7 +586,6 @@ func (me *AsService) DeleteLifecycleHook(ctx context.Context, lifecycleHookId st
 	logId := getLogId(ctx)
 	request := as.NewDeleteLifecycleHookRequest()
 	request.LifecycleHookId = &lifecycleHookId
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseAsClient().DeleteLifecycleHook(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -515,7 +599,6 @@ func (me *AsService) DescribeNotificationById(ctx context.Context, notificationI
 	logId := getLogId(ctx)
 	request := as.NewDescribeNotificationConfigurationsRequest()
 	request.AutoScalingNotificationIds = []*string{&notificationId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseAsClient().DescribeNotificationConfigurations(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -535,7 +618,6 @@ func (me *AsService) DeleteNotification(ctx context.Context, notificationId stri
 	logId := getLogId(ctx)
 	request := as.NewDeleteNotificationConfigurationRequest()
 	request.AutoScalingNotificationId = &notificationId
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseAsClient().DeleteNotificationConfiguration(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -566,8 +648,6 @@ func (me *AsService) DescribeAsInstancesByFilter(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -642,8 +722,6 @@ func (me *AsService) DescribeAsAdvices(ctx context.Context, param map[string]int
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAsClient().DescribeAutoScalingAdvices(request)
 	if err != nil {
 		errRet = err
@@ -668,8 +746,6 @@ func (me *AsService) DescribeAsLimits(ctx context.Context) (limits *as.DescribeA
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAsClient().DescribeAccountLimits(request)
 	if err != nil {
 		errRet = err
@@ -700,8 +776,6 @@ func (me *AsService) DescribeAsLastActivity(ctx context.Context, param map[strin
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAsClient().DescribeAutoScalingGroupLastActivities(request)
 	if err != nil {
 		errRet = err
@@ -726,8 +800,6 @@ func (me *AsService) DescribeAsLoadBalancerById(ctx context.Context, autoScaling
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAsClient().DescribeAutoScalingGroups(request)
 	if err != nil {
 		errRet = err
@@ -755,8 +827,6 @@ func (me *AsService) DeleteAsLoadBalancerById(ctx context.Context, autoScalingGr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseAsClient().DetachLoadBalancers(request)
 	if err != nil {
 		errRet = err
@@ -13,7 +13,6 @@ import (
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type CamService struct {
@@ -29,7 +28,6 @@ func (me *CamService) DescribeRoleById(ctx context.Context, roleId string) (camI
 	for {
 		request.Page = &pageStart
 		request.Rp = &rp
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().DescribeRoleList(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -71,7 +69,6 @@ func (me *CamService) DescribeRolesByFilter(ctx context.Context, params map[stri
 	for {
 		request.Page = &pageStart
 		request.Rp = &rp
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().DescribeRoleList(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -117,7 +114,6 @@ func (me *CamService) DeleteRoleById(ctx context.Context, roleId string) error {
 	logId := getLogId(ctx)
 	request := cam.NewDeleteRoleRequest()
 	request.RoleId = &roleId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCamClient().DeleteRole(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -135,7 +131,6 @@ func (me *CamService) DeleteRoleByName(ctx context.Context, roleName string) err
 	logId := getLogId(ctx)
 	request := cam.NewDeleteRoleRequest()
 	request.RoleName = &roleName
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCamClient().DeleteRole(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -173,7 +168,6 @@ func (me *CamService) DescribeRolePolicyAttachmentByName(ctx context.Context, ro
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.RoleName = &roleName
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedRolePolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -227,7 +221,6 @@ func (me *CamService) DescribeRolePolicyAttachmentById(ctx context.Context, role
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.RoleId = &roleId
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedRolePolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -277,7 +270,6 @@ func (me *CamService) DescribeRolePolicyAttachmentsByFilter(ctx context.Context,
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.RoleId = &roleId
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedRolePolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -329,7 +321,6 @@ func (me *CamService) DeleteRolePolicyAttachmentByName(ctx context.Context, role
 	request := cam.NewDetachRolePolicyRequest()
 	request.DetachRoleName = &roleName
 	request.PolicyName = &policyName
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCamClient().DetachRolePolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -350,7 +341,6 @@ func (me *CamService) DeleteRolePolicyAttachmentById(ctx context.Context, rolePo
 	request := cam.NewDetachRolePolicyRequest()
 	request.DetachRoleId = &roleId
 	request.PolicyId = &policyId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCamClient().DetachRolePolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -386,7 +376,6 @@ func (me *CamService) DescribeUserPolicyAttachmentById(ctx context.Context, user
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.TargetUin = uin
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedUserPolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -444,7 +433,6 @@ func (me *CamService) DescribeUserPolicyAttachmentsByFilter(ctx context.Context,
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.TargetUin = uin
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedUserPolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -510,7 +498,6 @@ func (me *CamService) AddUserPolicyAttachment(ctx context.Context, userId string
 	request := cam.NewAttachUserPolicyRequest()
 	request.AttachUin = uin
 	request.PolicyId = &policyIdInt64
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCamClient().AttachUserPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -540,7 +527,6 @@ func (me *CamService) DeleteUserPolicyAttachmentById(ctx context.Context, userPo
 	request := cam.NewDetachUserPolicyRequest()
 	request.DetachUin = uin
 	request.PolicyId = &policyId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCamClient().DetachUserPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -573,7 +559,6 @@ func (me *CamService) DescribeGroupPolicyAttachmentById(ctx context.Context, gro
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.TargetGroupId = &groupIdInt64
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedGroupPolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -628,7 +613,6 @@ func (me *CamService) DescribeGroupPolicyAttachmentsByFilter(ctx context.Context
 		request.Page = &pageStart
 		request.Rp = &rp
 		request.TargetGroupId = &groupIdInt64
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedGroupPolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -693,7 +677,6 @@ func (me *CamService) AddGroupPolicyAttachment(ctx context.Context, groupId stri
 	request := cam.NewAttachGroupPolicyRequest()
 	request.AttachGroupId = &groupIdInt64
 	request.PolicyId = &policyIdInt64
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCamClient().AttachGroupPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -720,7 +703,6 @@ func (me *CamService) DeleteGroupPolicyAttachmentById(ctx context.Context, group
 	request := cam.NewDetachGroupPolicyRequest()
 	request.DetachGroupId = &groupIdInt64
 	request.PolicyId = &policyId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCamClient().DetachGroupPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -797,7 +779,6 @@ func (me *CamService) DescribePoliciesByFilter(ctx context.Context, params map[s
 	for {
 		request.Page = &pageStart
 		request.Rp = &rp
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListPolicies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s read CAM policy failed, reason:%s\n", logId, err.Error())
@@ -851,7 +832,6 @@ func (me *CamService) DescribeUserById(ctx context.Context, userId string) (resp
 	logId := getLogId(ctx)
 	request := cam.NewGetUserRequest()
 	request.Name = &userId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCamClient().GetUser(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -877,7 +857,6 @@ func (me *CamService) DescribeUsersByFilter(ctx context.Context, params map[stri
 
 	result = make([]*cam.SubAccountInfo, 0)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCamClient().ListUsers(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -980,7 +959,6 @@ func (me *CamService) DescribeGroupById(ctx context.Context, groupId string) (ca
 	}
 	groupIdInt64 := uint64(groupIdInt)
 	request.GroupId = &groupIdInt64
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCamClient().GetGroup(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1010,7 +988,6 @@ func (me *CamService) DescribeGroupsByFilter(ctx context.Context, params map[str
 	for {
 		request.Page = &pageStart
 		request.Rp = &rp
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListGroups(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1144,7 +1121,6 @@ func (me *CamService) DescribeSAMLProvidersByFilter(ctx context.Context, params
 		}
 	}
 	providers = make([]*cam.SAMLProviderInfo, 0)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCamClient().ListSAMLProviders(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s read CAM SAML provider failed, reason:%s\n", logId, err.Error())
@@ -1265,7 +1241,6 @@ func (me *CamService) DeleteCamServiceLinkedRoleById(ctx context.Context, roleId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCamClient().DeleteServiceLinkedRole(request)
 	if err != nil {
 		errRet = err
@@ -1318,8 +1293,6 @@ func (me *CamService) DescribeCamUserSamlConfigById(ctx context.Context) (userSa
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCamClient().DescribeUserSAMLConfig(request)
 	if err != nil {
 		errRet = err
@@ -1344,8 +1317,6 @@ func (me *CamService) DeleteCamUserSamlConfigById(ctx context.Context) (errRet e
 
 	request.Operate = helper.String("disable")
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCamClient().UpdateUserSAMLConfig(request)
 	if err != nil {
 		errRet = err
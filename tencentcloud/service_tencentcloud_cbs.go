@@ -9,7 +9,6 @@ import (
 	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type CbsService struct {
@@ -53,7 +52,6 @@ func (me *CbsService) DescribeDiskList(ctx context.Context, diskIds []*string) (
 	request := cbs.NewDescribeDisksRequest()
 	request.DiskIds = diskIds
 	request.Limit = helper.IntUint64(100)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DescribeDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -93,7 +91,6 @@ func (me *CbsService) DescribeDisksByFilter(ctx context.Context, params map[stri
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCbsClient().DescribeDisks(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -179,7 +176,6 @@ func (me *CbsService) DescribeDisksInParallelByFilter(ctx context.Context, param
 			request.Offset = helper.IntUint64(offset)
 			request.Limit = helper.IntUint64(limit)
 
-			ratelimit.Check(request.GetAction())
 			response, err := me.client.UseCbsClient().DescribeDisks(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -215,7 +211,6 @@ func (me *CbsService) ModifyDiskAttributes(ctx context.Context, diskId, diskName
 	if projectId >= 0 {
 		request.ProjectId = helper.IntUint64(projectId)
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().ModifyDiskAttributes(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -238,7 +233,6 @@ func (me *CbsService) DeleteDiskSetByIds(ctx context.Context, diskSetIds string)
 	}
 
 	request.DiskIds = helper.StringsStringsPoint(diskSet)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().TerminateDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -254,7 +248,6 @@ func (me *CbsService) DeleteDiskById(ctx context.Context, diskId string) error {
 	logId := getLogId(ctx)
 	request := cbs.NewTerminateDisksRequest()
 	request.DiskIds = []*string{&diskId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().TerminateDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -271,7 +264,6 @@ func (me *CbsService) ResizeDisk(ctx context.Context, diskId string, diskSize in
 	request := cbs.NewResizeDiskRequest()
 	request.DiskId = &diskId
 	request.DiskSize = helper.IntUint64(diskSize)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().ResizeDisk(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -288,7 +280,6 @@ func (me *CbsService) ModifyThroughputPerformance(ctx context.Context, diskId st
 	request := cbs.NewModifyDiskExtraPerformanceRequest()
 	request.DiskId = &diskId
 	request.ThroughputPerformance = helper.IntUint64(throughputPerformance)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().ModifyDiskExtraPerformance(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -305,7 +296,6 @@ func (me *CbsService) ApplySnapshot(ctx context.Context, diskId, snapshotId stri
 	request := cbs.NewApplySnapshotRequest()
 	request.DiskId = &diskId
 	request.SnapshotId = &snapshotId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().ApplySnapshot(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -322,7 +312,6 @@ func (me *CbsService) AttachDisk(ctx context.Context, diskId, instanceId string)
 	request := cbs.NewAttachDisksRequest()
 	request.DiskIds = []*string{&diskId}
 	request.InstanceId = &instanceId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().AttachDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -339,7 +328,6 @@ func (me *CbsService) DetachDisk(ctx context.Context, diskId, instanceId string)
 	request := cbs.NewDetachDisksRequest()
 	request.DiskIds = []*string{&diskId}
 	request.InstanceId = &instanceId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DetachDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -365,7 +353,6 @@ func (me *CbsService) CreateSnapshot(ctx context.Context, diskId, snapshotName s
 			request.Tags = append(request.Tags, &tag)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().CreateSnapshot(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -384,7 +371,6 @@ func (me *CbsService) DescribeSnapshotById(ctx context.Context, snapshotId strin
 	logId := getLogId(ctx)
 	request := cbs.NewDescribeSnapshotsRequest()
 	request.SnapshotIds = []*string{&snapshotId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DescribeSnapshots(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -420,7 +406,6 @@ func (me *CbsService) DescribeSnapshotByIds(ctx context.Context, snapshotIdsPara
 		request.Limit = &pageSize
 
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseCbsClient().DescribeSnapshots(request)
 			if err != nil {
 				return retryError(err, InternalError)
@@ -461,7 +446,6 @@ func (me *CbsService) DescribeSnapshotsByFilter(ctx context.Context, params map[
 	for {
 		request.Offset = helper.IntUint64(offset)
 		request.Limit = helper.IntUint64(pageSize)
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCbsClient().DescribeSnapshots(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -491,7 +475,6 @@ func (me *CbsService) ModifySnapshotName(ctx context.Context, snapshotId, snapsh
 	request := cbs.NewModifySnapshotAttributeRequest()
 	request.SnapshotId = &snapshotId
 	request.SnapshotName = &snapshotName
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().ModifySnapshotAttribute(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -507,7 +490,6 @@ func (me *CbsService) DeleteSnapshot(ctx context.Context, snapshotId string) err
 	logId := getLogId(ctx)
 	request := cbs.NewDeleteSnapshotsRequest()
 	request.SnapshotIds = []*string{&snapshotId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DeleteSnapshots(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -523,7 +505,6 @@ func (me *CbsService) DescribeSnapshotPolicyById(ctx context.Context, policyId s
 	logId := getLogId(contextNil)
 	request := cbs.NewDescribeAutoSnapshotPoliciesRequest()
 	request.AutoSnapshotPolicyIds = []*string{&policyId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DescribeAutoSnapshotPolicies(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -558,7 +539,6 @@ func (me *CbsService) DescribeSnapshotPolicy(ctx context.Context, policyId, poli
 		}
 		request.Filters = append(request.Filters, &filter)
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DescribeAutoSnapshotPolicies(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -574,7 +554,6 @@ func (me *CbsService) DeleteSnapshotPolicy(ctx context.Context, policyId string)
 	logId := getLogId(ctx)
 	request := cbs.NewDeleteAutoSnapshotPoliciesRequest()
 	request.AutoSnapshotPolicyIds = []*string{&policyId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DeleteAutoSnapshotPolicies(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -591,7 +570,6 @@ func (me *CbsService) AttachSnapshotPolicy(ctx context.Context, diskId, policyId
 	request := cbs.NewBindAutoSnapshotPolicyRequest()
 	request.AutoSnapshotPolicyId = &policyId
 	request.DiskIds = []*string{&diskId}
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseCbsClient().BindAutoSnapshotPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -605,7 +583,6 @@ func (me *CbsService) DescribeAttachedSnapshotPolicy(ctx context.Context, diskId
 	logId := getLogId(ctx)
 	request := cbs.NewDescribeDiskAssociatedAutoSnapshotPolicyRequest()
 	request.DiskId = &diskId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCbsClient().DescribeDiskAssociatedAutoSnapshotPolicy(request)
 	if err != nil {
 		errRet = err
@@ -627,7 +604,6 @@ func (me *CbsService) UnattachSnapshotPolicy(ctx context.Context, diskId, policy
 	request := cbs.NewUnbindAutoSnapshotPolicyRequest()
 	request.AutoSnapshotPolicyId = &policyId
 	request.DiskIds = []*string{&diskId}
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseCbsClient().UnbindAutoSnapshotPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -642,7 +618,6 @@ func (me *CbsService) ModifyDiskChargeType(ctx context.Context, storageId string
 	request := cbs.NewModifyDisksChargeTypeRequest()
 	request.DiskIds = []*string{&storageId}
 	request.DiskChargePrepaid = &cbs.DiskChargePrepaid{Period: helper.IntUint64(period), RenewFlag: &renewFlag}
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseCbsClient().ModifyDisksChargeType(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -658,7 +633,6 @@ func (me *CbsService) ModifyDisksRenewFlag(ctx context.Context, storageId string
 	request.DiskIds = []*string{&storageId}
 	request.RenewFlag = &renewFlag
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseCbsClient().ModifyDisksRenewFlag(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -680,8 +654,6 @@ func (me *CbsService) DescribeCbsDiskBackupById(ctx context.Context, diskBackupI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCbsClient().DescribeDiskBackups(request)
 	if err != nil {
 		errRet = err
@@ -709,8 +681,6 @@ func (me *CbsService) DeleteCbsDiskBackupById(ctx context.Context, diskBackupId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCbsClient().DeleteDiskBackups(request)
 	if err != nil {
 		errRet = err
@@ -733,8 +703,6 @@ func (me *CbsService) ModifyDiskBackupQuota(ctx context.Context, diskId string,
 	request.DiskId = helper.String(diskId)
 	request.DiskBackupQuota = helper.IntUint64(diskBackupQuota)
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCbsClient().ModifyDiskBackupQuota(request)
 	if err != nil {
 		errRet = err
@@ -757,7 +725,6 @@ func (me *CbsService) CreateDiskBackup(ctx context.Context, diskId, diskBackupNa
 	request.DiskBackupName = helper.String(diskBackupName)
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseCbsClient().CreateDiskBackup(request)
 		if e != nil {
 			return retryError(e)
@@ -787,8 +754,6 @@ func (me *CbsService) DescribeCbsSnapshotSharePermissionById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCbsClient().DescribeSnapshotSharePermission(request)
 	if err != nil {
 		errRet = err
@@ -812,7 +777,6 @@ func (me *CbsService) ModifySnapshotsSharePermission(ctx context.Context, snapsh
 	request.SnapshotIds = []*string{&snapshotId}
 	request.Permission = helper.String(permission)
 	request.AccountIds = helper.StringsStringsPoint(accountIds)
-	ratelimit.Check(request.GetAction())
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 		result, e := me.client.UseCbsClient().ModifySnapshotsSharePermission(request)
@@ -841,7 +805,6 @@ func (me *CbsService) ApplyDiskBackup(ctx context.Context, diskBackupId, diskId
 	}()
 	request.DiskBackupId = helper.String(diskBackupId)
 	request.DiskId = helper.String(diskId)
-	ratelimit.Check(request.GetAction())
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 		result, e := me.client.UseCbsClient().ApplyDiskBackup(request)
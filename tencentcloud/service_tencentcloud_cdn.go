@@ -9,7 +9,6 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type CdnService struct {
@@ -33,7 +32,6 @@ func (me *CdnService) DescribeDomainsConfigByDomain(ctx context.Context, domain
 	}
 	request.Filters = append(request.Filters, filter)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCdnClient().DescribeDomainsConfig(request)
 	if err != nil {
 		if sdkErr, ok := err.(*errors.TencentCloudSDKError); ok {
@@ -61,7 +59,6 @@ func (me *CdnService) UpdateDomainConfig(ctx context.Context, request *cdn.Updat
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCdnClient().UpdateDomainConfig(request)
 
 	if err != nil {
@@ -80,7 +77,6 @@ func (me *CdnService) DeleteDomain(ctx context.Context, domain string) error {
 	request := cdn.NewDeleteCdnDomainRequest()
 	request.Domain = &domain
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseCdnClient().DeleteCdnDomain(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -95,7 +91,6 @@ func (me *CdnService) StopDomain(ctx context.Context, domain string) error {
 	request := cdn.NewStopCdnDomainRequest()
 	request.Domain = &domain
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseCdnClient().StopCdnDomain(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -110,7 +105,6 @@ func (me *CdnService) StartDomain(ctx context.Context, domain string) error {
 	request := cdn.NewStartCdnDomainRequest()
 	request.Domain = &domain
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseCdnClient().StartCdnDomain(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -147,7 +141,6 @@ func (me *CdnService) DescribeDomainsConfigByFilters(ctx context.Context,
 
 	for {
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseCdnClient().DescribeDomainsConfig(request)
 
 			if err != nil {
@@ -189,7 +182,6 @@ func (me *CdnService) VerifyDomainRecord(ctx context.Context, domain string) (re
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCdnClient().VerifyDomainRecord(request)
 
 	if err != nil {
@@ -218,7 +210,6 @@ func (me *CdnService) CreateVerifyRecord(ctx context.Context, domain string) (re
 
 	request.Domain = &domain
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCdnClient().CreateVerifyRecord(request)
 
 	if err != nil {
@@ -243,7 +234,6 @@ func (me *CdnService) DescribePurgeTasks(ctx context.Context, request *cdn.Descr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCdnClient().DescribePurgeTasks(request)
 
 	if err != nil {
@@ -270,7 +260,6 @@ func (me *CdnService) DescribePushTasks(ctx context.Context, request *cdn.Descri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCdnClient().DescribePushTasks(request)
 
 	if err != nil {
@@ -297,7 +286,6 @@ func (me *CdnService) PurgeUrlsCache(ctx context.Context, request *cdn.PurgeUrls
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCdnClient().PurgeUrlsCache(request)
 
 	if err != nil {
@@ -324,7 +312,6 @@ func (me *CdnService) PushUrlsCache(ctx context.Context, request *cdn.PushUrlsCa
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCdnClient().PushUrlsCache(request)
 
 	if err != nil {
@@ -9,7 +9,6 @@ import (
 
 	cdwch "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdwch/v20200915"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type CdwchService struct {
@@ -29,8 +28,6 @@ func (me *CdwchService) DescribeInstance(ctx context.Context, instanceId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCdwchClient().DescribeInstance(request)
 	if err != nil {
 		errRet = err
@@ -55,8 +52,6 @@ func (me *CdwchService) DestroyInstance(ctx context.Context, instanceId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCdwchClient().DestroyInstance(request)
 	if err != nil {
 		errRet = err
@@ -82,7 +77,6 @@ func (me *CdwchService) ResizeDisk(ctx context.Context, instanceId string, nodeT
 	request.InstanceId = &instanceId
 	request.Type = &nodeType
 	request.DiskSize = helper.IntInt64(resizeDisk)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseCdwchClient().ResizeDisk(request)
 	if err != nil {
@@ -111,7 +105,6 @@ func (me *CdwchService) ScaleUpInstance(ctx context.Context, instanceId, nodeTyp
 	request.ScaleUpEnableRolling = helper.Bool(true)
 	request.Type = &nodeType
 	request.SpecName = &specName
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseCdwchClient().ScaleUpInstance(request)
 	if err != nil {
@@ -144,7 +137,6 @@ func (me *CdwchService) ScaleOutInstance(ctx context.Context, instanceId string,
 	if shardIps != nil {
 		request.ReduceShardInfo = shardIps
 	}
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseCdwchClient().ScaleOutInstance(request)
 	if err != nil {
@@ -170,8 +162,6 @@ func (me *CdwchService) DescribeInstanceClusters(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCdwchClient().DescribeInstanceClusters(request)
 	if err != nil {
 		errRet = err
@@ -196,8 +186,6 @@ func (me *CdwchService) DescribeInstancesNew(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCdwchClient().DescribeInstancesNew(request)
 	if err != nil {
 		errRet = err
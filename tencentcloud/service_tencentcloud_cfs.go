@@ -14,7 +14,6 @@ import (
 	cfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cfs/v20190719"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type CfsService struct {
@@ -34,7 +33,6 @@ func (me *CfsService) DescribeFileSystem(ctx context.Context, fsId, vpcId, subne
 		request.SubnetId = &subnetId
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCfsClient().DescribeCfsFileSystems(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -54,7 +52,6 @@ func (me *CfsService) DescribeMountTargets(ctx context.Context, fsId string) (ta
 	request := cfs.NewDescribeMountTargetsRequest()
 	request.FileSystemId = &fsId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCfsClient().DescribeMountTargets(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -75,7 +72,6 @@ func (me *CfsService) ModifyFileSystemName(ctx context.Context, fsId, fsName str
 	request.FileSystemId = &fsId
 	request.FsName = &fsName
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCfsClient().UpdateCfsFileSystemName(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -94,7 +90,6 @@ func (me *CfsService) ModifyFileSystemAccessGroup(ctx context.Context, fsId, acc
 	request.FileSystemId = &fsId
 	request.PGroupId = &accessGroupId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCfsClient().UpdateCfsFileSystemPGroup(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -112,7 +107,6 @@ func (me *CfsService) DeleteFileSystem(ctx context.Context, fsId string) error {
 	request := cfs.NewDeleteCfsFileSystemRequest()
 	request.FileSystemId = &fsId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCfsClient().DeleteCfsFileSystem(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -133,7 +127,6 @@ func (me *CfsService) CreateAccessGroup(ctx context.Context, name, description s
 		request.DescInfo = &description
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCfsClient().CreateCfsPGroup(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -155,7 +148,6 @@ func (me *CfsService) CreateAccessGroup(ctx context.Context, name, description s
 func (me *CfsService) DescribeAccessGroup(ctx context.Context, id, name string) (accessGroups []*cfs.PGroupInfo, errRet error) {
 	logId := getLogId(ctx)
 	request := cfs.NewDescribeCfsPGroupsRequest()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCfsClient().DescribeCfsPGroups(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -183,7 +175,6 @@ func (me *CfsService) DeleteAccessGroup(ctx context.Context, id string) error {
 	logId := getLogId(ctx)
 	request := cfs.NewDeleteCfsPGroupRequest()
 	request.PGroupId = &id
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCfsClient().DeleteCfsPGroup(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -200,7 +191,6 @@ func (me *CfsService) DescribeAccessRule(ctx context.Context, accessGroupId, acc
 	logId := getLogId(ctx)
 	request := cfs.NewDescribeCfsRulesRequest()
 	request.PGroupId = &accessGroupId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCfsClient().DescribeCfsRules(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -231,7 +221,6 @@ func (me *CfsService) DeleteAccessRule(ctx context.Context, accessGroupId, acces
 	request := cfs.NewDeleteCfsRuleRequest()
 	request.PGroupId = &accessGroupId
 	request.RuleId = &accessRuleId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCfsClient().DeleteCfsRule(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -256,8 +245,6 @@ func (me *CfsService) DescribeCfsAutoSnapshotPolicyById(ctx context.Context, aut
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -303,8 +290,6 @@ func (me *CfsService) DeleteCfsAutoSnapshotPolicyById(ctx context.Context, autoS
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCfsClient().DeleteAutoSnapshotPolicy(request)
 	if err != nil {
 		errRet = err
@@ -327,8 +312,6 @@ func (me *CfsService) DescribeCfsAutoSnapshotPolicyAttachmentById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -389,8 +372,6 @@ func (me *CfsService) DeleteCfsAutoSnapshotPolicyAttachmentById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCfsClient().UnbindAutoSnapshotPolicy(request)
 	if err != nil {
 		errRet = err
@@ -413,8 +394,6 @@ func (me *CfsService) DescribeCfsSnapshotById(ctx context.Context, snapshotId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -460,8 +439,6 @@ func (me *CfsService) DeleteCfsSnapshotById(ctx context.Context, snapshotId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCfsClient().DeleteCfsSnapshot(request)
 	if err != nil {
 		errRet = err
@@ -500,8 +477,6 @@ func (me *CfsService) DescribeCfsMountTargetsById(ctx context.Context, fileSyste
 
 	request.FileSystemId = helper.String(fileSystemId)
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCfsClient().DescribeMountTargets(request)
 	if err != nil {
 		errRet = err
@@ -528,8 +503,6 @@ func (me *CfsService) DescribeCfsFileSystemClientsById(ctx context.Context, file
 
 	request.FileSystemId = helper.String(fileSystemId)
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCfsClient().DescribeCfsFileSystemClients(request)
 	if err != nil {
 		errRet = err
@@ -566,8 +539,6 @@ func (me *CfsService) DescribeCfsUserQuotaById(ctx context.Context, fileSystemId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -615,8 +586,6 @@ func (me *CfsService) DeleteCfsUserQuotaById(ctx context.Context, fileSystemId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCfsClient().DeleteUserQuota(request)
 	if err != nil {
 		errRet = err
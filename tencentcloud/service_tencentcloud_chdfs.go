@@ -9,7 +9,6 @@ import (
 
 	chdfs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/chdfs/v20201112"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type ChdfsService struct {
@@ -28,8 +27,6 @@ func (me *ChdfsService) DescribeChdfsAccessGroupById(ctx context.Context, access
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseChdfsClient().DescribeAccessGroup(request)
 	if err != nil {
 		errRet = err
@@ -57,8 +54,6 @@ func (me *ChdfsService) DeleteChdfsAccessGroupById(ctx context.Context, accessGr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseChdfsClient().DeleteAccessGroup(request)
 	if err != nil {
 		errRet = err
@@ -81,8 +76,6 @@ func (me *ChdfsService) DescribeChdfsFileSystemById(ctx context.Context, fileSys
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseChdfsClient().DescribeFileSystem(request)
 	if err != nil {
 		errRet = err
@@ -110,8 +103,6 @@ func (me *ChdfsService) DeleteChdfsFileSystemById(ctx context.Context, fileSyste
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseChdfsClient().DeleteFileSystem(request)
 	if err != nil {
 		errRet = err
@@ -148,8 +139,6 @@ func (me *ChdfsService) DescribeChdfsAccessRulesById(ctx context.Context, access
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseChdfsClient().DescribeAccessRules(request)
 	if err != nil {
 		errRet = err
@@ -182,8 +171,6 @@ func (me *ChdfsService) DeleteChdfsAccessRulesById(ctx context.Context, accessRu
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseChdfsClient().DeleteAccessRules(request)
 	if err != nil {
 		errRet = err
@@ -206,8 +193,6 @@ func (me *ChdfsService) DescribeChdfsLifeCycleRuleById(ctx context.Context, file
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseChdfsClient().DescribeLifeCycleRules(request)
 	if err != nil {
 		errRet = err
@@ -240,8 +225,6 @@ func (me *ChdfsService) DescribeChdfsLifeCycleRuleByPath(ctx context.Context, fi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseChdfsClient().DescribeLifeCycleRules(request)
 	if err != nil {
 		errRet = err
@@ -274,8 +257,6 @@ func (me *ChdfsService) DeleteChdfsLifeCycleRuleById(ctx context.Context, lifeCy
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseChdfsClient().DeleteLifeCycleRules(request)
 	if err != nil {
 		errRet = err
@@ -298,8 +279,6 @@ func (me *ChdfsService) DescribeChdfsMountPointById(ctx context.Context, mountPo
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseChdfsClient().DescribeMountPoint(request)
 	if err != nil {
 		errRet = err
@@ -327,8 +306,6 @@ func (me *ChdfsService) DeleteChdfsMountPointById(ctx context.Context, mountPoin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseChdfsClient().DeleteMountPoint(request)
 	if err != nil {
 		errRet = err
@@ -352,8 +329,6 @@ func (me *ChdfsService) DeleteChdfsMountPointAttachmentById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseChdfsClient().DisassociateAccessGroups(request)
 	if err != nil {
 		errRet = err
@@ -385,8 +360,6 @@ func (me *ChdfsService) DescribeChdfsAccessGroupsByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseChdfsClient().DescribeAccessGroups(request)
 	if err != nil {
 		errRet = err
@@ -423,8 +396,6 @@ func (me *ChdfsService) DescribeChdfsMountPointsByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseChdfsClient().DescribeMountPoints(request)
 	if err != nil {
 		errRet = err
@@ -449,8 +420,6 @@ func (me *ChdfsService) DescribeChdfsFileSystems(ctx context.Context) (fileSyste
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseChdfsClient().DescribeFileSystems(request)
 	if err != nil {
 		errRet = err
@@ -16,7 +16,6 @@ import (
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type ClbService struct {
@@ -47,7 +46,6 @@ func (me *ClbService) DescribeLoadBalancerById(ctx context.Context, clbId string
 	logId := getLogId(ctx)
 	request := clb.NewDescribeLoadBalancersRequest()
 	request.LoadBalancerIds = []*string{&clbId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeLoadBalancers(request)
 	if err != nil {
 		errRet = errors.WithStack(err)
@@ -92,7 +90,6 @@ func (me *ClbService) DescribeLoadBalancerByFilter(ctx context.Context, params m
 	for {
 		request.Offset = &(offset)
 		request.Limit = &(pageSize)
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseClbClient().DescribeLoadBalancers(request)
 		if err != nil {
 			errRet = errors.WithStack(err)
@@ -119,7 +116,6 @@ func (me *ClbService) DeleteLoadBalancerById(ctx context.Context, clbId string)
 	logId := getLogId(ctx)
 	request := clb.NewDeleteLoadBalancerRequest()
 	request.LoadBalancerIds = []*string{&clbId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DeleteLoadBalancer(request)
 	if err != nil {
 		if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -144,7 +140,6 @@ func (me *ClbService) DescribeListenerById(ctx context.Context, listenerId strin
 	request := clb.NewDescribeListenersRequest()
 	request.ListenerIds = []*string{&listenerId}
 	request.LoadBalancerId = &clbId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeListeners(request)
 	if err != nil {
 		if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -191,7 +186,6 @@ func (me *ClbService) DescribeListenersByFilter(ctx context.Context, params map[
 	}
 
 	listeners = make([]*clb.Listener, 0)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeListeners(request)
 	if err != nil {
 		if e, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -215,7 +209,6 @@ func (me *ClbService) DeleteListenerById(ctx context.Context, clbId string, list
 	request := clb.NewDeleteListenerRequest()
 	request.ListenerId = &listenerId
 	request.LoadBalancerId = &clbId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DeleteListener(request)
 	if err != nil {
 		return errors.WithStack(err)
@@ -234,7 +227,6 @@ func (me *ClbService) DescribeTaskStatusById(ctx context.Context, taskId string)
 	logId := getLogId(ctx)
 	request := clb.NewDescribeTaskStatusRequest()
 	request.TaskId = &taskId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeTaskStatus(request)
 	if err != nil {
 		return 0, errors.WithStack(err)
@@ -283,7 +275,6 @@ func (me *ClbService) DescribeRulesByFilter(ctx context.Context, params map[stri
 	}
 	request.LoadBalancerId = &clbId
 	request.ListenerIds = []*string{&listenerId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeListeners(request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
@@ -336,7 +327,6 @@ func (me *ClbService) DescribeRuleByPara(ctx context.Context, clbId string, list
 	request := clb.NewDescribeListenersRequest()
 	request.ListenerIds = []*string{&listenerId}
 	request.LoadBalancerId = &clbId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeListeners(request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
@@ -381,7 +371,6 @@ func (me *ClbService) DeleteRuleById(ctx context.Context, clbId string, listener
 	request.ListenerId = &listenerId
 	request.LoadBalancerId = &clbId
 	request.LocationIds = []*string{&locationId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DeleteRule(request)
 	if err != nil {
 		return errors.WithStack(err)
@@ -401,7 +390,6 @@ func (me *ClbService) DescribeAttachmentByPara(ctx context.Context, clbId string
 	request := clb.NewDescribeListenersRequest()
 	request.ListenerIds = []*string{&listenerId}
 	request.LoadBalancerId = &clbId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeListeners(request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
@@ -429,7 +417,6 @@ func (me *ClbService) DescribeAttachmentByPara(ctx context.Context, clbId string
 	aRequest.LoadBalancerId = &clbId
 	aRequest.Protocol = protocol
 	aRequest.Port = port
-	ratelimit.Check(request.GetAction())
 	aResponse, aErr := me.client.UseClbClient().DescribeTargets(aRequest)
 
 	if aErr != nil {
@@ -478,7 +465,6 @@ func (me *ClbService) DescribeAttachmentsByFilter(ctx context.Context, params ma
 	}
 	request.LoadBalancerId = &clbId
 	request.ListenerIds = []*string{&listenerId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeListeners(request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
@@ -505,7 +491,6 @@ func (me *ClbService) DescribeAttachmentsByFilter(ctx context.Context, params ma
 	aRequest.LoadBalancerId = &clbId
 	aRequest.Protocol = protocol
 	aRequest.Port = port
-	ratelimit.Check(request.GetAction())
 	aResponse, aErr := me.client.UseClbClient().DescribeTargets(aRequest)
 
 	if aErr != nil {
@@ -542,7 +527,6 @@ func (me *ClbService) DeleteAttachmentById(ctx context.Context, clbId string, li
 	if locationId != "" {
 		request.LocationId = &locationId
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DeregisterTargets(request)
 	if err != nil {
 		ee, ok := err.(*sdkErrors.TencentCloudSDKError)
@@ -579,7 +563,6 @@ func (me *ClbService) DescribeRedirectionById(ctx context.Context, rewriteId str
 	request.LoadBalancerId = &clbId
 	request.SourceListenerIds = []*string{&sourceListenerId}
 	request.SourceLocationIds = []*string{&sourceLocId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeRewrite(request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
@@ -630,7 +613,6 @@ func (me *ClbService) DescribeAllAutoRedirections(ctx context.Context, rewriteId
 	request := clb.NewDescribeRewriteRequest()
 	request.LoadBalancerId = &clbId
 	request.SourceListenerIds = []*string{&sourceListenerId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeRewrite(request)
 	if err != nil {
 		//in case that the lb is not exist, return empty
@@ -701,7 +683,6 @@ func (me *ClbService) DescribeRedirectionsByFilter(ctx context.Context, params m
 	request.LoadBalancerId = &clbId
 	request.SourceListenerIds = []*string{&sourceListenerId}
 	request.SourceLocationIds = []*string{&sourceLocId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeRewrite(request)
 	if err != nil {
 		errRet = err
@@ -755,7 +736,6 @@ func (me *ClbService) DeleteRedirectionById(ctx context.Context, rewriteId strin
 	rewriteInfo.SourceLocationId = &sourceLocId
 	rewriteInfo.TargetLocationId = &targetLocId
 	request.RewriteInfos = []*clb.RewriteLocationMap{&rewriteInfo}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DeleteRewrite(request)
 	if err != nil {
 		return errors.WithStack(err)
@@ -1098,7 +1078,6 @@ func (me *ClbService) CreateTopic(ctx context.Context, params map[string]interfa
 	}
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		resp, ok := me.client.UseClbClient().CreateTopic(request)
 		if ok != nil {
 			err = ok
@@ -1215,7 +1194,6 @@ func (me *ClbService) DescribeTargetGroups(ctx context.Context, targetGroupId st
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseClbClient().DescribeTargetGroups(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -1255,7 +1233,6 @@ func (me *ClbService) DescribeTargetGroupInstances(ctx context.Context, filters
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseClbClient().DescribeTargetGroupInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
@@ -1295,7 +1272,6 @@ func (me *ClbService) AssociateTargetGroups(ctx context.Context, listenerId, clb
 	request.Associations = append(request.Associations, &association)
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseClbClient().AssociateTargetGroups(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -1373,7 +1349,6 @@ func (me *ClbService) DisassociateTargetGroups(ctx context.Context, targetGroupI
 	}
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseClbClient().DisassociateTargetGroups(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -1396,7 +1371,6 @@ func (me *ClbService) ModifyTargetGroupInstancesWeight(ctx context.Context, targ
 	request.TargetGroupInstances = []*clb.TargetGroupInstance{&instance}
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseClbClient().ModifyTargetGroupInstancesWeight(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -1421,7 +1395,6 @@ func (me *ClbService) ModifyTargetGroupInstancesPort(ctx context.Context, target
 	request.TargetGroupInstances = []*clb.TargetGroupInstance{&instance}
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseClbClient().ModifyTargetGroupInstancesPort(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -1444,7 +1417,6 @@ func (me *ClbService) ModifyTargetPort(ctx context.Context, loadBalancerId, list
 	request.NewPort = &newPort
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseClbClient().ModifyTargetPort(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -1467,7 +1439,6 @@ func (me *ClbService) ModifyTargetWeight(ctx context.Context, loadBalancerId, li
 	request.Weight = &weight
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseClbClient().ModifyTargetWeight(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -1490,7 +1461,6 @@ func (me *ClbService) DescribeClbLogSet(ctx context.Context) (logSetId string, h
 				logId, "delete object", request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeClsLogSet(request)
 	if err != nil {
 		errRet = err
@@ -1513,7 +1483,6 @@ func (me *ClbService) CreateClbLogSet(ctx context.Context, name string, logsetTy
 	if logsetType != "" {
 		request.LogsetType = &logsetType
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().CreateClsLogSet(request)
 	if err != nil {
 		errRet = err
@@ -1538,7 +1507,6 @@ func (me *ClbService) UpdateClsLogSet(ctx context.Context, request *cls.ModifyLo
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClsClient().ModifyLogset(request)
 	if err != nil {
 		errRet = err
@@ -1555,7 +1523,6 @@ func (me *ClbService) DescribeLbCustomizedConfigById(ctx context.Context, config
 	request := clb.NewDescribeCustomizedConfigListRequest()
 	request.UconfigIds = []*string{&configId}
 	request.ConfigType = helper.String("CLB")
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DescribeCustomizedConfigList(request)
 	if err != nil {
 		errRet = errors.WithStack(err)
@@ -1576,7 +1543,6 @@ func (me *ClbService) DeleteLbCustomizedConfigById(ctx context.Context, configId
 	request := clb.NewSetCustomizedConfigForLoadBalancerRequest()
 	request.OperationType = helper.String("DELETE")
 	request.UconfigId = helper.String(configId)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().SetCustomizedConfigForLoadBalancer(request)
 	if err != nil {
 		errRet = errors.WithStack(err)
@@ -1598,7 +1564,6 @@ func (me *ClbService) BindOrUnBindCustomizedConfigWithLbId(ctx context.Context,
 		lbId := lbIdsList[i].(string)
 		request.LoadBalancerIds = append(request.LoadBalancerIds, &lbId)
 	}
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseClbClient().SetCustomizedConfigForLoadBalancer(request)
 	if err != nil {
@@ -1623,7 +1588,6 @@ func (me *ClbService) CreateLoadBalancerSnatIps(ctx context.Context, id string,
 	request.LoadBalancerId = &id
 	request.SnatIps = ips
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().CreateLoadBalancerSnatIps(request)
 
 	if err != nil {
@@ -1652,7 +1616,6 @@ func (me *ClbService) DeleteLoadBalancerSnatIps(ctx context.Context, id string,
 	request.LoadBalancerId = &id
 	request.Ips = ips
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseClbClient().DeleteLoadBalancerSnatIps(request)
 
 	if err != nil {
@@ -1691,8 +1654,6 @@ func (me *ClbService) DescribeClbFunctionTargetsAttachmentById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClbClient().DescribeTargets(request)
 	if err != nil {
 		errRet = err
@@ -1732,8 +1693,6 @@ func (me *ClbService) DeleteClbFunctionTargetsAttachmentById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClbClient().DeregisterFunctionTargets(request)
 	if err != nil {
 		errRet = err
@@ -1762,8 +1721,6 @@ func (me *ClbService) DescribeClbClusterResourcesByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1810,8 +1767,6 @@ func (me *ClbService) DescribeClbCrossTargetsByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1858,8 +1813,6 @@ func (me *ClbService) DescribeClbExclusiveClustersByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1906,8 +1859,6 @@ func (me *ClbService) DescribeClbIdleInstancesByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1954,8 +1905,6 @@ func (me *ClbService) DescribeClbListenersByTargets(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClbClient().DescribeLBListeners(request)
 	if err != nil {
 		errRet = err
@@ -1986,8 +1935,6 @@ func (me *ClbService) DescribeClbInstanceByCertId(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClbClient().DescribeLoadBalancerListByCertId(request)
 	if err != nil {
 		errRet = err
@@ -2018,8 +1965,6 @@ func (me *ClbService) DescribeClbInstanceTraffic(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClbClient().DescribeLoadBalancerTraffic(request)
 	if err != nil {
 		errRet = err
@@ -2056,8 +2001,6 @@ func (me *ClbService) DescribeClbInstanceDetailByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -2104,8 +2047,6 @@ func (me *ClbService) DescribeClbResourcesByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -2155,8 +2096,6 @@ func (me *ClbService) DescribeClbTargetGroupListByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -2203,8 +2142,6 @@ func (me *ClbService) DescribeClbTargetHealthByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClbClient().DescribeTargetHealth(request)
 	if err != nil {
 		errRet = err
@@ -2231,8 +2168,6 @@ func (me *ClbService) SetClbSecurityGroup(ctx context.Context, securityGroup str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseClbClient().SetSecurityGroupForLoadbalancers(request)
 	if err != nil {
 		errRet = err
@@ -15,7 +15,6 @@ import (
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type CvmService struct {
@@ -39,7 +38,6 @@ func (me *CvmService) DescribeInstanceSetByIds(ctx context.Context, instanceSetI
 
 	request.Limit = helper.IntInt64(100)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().DescribeInstances(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -62,7 +60,6 @@ func (me *CvmService) DescribeInstanceById(ctx context.Context, instanceId strin
 	request := cvm.NewDescribeInstancesRequest()
 	request.InstanceIds = []*string{&instanceId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().DescribeInstances(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -102,7 +99,6 @@ func (me *CvmService) DescribeInstanceByFilter(ctx context.Context, instancesId
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCvmClient().DescribeInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -180,7 +176,6 @@ func (me *CvmService) DescribeInstanceInParallelByFilter(ctx context.Context, fi
 			request.Offset = helper.IntInt64(offset)
 			request.Limit = helper.IntInt64(limit)
 
-			ratelimit.Check(request.GetAction())
 			response, err := me.client.UseCvmClient().DescribeInstances(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -214,7 +209,6 @@ func (me *CvmService) ModifyInstanceName(ctx context.Context, instanceId, instan
 	request.InstanceIds = []*string{&instanceId}
 	request.InstanceName = &instanceName
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().ModifyInstancesAttribute(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -233,7 +227,6 @@ func (me *CvmService) ModifySecurityGroups(ctx context.Context, instanceId strin
 	request.InstanceIds = []*string{&instanceId}
 	request.SecurityGroups = securityGroups
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().ModifyInstancesAttribute(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -252,7 +245,6 @@ func (me *CvmService) ModifyDisableApiTermination(ctx context.Context, instanceI
 	request.InstanceIds = []*string{&instanceId}
 	request.DisableApiTermination = &disableApiTermination
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().ModifyInstancesAttribute(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -271,7 +263,6 @@ func (me *CvmService) ModifyProjectId(ctx context.Context, instanceId string, pr
 	request.InstanceIds = []*string{&instanceId}
 	request.ProjectId = &projectId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().ModifyInstancesProject(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -291,7 +282,6 @@ func (me *CvmService) ModifyInstanceType(ctx context.Context, instanceId, instan
 	request.InstanceType = &instanceType
 	request.ForceStop = helper.Bool(true)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().ResetInstancesType(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -312,7 +302,6 @@ func (me *CvmService) ModifyPassword(ctx context.Context, instanceId, password s
 	forceStop := true
 	request.ForceStop = &forceStop
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().ResetInstancesPassword(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -334,7 +323,6 @@ func (me *CvmService) ModifyInternetMaxBandwidthOut(ctx context.Context, instanc
 		InternetMaxBandwidthOut: &internetMaxBandWidthOut,
 	}
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseCvmClient().ResetInstancesInternetMaxBandwidth(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -356,7 +344,6 @@ func (me *CvmService) ModifyVpc(ctx context.Context, instanceId, vpcId, subnetId
 		request.VirtualPrivateCloud.PrivateIpAddresses = []*string{&privateIp}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().ModifyInstancesVpcAttribute(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -377,7 +364,6 @@ func (me *CvmService) StopInstance(ctx context.Context, instanceId string, stopp
 		request.StoppedMode = &stoppedMode
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().StopInstances(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -395,7 +381,6 @@ func (me *CvmService) StartInstance(ctx context.Context, instanceId string) erro
 	request := cvm.NewStartInstancesRequest()
 	request.InstanceIds = []*string{&instanceId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().StartInstances(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -413,7 +398,6 @@ func (me *CvmService) DeleteInstance(ctx context.Context, instanceId string) err
 	request := cvm.NewTerminateInstancesRequest()
 	request.InstanceIds = []*string{&instanceId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().TerminateInstances(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -441,7 +425,6 @@ func (me *CvmService) DeleteInstanceSetByIds(ctx context.Context, instanceSetIds
 		request.InstanceIds = append(request.InstanceIds, instanceId)
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().TerminateInstances(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -456,7 +439,6 @@ func (me *CvmService) DeleteInstanceSetByIds(ctx context.Context, instanceSetIds
 
 func (me *CvmService) ResetInstance(ctx context.Context, request *cvm.ResetInstanceRequest) (errRet error) {
 	logId := getLogId(ctx)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseCvmClient().ResetInstance(request)
 
@@ -483,7 +465,6 @@ func (me *CvmService) DescribeInstanceTypes(ctx context.Context, zone string) (i
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().DescribeInstanceTypeConfigs(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -514,7 +495,6 @@ func (me *CvmService) DescribeInstanceTypesByFilter(ctx context.Context, filters
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().DescribeInstanceTypeConfigs(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -545,7 +525,6 @@ func (me *CvmService) DescribeInstancesSellTypeByFilter(ctx context.Context, fil
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().DescribeZoneInstanceConfigInfos(request)
 	if err != nil {
 		//deal with not supported error
@@ -568,7 +547,6 @@ func (me *CvmService) DescribeKeyPairById(ctx context.Context, keyId string) (ke
 	request := cvm.NewDescribeKeyPairsRequest()
 	request.KeyIds = []*string{&keyId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().DescribeKeyPairs(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -613,7 +591,6 @@ func (me *CvmService) DescribeKeyPairByFilter(ctx context.Context, id, name stri
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCvmClient().DescribeKeyPairs(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -642,7 +619,6 @@ func (me *CvmService) ModifyKeyPairName(ctx context.Context, keyId, keyName stri
 	request.KeyId = &keyId
 	request.KeyName = &keyName
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().ModifyKeyPairAttribute(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -660,7 +636,6 @@ func (me *CvmService) DeleteKeyPair(ctx context.Context, keyId string) error {
 	request := cvm.NewDeleteKeyPairsRequest()
 	request.KeyIds = []*string{&keyId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().DeleteKeyPairs(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -680,7 +655,6 @@ func (me *CvmService) UnbindKeyPair(ctx context.Context, keyIds []*string, insta
 	request.InstanceIds = instanceIds
 	request.ForceStop = helper.Bool(true)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().DisassociateInstancesKeyPairs(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -700,7 +674,6 @@ func (me *CvmService) BindKeyPair(ctx context.Context, keyIds []*string, instanc
 	request.InstanceIds = []*string{&instanceId}
 	request.ForceStop = helper.Bool(true)
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseCvmClient().AssociateInstancesKeyPairs(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -717,7 +690,6 @@ func (me *CvmService) CreatePlacementGroup(ctx context.Context, placementName, p
 	request.Name = &placementName
 	request.Type = &placementType
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().CreateDisasterRecoverGroup(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -741,7 +713,6 @@ func (me *CvmService) DescribePlacementGroupById(ctx context.Context, placementI
 	request := cvm.NewDescribeDisasterRecoverGroupsRequest()
 	request.DisasterRecoverGroupIds = []*string{&placementId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().DescribeDisasterRecoverGroups(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -775,7 +746,6 @@ func (me *CvmService) DescribePlacementGroupByFilter(ctx context.Context, id, na
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCvmClient().DescribeDisasterRecoverGroups(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -804,7 +774,6 @@ func (me *CvmService) ModifyPlacementGroup(ctx context.Context, placementId, nam
 	request.DisasterRecoverGroupId = &placementId
 	request.Name = &name
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().ModifyDisasterRecoverGroupAttribute(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -822,7 +791,6 @@ func (me *CvmService) DeletePlacementGroup(ctx context.Context, placementId stri
 	request := cvm.NewDeleteDisasterRecoverGroupsRequest()
 	request.DisasterRecoverGroupIds = []*string{&placementId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().DeleteDisasterRecoverGroups(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -839,7 +807,6 @@ func (me *CvmService) DescribeRegions(ctx context.Context) (zones []*cvm.RegionI
 	logId := getLogId(ctx)
 	request := cvm.NewDescribeRegionsRequest()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().DescribeRegions(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -858,7 +825,6 @@ func (me *CvmService) DescribeZones(ctx context.Context) (zones []*cvm.ZoneInfo,
 	logId := getLogId(ctx)
 	request := cvm.NewDescribeZonesRequest()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().DescribeZones(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -883,7 +849,6 @@ func (me *CvmService) CreateReservedInstance(ctx context.Context, configId strin
 		request.ReservedInstanceName = &reservedInstanceName
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().PurchaseReservedInstancesOffering(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -920,7 +885,6 @@ func (me *CvmService) DescribeReservedInstanceByFilter(ctx context.Context, filt
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCvmClient().DescribeReservedInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -961,7 +925,6 @@ func (me *CvmService) DescribeReservedInstanceConfigs(ctx context.Context, filte
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCvmClient().DescribeReservedInstancesOfferings(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1028,7 +991,6 @@ func (me *CvmService) ModifyImage(ctx context.Context, instanceId, imageName, im
 	request.ImageDescription = helper.String(imageDesc)
 
 	err := resource.Retry(6*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, e := me.client.UseCvmClient().ModifyImageAttribute(request)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1053,7 +1015,6 @@ func (me *CvmService) DeleteImage(ctx context.Context, imageId string) error {
 	request := cvm.NewDeleteImagesRequest()
 	request.ImageIds = []*string{&imageId}
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseCvmClient().DeleteImages(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1071,7 +1032,6 @@ func (me *CvmService) DescribeImageById(ctx context.Context, keyId string, isDel
 
 	var imgRsp *cvm.DescribeImagesResponse
 	err := resource.Retry(20*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCvmClient().DescribeImages(request)
 		if err != nil {
 			return resource.RetryableError(err)
@@ -1137,7 +1097,6 @@ func (me *CvmService) DescribeImagesByFilter(ctx context.Context, filters map[st
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCvmClient().DescribeImages(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1167,7 +1126,6 @@ func (me *CvmService) ModifyRenewParam(ctx context.Context, instanceId string, r
 	request.InstanceIds = []*string{&instanceId}
 	request.RenewFlag = &renewFlag
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().ModifyInstancesRenewFlag(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1196,7 +1154,6 @@ func (me *CvmService) ModifyInstanceChargeType(ctx context.Context, instanceId s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().ModifyInstancesChargeType(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1219,7 +1176,6 @@ func (me *CvmService) ResizeInstanceDisks(ctx context.Context, request *cvm.Resi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().ResizeInstanceDisks(request)
 
 	if err != nil {
@@ -1245,8 +1201,6 @@ func (me *CvmService) DescribeCvmHpcClusterById(ctx context.Context, hpcClusterI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1292,8 +1246,6 @@ func (me *CvmService) DeleteCvmHpcClusterById(ctx context.Context, hpcClusterId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCvmClient().DeleteHpcClusters(request)
 	if err != nil {
 		errRet = err
@@ -1316,8 +1268,6 @@ func (me *CvmService) DescribeCvmLaunchTemplateById(ctx context.Context, launchT
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCvmClient().DescribeLaunchTemplates(request)
 	if err != nil {
 		errRet = err
@@ -1345,8 +1295,6 @@ func (me *CvmService) DescribeLaunchTemplateVersionsById(ctx context.Context, la
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCvmClient().DescribeLaunchTemplateVersions(request)
 	if err != nil {
 		errRet = err
@@ -1377,7 +1325,6 @@ func (me *CvmService) DescribeLaunchTemplateVersions(ctx context.Context, launch
 	offect := 0
 	limit := 50
 	for {
-		ratelimit.Check(request.GetAction())
 		request.Offset = helper.IntUint64(offect)
 		request.Limit = helper.IntUint64(limit)
 		response, err := me.client.UseCvmClient().DescribeLaunchTemplateVersions(request)
@@ -1413,8 +1360,6 @@ func (me *CvmService) DeleteCvmLaunchTemplateById(ctx context.Context, launchTem
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCvmClient().DeleteLaunchTemplate(request)
 	if err != nil {
 		errRet = err
@@ -1438,8 +1383,6 @@ func (me *CvmService) DescribeCvmLaunchTemplateVersionById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCvmClient().DescribeLaunchTemplateVersions(request)
 	if err != nil {
 		errRet = err
@@ -1468,8 +1411,6 @@ func (me *CvmService) DeleteCvmLaunchTemplateVersionById(ctx context.Context, la
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCvmClient().DeleteLaunchTemplateVersions(request)
 	if err != nil {
 		errRet = err
@@ -1486,7 +1427,6 @@ func (me *CvmService) ModifyLaunchTemplateDefaultVersion(ctx context.Context, la
 	request.LaunchTemplateId = helper.String(launchTemplateId)
 	request.DefaultVersion = helper.IntInt64(defaultVersion)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient().ModifyLaunchTemplateDefaultVersion(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -1525,8 +1465,6 @@ func (me *CvmService) DescribeCvmChcHostsByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1573,8 +1511,6 @@ func (me *CvmService) DescribeCvmChcDeniedActionsByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCvmClient().DescribeChcDeniedActions(request)
 	if err != nil {
 		errRet = err
@@ -1602,8 +1538,6 @@ func (me *CvmService) DeleteCvmChcAssistVpcById(ctx context.Context, chcId strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCvmClient().RemoveChcAssistVpc(request)
 	if err != nil {
 		errRet = err
@@ -1685,8 +1619,6 @@ func (me *CvmService) DescribeCvmImageQuotaByFilter(ctx context.Context, param m
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCvmClient().DescribeImageQuota(request)
 	if err != nil {
 		errRet = err
@@ -1721,8 +1653,6 @@ func (me *CvmService) DescribeCvmImageSharePermissionByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseCvmClient().DescribeImageSharePermission(request)
 	if err != nil {
 		errRet = err
@@ -1750,7 +1680,6 @@ func (me *CvmService) ModifyImageSharePermission(ctx context.Context, imageId, p
 	request.ImageId = helper.String(imageId)
 	request.Permission = helper.String(permission)
 	request.AccountIds = helper.StringsStringsPoint(accountIds)
-	ratelimit.Check(request.GetAction())
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
 		result, e := me.client.UseCvmClient().ModifyImageSharePermission(request)
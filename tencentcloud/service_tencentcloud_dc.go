@@ -7,7 +7,6 @@ import (
 
 	dc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dc/v20180410"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type DcService struct {
@@ -87,7 +86,6 @@ getMoreData:
 	}
 	request.Limit = &limit
 	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDcClient().DescribeDirectConnects(request)
 	if err != nil {
 		errRet = err
@@ -166,7 +164,6 @@ getMoreData:
 	}
 	request.Limit = &limit
 	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDcClient().DescribeDirectConnectTunnels(request)
 	if err != nil {
 		errRet = err
@@ -247,7 +244,6 @@ func (me *DcService) CreateDirectConnectTunnel(ctx context.Context, dcId, dcxNam
 	if customerAddress != "" {
 		request.CustomerAddress = &customerAddress
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDcClient().CreateDirectConnectTunnel(request)
 	if err != nil {
 		errRet = err
@@ -275,7 +271,6 @@ func (me *DcService) DeleteDirectConnectTunnel(ctx context.Context, dcxId string
 	}()
 
 	request.DirectConnectTunnelId = &dcxId
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseDcClient().DeleteDirectConnectTunnel(request)
 	if err != nil {
 		errRet = err
@@ -327,7 +322,6 @@ func (me *DcService) ModifyDirectConnectTunnelAttribute(ctx context.Context, dcx
 			request.RouteFilterPrefixes = append(request.RouteFilterPrefixes, &dcPrefix)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseDcClient().ModifyDirectConnectTunnelAttribute(request)
 	if err != nil {
 		errRet = err
@@ -347,8 +341,6 @@ func (me *DcService) DescribeDcShareDcxConfigById(ctx context.Context, directCon
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcClient().DescribeDirectConnectTunnels(request)
 	if err != nil {
 		errRet = err
@@ -375,8 +367,6 @@ func (me *DcService) DescribeDcInternetAddressById(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcClient().DescribeInternetAddress(request)
 	if err != nil {
 		errRet = err
@@ -405,8 +395,6 @@ func (me *DcService) DeleteDcInternetAddressById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcClient().ReleaseInternetAddress(request)
 	if err != nil {
 		errRet = err
@@ -429,8 +417,6 @@ func (me *DcService) DescribeDcxExtraConfigById(ctx context.Context, directConne
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcClient().DescribeDirectConnectTunnelExtra(request)
 	if err != nil {
 		errRet = err
@@ -454,8 +440,6 @@ func (me *DcService) DescribeDcInternetAddressQuota(ctx context.Context) (Intern
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcClient().DescribeInternetAddressQuota(request)
 	if err != nil {
 		errRet = err
@@ -480,8 +464,6 @@ func (me *DcService) DescribeDcInternetAddressStatistics(ctx context.Context) (s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcClient().DescribeInternetAddressStatistics(request)
 	if err != nil {
 		errRet = err
@@ -514,8 +496,6 @@ func (me *DcService) DescribeDcPublicDirectConnectTunnelRoutesByFilter(ctx conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -556,8 +536,6 @@ func (me *DcService) DeleteDcInstanceById(ctx context.Context, directConnectId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcClient().DeleteDirectConnect(request)
 	if err != nil {
 		errRet = err
@@ -586,8 +564,6 @@ func (me *DcService) DescribeDcAccessPointsByFilter(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -11,7 +11,6 @@ import (
 	dcdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dcdb/v20180411"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type DcdbService struct {
@@ -78,7 +77,6 @@ func (me *DcdbService) DeleteDcdbAccountById(ctx context.Context, instanceId, us
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDcdbClient().DeleteAccount(request)
 	if err != nil {
 		errRet = err
@@ -185,7 +183,6 @@ func (me *DcdbService) DeleteDcdbHourdbInstanceById(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDcdbClient().DestroyHourDCDBInstance(request)
 	if err != nil {
 		errRet = err
@@ -244,7 +241,6 @@ func (me *DcdbService) DeleteDcdbSecurityGroupAttachmentById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDcdbClient().DisassociateSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -313,7 +309,6 @@ func (me *DcdbService) DescribeDcdbInstancesByFilter(ctx context.Context, params
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 20
@@ -322,7 +317,6 @@ func (me *DcdbService) DescribeDcdbInstancesByFilter(ctx context.Context, params
 		request.Offset = &offset
 		request.Limit = &pageSize
 
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDcdbClient().DescribeDCDBInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -364,13 +358,11 @@ func (me *DcdbService) DescribeDcdbAccountsByFilter(ctx context.Context, param m
 			request.InstanceId = v.(*string)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 20
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDcdbClient().DescribeAccounts(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -412,13 +404,11 @@ func (me *DcdbService) DescribeDcdbDatabasesByFilter(ctx context.Context, param
 			request.InstanceId = v.(*string)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 20
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDcdbClient().DescribeDatabases(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -461,7 +451,6 @@ func (me *DcdbService) DescribeDcdbParametersByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDcdbClient().DescribeDBParameters(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -500,7 +489,6 @@ func (me *DcdbService) DescribeDcdbShardsByFilter(ctx context.Context, param map
 			request.ShardInstanceIds = v.([]*string)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 20
@@ -508,7 +496,6 @@ func (me *DcdbService) DescribeDcdbShardsByFilter(ctx context.Context, param map
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDcdbClient().DescribeDCDBShards(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -552,7 +539,6 @@ func (me *DcdbService) DescribeDcdbSecurityGroupsByFilter(ctx context.Context, p
 	}
 	request.Product = helper.String("dcdb") // api only use this fixed value
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDcdbClient().DescribeDBSecurityGroups(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -581,8 +567,6 @@ func (me *DcdbService) DeleteDcdbDbInstanceById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DestroyDCDBInstance(request)
 	if err != nil {
 		errRet = err
@@ -605,8 +589,6 @@ func (me *DcdbService) DescribeDcnDetailById(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeDcnDetail(request)
 	if err != nil {
 		errRet = err
@@ -647,8 +629,6 @@ func (me *DcdbService) DescribeDcdbFlowById(ctx context.Context, flowId *int64)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeFlow(request)
 	if err != nil {
 		errRet = err
@@ -730,8 +710,6 @@ func (me *DcdbService) DescribeDcdbAccountPrivilegesById(ctx context.Context, id
 		request.ColName = all
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeAccountPrivileges(request)
 	if err != nil {
 		errRet = err
@@ -755,8 +733,6 @@ func (me *DcdbService) DescribeDcdbDatabases(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeDatabases(request)
 	if err != nil {
 		errRet = err
@@ -782,8 +758,6 @@ func (me *DcdbService) DescribeDcdbDBTables(ctx context.Context, instanceId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeDatabaseTable(request)
 	if err != nil {
 		errRet = err
@@ -808,8 +782,6 @@ func (me *DcdbService) DescribeDcdbDBObjects(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeDatabaseObjects(request)
 	if err != nil {
 		errRet = err
@@ -834,8 +806,6 @@ func (me *DcdbService) DescribeDcdbDbParametersById(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeDBParameters(request)
 	if err != nil {
 		errRet = err
@@ -927,8 +897,6 @@ func (me *DcdbService) DescribeDcdbDbInstanceDetailById(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeDCDBInstanceDetail(request)
 	if err != nil {
 		errRet = err
@@ -952,8 +920,6 @@ func (me *DcdbService) DeleteDcdbDedicatedClusterDbInstanceById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().TerminateDedicatedDBInstance(request)
 	if err != nil {
 		errRet = err
@@ -976,8 +942,6 @@ func (me *DcdbService) DescribeDcdbEncryptAttributesConfigById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeDBEncryptAttributes(request)
 	if err != nil {
 		errRet = err
@@ -1001,8 +965,6 @@ func (me *DcdbService) DescribeDcdbDbSyncModeConfigById(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeDBSyncMode(request)
 	if err != nil {
 		errRet = err
@@ -1213,8 +1175,6 @@ func (me *DcdbService) DescribeDcdbFileDownloadUrlByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeFileDownloadUrl(request)
 	if err != nil {
 		errRet = err
@@ -1254,8 +1214,6 @@ func (me *DcdbService) DescribeDcdbLogFilesByFilter(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeDBLogFiles(request)
 	if err != nil {
 		errRet = err
@@ -1289,8 +1247,6 @@ func (me *DcdbService) DescribeDcdbInstanceNodeInfoByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1337,8 +1293,6 @@ func (me *DcdbService) DescribeDcdbOrdersByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeOrders(request)
 	if err != nil {
 		errRet = err
@@ -1397,8 +1351,6 @@ func (me *DcdbService) DescribeDcdbPriceByFilter(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeDCDBPrice(request)
 	if err != nil {
 		errRet = err
@@ -1426,8 +1378,6 @@ func (me *DcdbService) DescribeDcdbProjectsByFilter(ctx context.Context) (projec
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeProjects(request)
 	if err != nil {
 		errRet = err
@@ -1464,8 +1414,6 @@ func (me *DcdbService) DescribeDcdbProjectSecurityGroupsByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeProjectSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -1505,8 +1453,6 @@ func (me *DcdbService) DescribeDcdbRenewalPriceByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeDCDBRenewalPrice(request)
 	if err != nil {
 		errRet = err
@@ -1534,8 +1480,6 @@ func (me *DcdbService) DescribeDcdbSaleInfoByFilter(ctx context.Context, param m
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeDCDBSaleInfo(request)
 	if err != nil {
 		errRet = err
@@ -1619,8 +1563,6 @@ func (me *DcdbService) DescribeDcdbSlowLogsByFilter(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1683,8 +1625,6 @@ func (me *DcdbService) DescribeDcdbUpgradePriceByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDcdbClient().DescribeDCDBUpgradePrice(request)
 	if err != nil {
 		errRet = err
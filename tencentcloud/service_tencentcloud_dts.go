@@ -11,7 +11,6 @@ import (
 	dts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dts/v20211206"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 const (
@@ -98,7 +97,6 @@ func (me *DtsService) DescribeDtsSyncJobsByFilter(ctx context.Context, param map
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -106,7 +104,6 @@ func (me *DtsService) DescribeDtsSyncJobsByFilter(ctx context.Context, param map
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDtsClient().DescribeSyncJobs(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -142,7 +139,6 @@ func (me *DtsService) IsolateDtsSyncJobById(ctx context.Context, jobId string) (
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDtsClient().IsolateSyncJob(request)
 	if err != nil {
 		errRet = err
@@ -167,7 +163,6 @@ func (me *DtsService) DestroyDtsSyncJobById(ctx context.Context, jobId string) (
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDtsClient().DestroySyncJob(request)
 	if err != nil {
 		errRet = err
@@ -202,7 +197,6 @@ func (me *DtsService) DeleteDtsSyncJobById(ctx context.Context, jobId string) (e
 	//      return err
 	// }
 
-	ratelimit.Check(request.GetAction())
 	err = resource.Retry(3*writeRetryTimeout, func() *resource.RetryError {
 		request.JobId = helper.String(jobId)
 		_, err := me.client.UseDtsClient().DestroySyncJob(request)
@@ -271,7 +265,6 @@ func (me *DtsService) DescribeDtsCompareTasksByFilter(ctx context.Context, param
 			request.JobId = v.(*string)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -279,7 +272,6 @@ func (me *DtsService) DescribeDtsCompareTasksByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDtsClient().DescribeCompareTasks(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -349,7 +341,6 @@ func (me *DtsService) StopDtsCompareById(ctx context.Context, jobId, compareTask
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDtsClient().StopCompare(request)
 	if err != nil {
 		errRet = err
@@ -391,7 +382,6 @@ func (me *DtsService) DeleteDtsCompareTaskById(ctx context.Context, jobId, compa
 	request.JobId = helper.String(jobId)
 	request.CompareTaskId = helper.String(compareTaskId)
 
-	ratelimit.Check(request.GetAction())
 	response, err = me.client.UseDtsClient().DeleteCompareTask(request)
 	if err != nil {
 		errRet = err
@@ -502,7 +492,6 @@ func (me *DtsService) DescribeDtsMigrateJobsByFilter(ctx context.Context, param
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -510,7 +499,6 @@ func (me *DtsService) DescribeDtsMigrateJobsByFilter(ctx context.Context, param
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseDtsClient().DescribeMigrationJobs(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -573,7 +561,6 @@ func (me *DtsService) IsolateDtsMigrateJobById(ctx context.Context, jobId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseDtsClient().IsolateMigrateJob(request)
 	if err != nil {
 		errRet = err
@@ -610,7 +597,6 @@ func (me *DtsService) DeleteDtsMigrateServiceById(ctx context.Context, jobId str
 		return err
 	}
 
-	ratelimit.Check(request.GetAction())
 	err = resource.Retry(3*writeRetryTimeout, func() *resource.RetryError {
 		request.JobId = helper.String(jobId)
 		_, err := me.client.UseDtsClient().DestroyMigrateJob(request)
@@ -688,8 +674,6 @@ func (me *DtsService) DescribeDtsMigrateServiceById(ctx context.Context, jobId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDtsClient().DescribeMigrationDetail(request)
 	if err != nil {
 		errRet = err
@@ -715,8 +699,6 @@ func (me *DtsService) DescribeDtsMigrateCheckById(ctx context.Context, jobId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDtsClient().DescribeMigrationCheckJob(request)
 	if err != nil {
 		errRet = err
@@ -782,8 +764,6 @@ func (me *DtsService) DescribeDtsSyncCheckJobOperationById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDtsClient().DescribeCheckSyncJobResult(request)
 	if err != nil {
 		errRet = err
@@ -853,8 +833,6 @@ func (me *DtsService) DescribeDtsSyncJobResumeOperationById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDtsClient().DescribeSyncJobs(request)
 	if err != nil {
 		errRet = err
@@ -909,8 +887,6 @@ func (me *DtsService) DescribeDtsCompareTaskStopOperationById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDtsClient().DescribeCompareReport(request)
 	if err != nil {
 		errRet = err
@@ -1020,8 +996,6 @@ func (me *DtsService) DescribeDtsMigrateDbInstancesByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1062,8 +1036,6 @@ func (me *DtsService) DescribeDtsSyncConfigById(ctx context.Context, jobId strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseDtsClient().DescribeSyncJobs(request)
 	if err != nil {
 		errRet = err
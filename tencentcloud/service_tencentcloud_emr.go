@@ -11,7 +11,6 @@ import (
 	emr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/emr/v20190103"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type EMRService struct {
@@ -20,7 +19,6 @@ type EMRService struct {
 
 func (me *EMRService) UpdateInstance(ctx context.Context, request *emr.ScaleOutInstanceRequest) (id string, err error) {
 	logId := getLogId(ctx)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseEmrClient().ScaleOutInstance(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -37,7 +35,6 @@ func (me *EMRService) DeleteInstance(ctx context.Context, d *schema.ResourceData
 	if v, ok := d.GetOk("instance_id"); ok {
 		request.InstanceId = common.StringPtr(v.(string))
 	}
-	ratelimit.Check(request.GetAction())
 	//API: https://cloud.tencent.com/document/api/589/34261
 	_, err := me.client.UseEmrClient().TerminateInstance(request)
 	if err != nil {
@@ -164,7 +161,6 @@ func (me *EMRService) CreateInstance(ctx context.Context, d *schema.ResourceData
 		request.ExtendFsField = common.StringPtr(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	//API: https://cloud.tencent.com/document/api/589/34261
 	response, err := me.client.UseEmrClient().CreateInstance(request)
 	if err != nil {
@@ -180,7 +176,6 @@ func (me *EMRService) DescribeInstances(ctx context.Context, filters map[string]
 	logId := getLogId(ctx)
 	request := emr.NewDescribeInstancesRequest()
 
-	ratelimit.Check(request.GetAction())
 	// API: https://cloud.tencent.com/document/api/589/41707
 	if v, ok := filters["instance_ids"]; ok {
 		instances := v.([]interface{})
@@ -219,7 +214,6 @@ func (me *EMRService) DescribeInstancesById(ctx context.Context, instanceId stri
 	logId := getLogId(ctx)
 	request := emr.NewDescribeInstancesRequest()
 
-	ratelimit.Check(request.GetAction())
 	// API: https://cloud.tencent.com/document/api/589/41707
 	request.ProjectId = helper.IntInt64(-1)
 	request.InstanceIds = make([]*string, 0)
@@ -244,7 +238,6 @@ func (me *EMRService) DescribeClusterNodes(ctx context.Context, instanceId, node
 	logId := getLogId(ctx)
 	request := emr.NewDescribeClusterNodesRequest()
 
-	ratelimit.Check(request.GetAction())
 	// API: https://cloud.tencent.com/document/api/589/41707
 	request.InstanceId = &instanceId
 	request.NodeFlag = &nodeFlag
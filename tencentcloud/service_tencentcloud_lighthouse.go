@@ -9,7 +9,6 @@ import (
 	lighthouse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/lighthouse/v20200324"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type LightHouseService struct {
@@ -32,7 +31,6 @@ func (me *LightHouseService) DescribeLighthouseInstanceById(ctx context.Context,
 	}()
 
 	request.InstanceIds = append(request.InstanceIds, helper.String(instanceId))
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -41,7 +39,6 @@ func (me *LightHouseService) DescribeLighthouseInstanceById(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseLighthouseClient().DescribeInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -83,7 +80,6 @@ func (me *LightHouseService) DeleteLighthouseInstanceById(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseLighthouseClient().TerminateInstances(request)
 	if err != nil {
 		errRet = err
@@ -109,7 +105,6 @@ func (me *LightHouseService) IsolateLighthouseInstanceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseLighthouseClient().IsolateInstances(request)
 	if err != nil {
 		errRet = err
@@ -147,8 +142,6 @@ func (me *LightHouseService) DeleteLighthouseBlueprintById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DeleteBlueprints(request)
 	if err != nil {
 		errRet = err
@@ -171,8 +164,6 @@ func (me *LightHouseService) DescribeLighthouseBlueprintById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DescribeBlueprints(request)
 	if err != nil {
 		errRet = err
@@ -205,7 +196,6 @@ func (me *LightHouseService) DescribeLighthouseFirewallRuleById(ctx context.Cont
 	offset := 0
 	firewallRules = make([]*lighthouse.FirewallRuleInfo, 0)
 	for {
-		ratelimit.Check(request.GetAction())
 		request.Limit = helper.IntInt64(limit)
 		request.Offset = helper.IntInt64(offset)
 		response, err := me.client.UseLighthouseClient().DescribeFirewallRules(request)
@@ -237,8 +227,6 @@ func (me *LightHouseService) DescribeLighthouseFirewallRulesTemplateByFilter(ctx
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DescribeFirewallRulesTemplate(request)
 	if err != nil {
 		errRet = err
@@ -264,8 +252,6 @@ func (me *LightHouseService) DescribeLighthouseDiskBackupById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DescribeDiskBackups(request)
 	if err != nil {
 		errRet = err
@@ -293,8 +279,6 @@ func (me *LightHouseService) DeleteLighthouseDiskBackupById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DeleteDiskBackups(request)
 	if err != nil {
 		errRet = err
@@ -345,8 +329,6 @@ func (me *LightHouseService) DescribeLighthouseDiskById(ctx context.Context, dis
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DescribeDisks(request)
 	if err != nil {
 		errRet = err
@@ -378,7 +360,6 @@ func (me *LightHouseService) DescribeLighthouseDisk(ctx context.Context, diskIds
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseLighthouseClient().DescribeDisks(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -456,8 +437,6 @@ func (me *LightHouseService) DeleteLighthouseDiskAttachmentById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DetachDisks(request)
 	if err != nil {
 		errRet = err
@@ -480,8 +459,6 @@ func (me *LightHouseService) DescribeLighthouseKeyPairById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DescribeKeyPairs(request)
 	if err != nil {
 		errRet = err
@@ -509,8 +486,6 @@ func (me *LightHouseService) DeleteLighthouseKeyPairById(ctx context.Context, ke
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DeleteKeyPairs(request)
 	if err != nil {
 		errRet = err
@@ -533,8 +508,6 @@ func (me *LightHouseService) DescribeLighthouseSnapshotById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DescribeSnapshots(request)
 	if err != nil {
 		errRet = err
@@ -562,8 +535,6 @@ func (me *LightHouseService) DeleteLighthouseSnapshotById(ctx context.Context, s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DeleteSnapshots(request)
 	if err != nil {
 		errRet = err
@@ -637,7 +608,6 @@ func (me *LightHouseService) DescribeLighthouseBundleByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	request.Offset = helper.IntInt64(offset)
 	request.Limit = helper.IntInt64(limit)
 	response, err := me.client.UseLighthouseClient().DescribeBundles(request)
@@ -676,8 +646,6 @@ func (me *LightHouseService) DescribeLighthouseZoneByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DescribeZones(request)
 	if err != nil {
 		errRet = err
@@ -718,8 +686,6 @@ func (me *LightHouseService) DescribeLighthouseSceneByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -772,8 +738,6 @@ func (me *LightHouseService) DescribeLighthouseAllSceneByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -833,8 +797,6 @@ func (me *LightHouseService) DescribeLighthouseResetInstanceBlueprintByFilter(ct
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	request.Offset = helper.IntInt64(offset)
 	request.Limit = helper.IntInt64(limit)
 	response, err := me.client.UseLighthouseClient().DescribeResetInstanceBlueprints(request)
@@ -865,8 +827,6 @@ func (me *LightHouseService) DescribeLighthouseRegionByFilter(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DescribeRegions(request)
 	if err != nil {
 		errRet = err
@@ -896,8 +856,6 @@ func (me *LightHouseService) DescribeLighthouseInstanceVncUrlByFilter(ctx contex
 
 	request.InstanceId = helper.String(instanceId)
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DescribeInstanceVncUrl(request)
 	if err != nil {
 		errRet = err
@@ -945,8 +903,6 @@ func (me *LightHouseService) DescribeLighthouseInstanceTrafficPackageByFilter(ct
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	for {
 		request.Offset = helper.IntInt64(offset)
 		request.Limit = helper.IntInt64(limit)
@@ -985,8 +941,6 @@ func (me *LightHouseService) DescribeLighthouseInstanceDiskNum(ctx context.Conte
 
 	request.InstanceIds = helper.Strings(instanceIds)
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DescribeInstancesDiskNum(request)
 	if err != nil {
 		errRet = err
@@ -1017,8 +971,6 @@ func (me *LightHouseService) DescribeLighthouseInstanceBlueprintByFilter(ctx con
 
 	request.InstanceIds = helper.Strings(instanceIds)
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DescribeBlueprintInstances(request)
 	if err != nil {
 		errRet = err
@@ -1053,8 +1005,6 @@ func (me *LightHouseService) DescribeLighthouseDiskConfigByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DescribeDiskConfigs(request)
 	if err != nil {
 		errRet = err
@@ -1097,8 +1047,6 @@ func (me *LightHouseService) DescribeLighthouseKeyPairAttachmentById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DescribeKeyPairs(request)
 	if err != nil {
 		errRet = err
@@ -1127,8 +1075,6 @@ func (me *LightHouseService) DeleteLighthouseKeyPairAttachmentById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().DisassociateInstancesKeyPairs(request)
 	if err != nil {
 		errRet = err
@@ -1151,8 +1097,6 @@ func (me *LightHouseService) IsolateLighthouseDiskById(ctx context.Context, disk
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().IsolateDisks(request)
 	if err != nil {
 		errRet = err
@@ -1175,8 +1119,6 @@ func (me *LightHouseService) TerminateLighthouseDiskById(ctx context.Context, di
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().TerminateDisks(request)
 	if err != nil {
 		errRet = err
@@ -1208,8 +1150,6 @@ func (me *LightHouseService) DescribeLighthouseModifyInstanceBundleByFilter(ctx
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1251,8 +1191,6 @@ func (me *LightHouseService) ModifyFirewallRuleDescription(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseLighthouseClient().ModifyFirewallRuleDescription(request)
 	if err != nil {
 		errRet = err
@@ -11,7 +11,6 @@ import (
 
 	mariadb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mariadb/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type MariadbService struct {
@@ -112,7 +111,6 @@ func (me *MariadbService) DescribeMariadbDbInstancesByFilter(ctx context.Context
 			request.ExclusterIds = v.([]*string)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 20
@@ -120,7 +118,6 @@ func (me *MariadbService) DescribeMariadbDbInstancesByFilter(ctx context.Context
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseMariadbClient().DescribeDBInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -161,8 +158,6 @@ func (me *MariadbService) DescribeMariadbDcnDetailByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMariadbClient().DescribeDcnDetail(request)
 	if err != nil {
 		errRet = err
@@ -201,8 +196,6 @@ func (me *MariadbService) DescribeMariadbFileDownloadUrlByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMariadbClient().DescribeFileDownloadUrl(request)
 	if err != nil {
 		errRet = err
@@ -238,8 +231,6 @@ func (me *MariadbService) DescribeMariadbFlowByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMariadbClient().DescribeFlow(request)
 	if err != nil {
 		errRet = err
@@ -275,8 +266,6 @@ func (me *MariadbService) DescribeMariadbInstanceNodeInfoByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -320,8 +309,6 @@ func (me *MariadbService) DescribeMariadbInstanceSpecsByFilter(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMariadbClient().DescribeDBInstanceSpecs(request)
 	if err != nil {
 		errRet = err
@@ -352,7 +339,6 @@ func (me *MariadbService) DescribeMariadbOrdersByFilter(ctx context.Context, dea
 	}()
 
 	request.DealNames = common.StringPtrs([]string{dealName})
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseMariadbClient().DescribeOrders(request)
 	if err != nil {
@@ -410,8 +396,6 @@ func (me *MariadbService) DescribeMariadbPriceByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMariadbClient().DescribePrice(request)
 	if err != nil {
 		errRet = err
@@ -450,8 +434,6 @@ func (me *MariadbService) DescribeMariadbProjectSecurityGroupsByFilter(ctx conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMariadbClient().DescribeProjectSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -493,8 +475,6 @@ func (me *MariadbService) DescribeMariadbRenewalPriceByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMariadbClient().DescribeRenewalPrice(request)
 	if err != nil {
 		errRet = err
@@ -524,8 +504,6 @@ func (me *MariadbService) DescribeMariadbSaleInfoByFilter(ctx context.Context) (
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMariadbClient().DescribeSaleInfo(request)
 	if err != nil {
 		errRet = err
@@ -579,8 +557,6 @@ func (me *MariadbService) DescribeMariadbSlowLogsByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -644,8 +620,6 @@ func (me *MariadbService) DescribeMariadbUpgradePriceByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMariadbClient().DescribeUpgradePrice(request)
 	if err != nil {
 		errRet = err
@@ -684,8 +658,6 @@ func (me *MariadbService) DescribeMariadbLogFilesByFilter(ctx context.Context, p
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMariadbClient().DescribeDBLogFiles(request)
 	if err != nil {
 		errRet = err
@@ -781,7 +753,6 @@ func (me *MariadbService) DeleteMariadbDbInstanceById(ctx context.Context, insta
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMariadbClient().DestroyDBInstance(request)
 	if err != nil {
 		errRet = err
@@ -807,7 +778,6 @@ func (me *MariadbService) DeleteMariadbHourDbInstanceById(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMariadbClient().DestroyHourDBInstance(request)
 	if err != nil {
 		errRet = err
@@ -833,7 +803,6 @@ func (me *MariadbService) DeleteMariadbDbInstance(ctx context.Context, instanceI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMariadbClient().TerminateDedicatedDBInstance(request)
 	if err != nil {
 		errRet = err
@@ -897,7 +866,6 @@ func (me *MariadbService) DeleteMariadbAccountById(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMariadbClient().DeleteAccount(request)
 	if err != nil {
 		errRet = err
@@ -1015,7 +983,6 @@ func (me *MariadbService) DeleteMariadbSecurityGroupsById(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMariadbClient().DisassociateSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -1046,7 +1013,6 @@ func (me *MariadbService) DescribeMariadbAccountsByFilter(ctx context.Context, p
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMariadbClient().DescribeAccounts(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1116,8 +1082,6 @@ func (me *MariadbService) DescribeMariadbInstanceById(ctx context.Context, insta
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMariadbClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -1145,8 +1109,6 @@ func (me *MariadbService) IsolateDBInstanceById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMariadbClient().IsolateDBInstance(request)
 	if err != nil {
 		errRet = err
@@ -1169,8 +1131,6 @@ func (me *MariadbService) DeleteMariadbInstanceById(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMariadbClient().DestroyDBInstance(request)
 	if err != nil {
 		errRet = err
@@ -1196,7 +1156,6 @@ func (me *MariadbService) DescribeMariadbDatabaseObjectsByFilter(ctx context.Con
 	request.InstanceId = &instanceId
 	request.DbName = &dbName
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMariadbClient().DescribeDatabaseObjects(request)
 	if err != nil {
 		errRet = err
@@ -1225,7 +1184,6 @@ func (me *MariadbService) DescribeMariadbDatabasesByFilter(ctx context.Context,
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMariadbClient().DescribeDatabases(request)
 	if err != nil {
 		errRet = err
@@ -1265,7 +1223,6 @@ func (me *MariadbService) DescribeMariadbDatabaseTableByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMariadbClient().DescribeDatabaseTable(request)
 	if err != nil {
 		errRet = err
@@ -1354,8 +1311,6 @@ func (me *MariadbService) DescribeMariadbAccountPrivilegesById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMariadbClient().DescribeAccountPrivileges(request)
 	if err != nil {
 		errRet = err
@@ -1379,8 +1334,6 @@ func (me *MariadbService) DescribeMariadbBackupTimeById(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMariadbClient().DescribeBackupTime(request)
 	if err != nil {
 		errRet = err
@@ -1408,8 +1361,6 @@ func (me *MariadbService) DescribeDBInstanceDetailById(ctx context.Context, inst
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMariadbClient().DescribeDBInstanceDetail(request)
 	if err != nil {
 		errRet = err
@@ -6,7 +6,6 @@ import (
 
 	mdl "github.com/tencentcloud/tencentcloud-sdk-go-intl-en/tencentcloud/mdl/v20200326"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type MdlService struct {
@@ -25,8 +24,6 @@ func (me *MdlService) DescribeMdlStreamLiveInputById(ctx context.Context, id str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMdlClient().DescribeStreamLiveInput(request)
 	if err != nil {
 		errRet = err
@@ -53,8 +50,6 @@ func (me *MdlService) DeleteMdlStreamLiveInputById(ctx context.Context, id strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMdlClient().DeleteStreamLiveInput(request)
 	if err != nil {
 		errRet = err
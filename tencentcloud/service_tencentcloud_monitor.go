@@ -13,7 +13,6 @@ import (
 	monitor "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 	"gopkg.in/yaml.v2"
 )
 
@@ -56,7 +55,6 @@ func (me *MonitorService) CheckCanCreateMysqlROInstance(ctx context.Context, mys
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().GetMonitorData(request)
 	if err != nil {
 		errRet = err
@@ -77,7 +75,6 @@ func (me *MonitorService) CheckCanCreateMysqlROInstance(ctx context.Context, mys
 func (me *MonitorService) FullRegions() (regions []string, errRet error) {
 	request := cvm.NewDescribeRegionsRequest()
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if response, err := me.client.UseCvmClient().DescribeRegions(request); err != nil {
 			return retryError(err, InternalError)
 		} else {
@@ -103,7 +100,6 @@ func (me *MonitorService) DescribePolicyGroupDetailInfo(ctx context.Context, gro
 	request.Module = helper.String("monitor")
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if response, err = me.client.UseMonitorClient().DescribePolicyGroupInfo(request); err != nil {
 			return retryError(err, InternalError)
 		}
@@ -124,7 +120,6 @@ func (me *MonitorService) DescribeAlarmPolicyById(ctx context.Context, policyId
 	request.Module = helper.String("monitor")
 	request.PolicyId = &policyId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DescribeAlarmPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -170,7 +165,6 @@ func (me *MonitorService) DescribeAlarmNoticeById(ctx context.Context, alarmmap
 	}
 
 	if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		if response, err = me.client.UseMonitorClient().DescribeAlarmNotices(request); err != nil {
 			return retryError(err, InternalError)
 		}
@@ -199,7 +193,6 @@ func (me *MonitorService) DescribePolicyGroup(ctx context.Context, groupId int64
 			break
 		}
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err := me.client.UseMonitorClient().DescribePolicyGroupList(request)
 			if err != nil {
 				return retryError(err, InternalError)
@@ -247,7 +240,6 @@ func (me *MonitorService) DescribeBindingPolicyObjectList(ctx context.Context, g
 			break
 		}
 		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(requestList.GetAction())
 			if responseList, err = me.client.UseMonitorClient().DescribeBindingPolicyObjectList(requestList); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -288,7 +280,6 @@ func (me *MonitorService) DescribeBindingAlarmPolicyObjectList(ctx context.Conte
 			break
 		}
 		if err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(requestList.GetAction())
 			if responseList, err = me.client.UseMonitorClient().DescribeBindingPolicyObjectList(requestList); err != nil {
 				return retryError(err, InternalError)
 			}
@@ -368,7 +359,6 @@ func (me *MonitorService) IsolateMonitorTmpInstanceById(ctx context.Context, tmp
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().TerminatePrometheusInstances(request)
 	if err != nil {
 		errRet = err
@@ -393,7 +383,6 @@ func (me *MonitorService) DeleteMonitorTmpInstanceById(ctx context.Context, tmpI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DestroyPrometheusInstance(request)
 	if err != nil {
 		errRet = err
@@ -489,7 +478,6 @@ func (me *MonitorService) DeleteMonitorTmpScrapeJobById(ctx context.Context, tmp
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DeletePrometheusScrapeJobs(request)
 	if err != nil {
 		errRet = err
@@ -509,7 +497,6 @@ func (me *MonitorService) DeleteMonitorAlarmNoticeById(ctx context.Context, Id s
 	request.NoticeIds = n
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseMonitorClient().DeleteAlarmNotices(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -581,7 +568,6 @@ func (me *MonitorService) DeleteMonitorTmpExporterIntegrationById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DeleteExporterIntegration(request)
 	if err != nil {
 		errRet = err
@@ -609,7 +595,6 @@ func (me *MonitorService) DescribeMonitorTmpAlertRuleById(ctx context.Context, i
 	request.InstanceId = &instanceId
 	request.RuleId = &tmpAlertRuleId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DescribeAlertRules(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -642,7 +627,6 @@ func (me *MonitorService) DeleteMonitorTmpAlertRule(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DeleteAlertRules(request)
 	if err != nil {
 		errRet = err
@@ -670,7 +654,6 @@ func (me *MonitorService) DescribeMonitorRecordingRuleById(ctx context.Context,
 	request.InstanceId = &instanceId
 	request.RuleId = &recordingRuleId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DescribeRecordingRules(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -703,7 +686,6 @@ func (me *MonitorService) DeleteMonitorRecordingRule(ctx context.Context, instan
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DeleteRecordingRules(request)
 	if err != nil {
 		errRet = err
@@ -763,7 +745,6 @@ func (me *MonitorService) DeleteMonitorGrafanaInstanceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DeleteGrafanaInstance(request)
 	if err != nil {
 		errRet = err
@@ -789,7 +770,6 @@ func (me *MonitorService) CleanGrafanaInstanceById(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().CleanGrafanaInstance(request)
 	if err != nil {
 		errRet = err
@@ -847,7 +827,6 @@ func (me *MonitorService) DeleteMonitorGrafanaIntegrationById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DeleteGrafanaIntegration(request)
 	if err != nil {
 		errRet = err
@@ -908,7 +887,6 @@ func (me *MonitorService) DeleteMonitorGrafanaNotificationChannelById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DeleteGrafanaNotificationChannel(request)
 	if err != nil {
 		errRet = err
@@ -970,7 +948,6 @@ func (me *MonitorService) DeleteMonitorSsoAccountById(ctx context.Context, insta
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DeleteSSOAccount(request)
 	if err != nil {
 		errRet = err
@@ -1030,7 +1007,6 @@ func (me *MonitorService) DeleteMonitorGrafanaPluginById(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().UninstallGrafanaPlugins(request)
 	if err != nil {
 		errRet = err
@@ -1092,7 +1068,6 @@ func (me *MonitorService) DeleteTkeTmpAlertPolicyById(ctx context.Context, insta
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DeletePrometheusAlertPolicy(request)
 	if err != nil {
 		errRet = err
@@ -1118,7 +1093,6 @@ func (me *MonitorService) DescribeTmpTkeClusterAgentsById(ctx context.Context, i
 	}()
 
 	request.InstanceId = &instanceId
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 100
@@ -1126,7 +1100,6 @@ func (me *MonitorService) DescribeTmpTkeClusterAgentsById(ctx context.Context, i
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseMonitorClient().DescribePrometheusClusterAgents(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1171,7 +1144,6 @@ func (me *MonitorService) DeletePrometheusClusterAgent(ctx context.Context, inst
 		ClusterType: &clusterType,
 	})
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DeletePrometheusClusterAgent(request)
 	if err != nil {
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
@@ -1205,7 +1177,6 @@ func (me *MonitorService) DescribeTkeTmpConfigById(ctx context.Context, configId
 	request.ClusterType = &ids.ClusterType
 	request.InstanceId = &ids.InstanceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DescribePrometheusConfig(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail,ids [%s], request body [%s], reason[%s]\n",
@@ -1257,7 +1228,6 @@ func (me *MonitorService) DeleteTkeTmpConfigByName(ctx context.Context, configId
 		request.RawJobs = RawJobs
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DeletePrometheusConfig(request)
 	if err != nil {
 		errRet = err
@@ -1307,7 +1277,6 @@ func (me *MonitorService) DescribeTmpTkeTemplateById(ctx context.Context, templa
 			Value: &templateId,
 		},
 	)
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 100
@@ -1316,7 +1285,6 @@ func (me *MonitorService) DescribeTmpTkeTemplateById(ctx context.Context, templa
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseMonitorClient().DescribePrometheusTemp(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1364,7 +1332,6 @@ func (me *MonitorService) DeleteTmpTkeTemplate(ctx context.Context, tempId strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DeletePrometheusTemp(request)
 	if err != nil {
 		errRet = err
@@ -1390,7 +1357,6 @@ func (me *MonitorService) DeletePrometheusRecordRuleYaml(ctx context.Context, id
 	request.InstanceId = &id
 	request.Names = []*string{&name}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DeletePrometheusRecordRuleYaml(request)
 	if err != nil {
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
@@ -1487,7 +1453,6 @@ func (me *MonitorService) ModifyTkeTmpGlobalNotification(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().ModifyPrometheusGlobalNotification(request)
 	if err != nil {
 		errRet = err
@@ -1513,7 +1478,6 @@ func (me *MonitorService) DescribePrometheusTempSync(ctx context.Context, templa
 	}()
 
 	request.TemplateId = &templateId
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseMonitorClient().DescribePrometheusTempSync(request)
 	if err != nil {
@@ -1551,8 +1515,6 @@ func (me *MonitorService) DescribeMonitorManageGrafanaAttachmentById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMonitorClient().DescribePrometheusInstances(request)
 	if err != nil {
 		errRet = err
@@ -1587,8 +1549,6 @@ func (me *MonitorService) DeleteMonitorManageGrafanaAttachmentById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMonitorClient().UnbindPrometheusManagedGrafana(request)
 	if err != nil {
 		errRet = err
@@ -1612,7 +1572,6 @@ func (me *MonitorService) DescribeTkeTmpBasicConfigById(ctx context.Context, clu
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMonitorClient().DescribePrometheusConfig(request)
 	if err != nil {
 		errRet = err
@@ -1741,8 +1700,6 @@ func (me *MonitorService) DescribeMonitorTmpGrafanaConfigById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMonitorClient().DescribeGrafanaConfig(request)
 	if err != nil {
 		errRet = err
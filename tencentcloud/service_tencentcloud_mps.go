@@ -7,7 +7,6 @@ import (
 	mps "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/mps/v20190612"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type MpsService struct {
@@ -26,8 +25,6 @@ func (me *MpsService) DescribeMpsWorkflowById(ctx context.Context, workflowId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DescribeWorkflows(request)
 	if err != nil {
 		errRet = err
@@ -55,8 +52,6 @@ func (me *MpsService) DeleteMpsWorkflowById(ctx context.Context, workflowId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DeleteWorkflow(request)
 	if err != nil {
 		errRet = err
@@ -79,8 +74,6 @@ func (me *MpsService) EnableWorkflow(ctx context.Context, workflowId int64) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().EnableWorkflow(request)
 	if err != nil {
 		errRet = err
@@ -103,8 +96,6 @@ func (me *MpsService) DisableWorkflow(ctx context.Context, workflowId int64) (er
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DisableWorkflow(request)
 	if err != nil {
 		errRet = err
@@ -127,8 +118,6 @@ func (me *MpsService) DescribeMpsTranscodeTemplateById(ctx context.Context, defi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DescribeTranscodeTemplates(request)
 	if err != nil {
 		errRet = err
@@ -156,8 +145,6 @@ func (me *MpsService) DeleteMpsTranscodeTemplateById(ctx context.Context, defini
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DeleteTranscodeTemplate(request)
 	if err != nil {
 		errRet = err
@@ -180,8 +167,6 @@ func (me *MpsService) DescribeMpsWatermarkTemplateById(ctx context.Context, defi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DescribeWatermarkTemplates(request)
 	if err != nil {
 		errRet = err
@@ -209,8 +194,6 @@ func (me *MpsService) DeleteMpsWatermarkTemplateById(ctx context.Context, defini
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DeleteWatermarkTemplate(request)
 	if err != nil {
 		errRet = err
@@ -233,8 +216,6 @@ func (me *MpsService) DescribeMpsImageSpriteTemplateById(ctx context.Context, de
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DescribeImageSpriteTemplates(request)
 	if err != nil {
 		errRet = err
@@ -262,8 +243,6 @@ func (me *MpsService) DeleteMpsImageSpriteTemplateById(ctx context.Context, defi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DeleteImageSpriteTemplate(request)
 	if err != nil {
 		errRet = err
@@ -286,8 +265,6 @@ func (me *MpsService) DescribeMpsSnapshotByTimeoffsetTemplateById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DescribeSnapshotByTimeOffsetTemplates(request)
 	if err != nil {
 		errRet = err
@@ -315,8 +292,6 @@ func (me *MpsService) DeleteMpsSnapshotByTimeoffsetTemplateById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DeleteSnapshotByTimeOffsetTemplate(request)
 	if err != nil {
 		errRet = err
@@ -339,8 +314,6 @@ func (me *MpsService) DescribeMpsSampleSnapshotTemplateById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DescribeSampleSnapshotTemplates(request)
 	if err != nil {
 		errRet = err
@@ -368,8 +341,6 @@ func (me *MpsService) DeleteMpsSampleSnapshotTemplateById(ctx context.Context, d
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DeleteSampleSnapshotTemplate(request)
 	if err != nil {
 		errRet = err
@@ -392,8 +363,6 @@ func (me *MpsService) DescribeMpsAnimatedGraphicsTemplateById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DescribeAnimatedGraphicsTemplates(request)
 	if err != nil {
 		errRet = err
@@ -421,8 +390,6 @@ func (me *MpsService) DeleteMpsAnimatedGraphicsTemplateById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DeleteAnimatedGraphicsTemplate(request)
 	if err != nil {
 		errRet = err
@@ -445,8 +412,6 @@ func (me *MpsService) DescribeMpsAdaptiveDynamicStreamingTemplateById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DescribeAdaptiveDynamicStreamingTemplates(request)
 	if err != nil {
 		errRet = err
@@ -474,8 +439,6 @@ func (me *MpsService) DeleteMpsAdaptiveDynamicStreamingTemplateById(ctx context.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DeleteAdaptiveDynamicStreamingTemplate(request)
 	if err != nil {
 		errRet = err
@@ -498,8 +461,6 @@ func (me *MpsService) DescribeMpsAiAnalysisTemplateById(ctx context.Context, def
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DescribeAIAnalysisTemplates(request)
 	if err != nil {
 		errRet = err
@@ -527,8 +488,6 @@ func (me *MpsService) DeleteMpsAiAnalysisTemplateById(ctx context.Context, defin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DeleteAIAnalysisTemplate(request)
 	if err != nil {
 		errRet = err
@@ -551,8 +510,6 @@ func (me *MpsService) DescribeMpsAiRecognitionTemplateById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DescribeAIRecognitionTemplates(request)
 	if err != nil {
 		errRet = err
@@ -580,8 +537,6 @@ func (me *MpsService) DeleteMpsAiRecognitionTemplateById(ctx context.Context, de
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DeleteAIRecognitionTemplate(request)
 	if err != nil {
 		errRet = err
@@ -604,8 +559,6 @@ func (me *MpsService) DescribeMpsPersonSampleById(ctx context.Context, personId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DescribePersonSamples(request)
 	if err != nil {
 		errRet = err
@@ -633,8 +586,6 @@ func (me *MpsService) DeleteMpsPersonSampleById(ctx context.Context, personId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMpsClient().DeletePersonSample(request)
 	if err != nil {
 		errRet = err
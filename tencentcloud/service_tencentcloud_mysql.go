@@ -12,7 +12,6 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type MysqlService struct {
@@ -68,8 +67,6 @@ needMoreItems:
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DescribeBackups(request)
 	if err != nil {
 		errRet = err
@@ -106,7 +103,6 @@ func (me *MysqlService) CreateBackup(ctx context.Context, mysqlId string) (backu
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().CreateBackup(request)
 	if err != nil {
 		errRet = err
@@ -127,7 +123,6 @@ func (me *MysqlService) DescribeDBZoneConfig(ctx context.Context) (sellConfigure
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeCdbZoneConfig(request)
 	if err != nil {
 		errRet = err
@@ -149,7 +144,6 @@ func (me *MysqlService) DescribeBackupConfigByMysqlId(ctx context.Context, mysql
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeBackupConfig(request)
 	if err != nil {
 		errRet = err
@@ -176,7 +170,6 @@ func (me *MysqlService) ModifyBackupConfigByMysqlId(ctx context.Context, mysqlId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().ModifyBackupConfig(request)
 	if err != nil {
 		errRet = err
@@ -199,7 +192,6 @@ func (me *MysqlService) DescribeDefaultParameters(ctx context.Context, engineVer
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeDefaultParams(request)
 	if err != nil {
 		errRet = err
@@ -227,7 +219,6 @@ func (me *MysqlService) DescribeInstanceParameters(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeInstanceParams(request)
 	if err != nil {
 		errRet = err
@@ -258,7 +249,6 @@ func (me *MysqlService) ModifyInstanceParam(ctx context.Context, instanceId stri
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().ModifyInstanceParam(request)
 	if err != nil {
 		errRet = err
@@ -323,7 +313,6 @@ func (me *MysqlService) CreateAccount(ctx context.Context, mysqlId string,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().CreateAccounts(request)
 	if err != nil {
 		errRet = err
@@ -353,7 +342,6 @@ func (me *MysqlService) ModifyAccountPassword(ctx context.Context, mysqlId strin
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().ModifyAccountPassword(request)
 	if err != nil {
 		errRet = err
@@ -382,7 +370,6 @@ func (me *MysqlService) ModifyAccountMaxUserConnections(ctx context.Context, mys
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().ModifyAccountMaxUserConnections(request)
 	if err != nil {
 		errRet = err
@@ -412,7 +399,6 @@ func (me *MysqlService) UpgradeDBInstanceEngineVersion(ctx context.Context, mysq
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().UpgradeDBInstanceEngineVersion(request)
 	if err != nil {
 		errRet = err
@@ -439,7 +425,6 @@ func (me *MysqlService) ModifyAccountHost(ctx context.Context, mysqlId, accountN
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().ModifyAccountHost(request)
 	if err != nil {
 		errRet = err
@@ -469,7 +454,6 @@ func (me *MysqlService) ModifyAccountDescription(ctx context.Context, mysqlId st
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().ModifyAccountDescription(request)
 	if err != nil {
 		errRet = err
@@ -498,7 +482,6 @@ func (me *MysqlService) DeleteAccount(ctx context.Context, mysqlId string,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DeleteAccounts(request)
 	if err != nil {
 		errRet = err
@@ -535,7 +518,6 @@ func (me *MysqlService) DescribeAccounts(ctx context.Context, mysqlId string) (a
 	}()
 
 needMoreItems:
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeAccounts(request)
 	if err != nil {
 		errRet = err
@@ -570,7 +552,6 @@ func (me *MysqlService) _innerDescribeAsyncRequestInfo(ctx context.Context, asyn
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeAsyncRequestInfo(request)
 	if err != nil {
 		errRet = err
@@ -638,7 +619,6 @@ func (me *MysqlService) ModifyAccountPrivileges(ctx context.Context, mysqlId str
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().ModifyAccountPrivileges(request)
 	if err != nil {
 		errRet = err
@@ -670,7 +650,6 @@ func (me *MysqlService) DescribeAccountPrivileges(ctx context.Context, mysqlId s
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeAccountPrivileges(request)
 	if err != nil {
 		errRet = err
@@ -757,7 +736,6 @@ func (me *MysqlService) DescribeIsolatedDBInstanceById(ctx context.Context, mysq
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -791,7 +769,6 @@ func (me *MysqlService) _innerDescribeDBInstanceById(ctx context.Context, mysqlI
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -826,7 +803,6 @@ func (me *MysqlService) DescribeRunningDBInstanceById(ctx context.Context, mysql
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -859,7 +835,6 @@ func (me *MysqlService) CheckDBGTIDOpen(ctx context.Context, mysqlId string) (op
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeDBInstanceGTID(request)
 	if err != nil {
 		sdkErr, ok := err.(*errors.TencentCloudSDKError)
@@ -885,7 +860,6 @@ func (me *MysqlService) DescribeDBSecurityGroups(ctx context.Context, mysqlId st
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeDBSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -932,7 +906,6 @@ func (me *MysqlService) ModifyInstanceTag(ctx context.Context, mysqlId string, d
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().ModifyInstanceTag(request)
 	if err != nil {
 		errRet = err
@@ -968,7 +941,6 @@ again:
 	} else {
 		offset = offset + limit
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeTagsOfInstanceIds(request)
 	if err != nil {
 		errRet = err
@@ -1005,7 +977,6 @@ func (me *MysqlService) DescribeDBInstanceConfig(ctx context.Context, mysqlId st
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeDBInstanceConfig(request)
 
 	if err != nil {
@@ -1059,7 +1030,6 @@ func (me *MysqlService) InitDBInstances(ctx context.Context, mysqlId, password,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().InitDBInstances(request)
 
 	if err != nil {
@@ -1087,7 +1057,6 @@ func (me *MysqlService) OpenWanService(ctx context.Context, mysqlId string) (asy
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().OpenWanService(request)
 
 	if err != nil {
@@ -1109,7 +1078,6 @@ func (me *MysqlService) CloseWanService(ctx context.Context, mysqlId string) (as
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().CloseWanService(request)
 
 	if err != nil {
@@ -1131,7 +1099,6 @@ func (me *MysqlService) OpenDBInstanceGTID(ctx context.Context, mysqlId string)
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().OpenDBInstanceGTID(request)
 
 	if err != nil {
@@ -1156,7 +1123,6 @@ func (me *MysqlService) ModifyDBInstanceName(ctx context.Context, mysqlId,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, errRet := me.client.UseMysqlClient().ModifyDBInstanceName(request)
 
 	if errRet != nil {
@@ -1186,7 +1152,6 @@ func (me *MysqlService) ModifyDBInstanceVipVport(ctx context.Context, mysqlId, v
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, errRet := me.client.UseMysqlClient().ModifyDBInstanceVipVport(request)
 
 	if errRet != nil {
@@ -1226,7 +1191,6 @@ func (me *MysqlService) UpgradeDBInstance(ctx context.Context, mysqlId string,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().UpgradeDBInstance(request)
 
 	if err != nil {
@@ -1251,7 +1215,6 @@ func (me *MysqlService) ModifyDBInstanceProject(ctx context.Context, mysqlId str
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().ModifyDBInstanceProject(request)
 
 	if err != nil {
@@ -1282,7 +1245,6 @@ func (me *MysqlService) ModifyDBInstanceSecurityGroups(ctx context.Context, mysq
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().ModifyDBInstanceSecurityGroups(request)
 
 	if err != nil {
@@ -1308,7 +1270,6 @@ func (me *MysqlService) DisassociateSecurityGroup(ctx context.Context, mysqlId s
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DisassociateSecurityGroups(request)
 
 	if err != nil {
@@ -1334,7 +1295,6 @@ func (me *MysqlService) ModifyAutoRenewFlag(ctx context.Context, mysqlId string,
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().ModifyAutoRenewFlag(request)
 
 	if err != nil {
@@ -1358,7 +1318,6 @@ func (me *MysqlService) IsolateDBInstance(ctx context.Context, mysqlId string) (
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().IsolateDBInstance(request)
 
 	if err != nil {
@@ -1384,7 +1343,6 @@ func (me *MysqlService) OfflineIsolatedInstances(ctx context.Context, mysqlId st
 				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	_, errRet = me.client.UseMysqlClient().OfflineIsolatedInstances(request)
 
 	return
@@ -1402,8 +1360,6 @@ func (me *MysqlService) DescribeMysqlTimeWindowById(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DescribeTimeWindow(request)
 	if err != nil {
 		errRet = err
@@ -1427,8 +1383,6 @@ func (me *MysqlService) DeleteMysqlTimeWindowById(ctx context.Context, instanceI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DeleteTimeWindow(request)
 	if err != nil {
 		errRet = err
@@ -1451,8 +1405,6 @@ func (me *MysqlService) DescribeMysqlParamTemplateById(ctx context.Context, temp
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DescribeParamTemplateInfo(request)
 	if err != nil {
 		errRet = err
@@ -1476,8 +1428,6 @@ func (me *MysqlService) DescribeMysqlParamTemplateInfoById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DescribeParamTemplates(request)
 	if err != nil {
 		errRet = err
@@ -1505,8 +1455,6 @@ func (me *MysqlService) DeleteMysqlParamTemplateById(ctx context.Context, templa
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DeleteParamTemplate(request)
 	if err != nil {
 		errRet = err
@@ -1529,8 +1477,6 @@ func (me *MysqlService) DescribeMysqlDeployGroupById(ctx context.Context, deploy
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1576,8 +1522,6 @@ func (me *MysqlService) DeleteMysqlDeployGroupById(ctx context.Context, deployGr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DeleteDeployGroups(request)
 	if err != nil {
 		errRet = err
@@ -1600,8 +1544,6 @@ func (me *MysqlService) DescribeMysqlSecurityGroupsAttachmentById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DescribeDBSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -1635,8 +1577,6 @@ func (me *MysqlService) DeleteMysqlSecurityGroupsAttachmentById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DisassociateSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -1659,8 +1599,6 @@ func (me *MysqlService) DescribeMysqlLocalBinlogConfigById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DescribeLocalBinlogConfig(request)
 	if err != nil {
 		errRet = err
@@ -1685,8 +1623,6 @@ func (me *MysqlService) DescribeMysqlAuditLogFileById(ctx context.Context, insta
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DescribeAuditLogFiles(request)
 	if err != nil {
 		errRet = err
@@ -1715,8 +1651,6 @@ func (me *MysqlService) DeleteMysqlAuditLogFileById(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DeleteAuditLogFile(request)
 	if err != nil {
 		errRet = err
@@ -1759,8 +1693,6 @@ func (me *MysqlService) DescribeMysqlBackupOverviewByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DescribeBackupOverview(request)
 	if err != nil {
 		errRet = err
@@ -1799,8 +1731,6 @@ func (me *MysqlService) DescribeMysqlBackupSummariesByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1847,8 +1777,6 @@ func (me *MysqlService) DescribeMysqlBinLogByFilter(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1895,7 +1823,6 @@ func (me *MysqlService) DescribeMysqlBinlogBackupOverviewByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeBinlogBackupOverview(request)
 	if err != nil {
 		errRet = err
@@ -1929,8 +1856,6 @@ func (me *MysqlService) DescribeMysqlCloneListByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1977,7 +1902,6 @@ func (me *MysqlService) DescribeMysqlDataBackupOverviewByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeDataBackupOverview(request)
 	if err != nil {
 		errRet = err
@@ -2012,7 +1936,6 @@ func (me *MysqlService) DescribeMysqlDbFeaturesByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeDBFeatures(request)
 	if err != nil {
 		errRet = err
@@ -2053,8 +1976,6 @@ func (me *MysqlService) DescribeMysqlInstTablesByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -2099,7 +2020,6 @@ func (me *MysqlService) DescribeMysqlInstanceCharsetByFilter(ctx context.Context
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeDBInstanceCharset(request)
 	if err != nil {
 		errRet = err
@@ -2130,7 +2050,6 @@ func (me *MysqlService) DescribeMysqlInstanceInfoById(ctx context.Context, insta
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeDBInstanceInfo(request)
 	if err != nil {
 		errRet = err
@@ -2165,8 +2084,6 @@ func (me *MysqlService) DescribeMysqlInstanceParamRecordByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset      int64 = 0
 		limit       int64 = 20
@@ -2216,7 +2133,6 @@ func (me *MysqlService) DescribeMysqlInstanceRebootTimeByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeDBInstanceRebootTime(request)
 	if err != nil {
 		errRet = err
@@ -2247,7 +2163,6 @@ func (me *MysqlService) DescribeMysqlProxyCustomById(ctx context.Context, instan
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeProxyCustomConf(request)
 	if err != nil {
 		errRet = err
@@ -2288,7 +2203,6 @@ func (me *MysqlService) DescribeMysqlRollbackRangeTimeByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeRollbackRangeTime(request)
 	if err != nil {
 		errRet = err
@@ -2323,8 +2237,6 @@ func (me *MysqlService) DescribeMysqlSlowLogByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -2395,8 +2307,6 @@ func (me *MysqlService) DescribeMysqlSlowLogDataByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -2439,7 +2349,6 @@ func (me *MysqlService) DescribeMysqlSupportedPrivilegesById(ctx context.Context
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeSupportedPrivileges(request)
 	if err != nil {
 		errRet = err
@@ -2470,8 +2379,6 @@ func (me *MysqlService) DescribeMysqlSwitchRecordById(ctx context.Context, insta
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 200
@@ -2518,8 +2425,6 @@ func (me *MysqlService) DescribeMysqlUploadedFilesByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -2593,8 +2498,6 @@ func (me *MysqlService) DescribeMysqlUserTaskByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -2634,8 +2537,6 @@ func (me *MysqlService) DescribeMysqlBackupDownloadRestrictionById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DescribeBackupDownloadRestriction(request)
 	if err != nil {
 		errRet = err
@@ -2659,8 +2560,6 @@ func (me *MysqlService) DescribeMysqlBackupEncryptionStatusById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DescribeBackupEncryptionStatus(request)
 	if err != nil {
 		errRet = err
@@ -2684,8 +2583,6 @@ func (me *MysqlService) DescribeMysqlDbImportJobById(ctx context.Context, instan
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -2732,8 +2629,6 @@ func (me *MysqlService) DeleteMysqlDbImportJobById(ctx context.Context, asyncReq
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().StopDBImportJob(request)
 	if err != nil {
 		errRet = err
@@ -2756,8 +2651,6 @@ func (me *MysqlService) DeleteMysqlIsolateInstanceById(ctx context.Context, inst
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().ReleaseIsolatedDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -2780,8 +2673,6 @@ func (me *MysqlService) DescribeMysqlPasswordComplexityById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DescribeInstanceParams(request)
 	if err != nil {
 		errRet = err
@@ -2812,8 +2703,6 @@ func (me *MysqlService) DescribeMysqlProxyById(ctx context.Context, instanceId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DescribeCdbProxyInfo(request)
 	if err != nil {
 		errRet = err
@@ -2846,8 +2735,6 @@ func (me *MysqlService) ModifyCdbProxyAddressVipAndVPort(ctx context.Context, pr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().ModifyCdbProxyAddressVipAndVPort(request)
 	if err != nil {
 		errRet = err
@@ -2872,8 +2759,6 @@ func (me *MysqlService) ModifyCdbProxyAddressDesc(ctx context.Context, proxyGrou
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().ModifyCdbProxyAddressDesc(request)
 	if err != nil {
 		errRet = err
@@ -2900,8 +2785,6 @@ func (me *MysqlService) UpgradeCDBProxyVersion(ctx context.Context, instanceId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().UpgradeCDBProxyVersion(request)
 	if err != nil {
 		errRet = err
@@ -2924,8 +2807,6 @@ func (me *MysqlService) DeleteMysqlProxyById(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().CloseCDBProxy(request)
 	if err != nil {
 		errRet = err
@@ -2948,8 +2829,6 @@ func (me *MysqlService) DescribeMysqlRemoteBackupConfigById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DescribeRemoteBackupConfig(request)
 	if err != nil {
 		errRet = err
@@ -2974,8 +2853,6 @@ func (me *MysqlService) DescribeMysqlRollbackById(ctx context.Context, instanceI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DescribeRollbackTaskDetail(request)
 	if err != nil {
 		errRet = err
@@ -3002,8 +2879,6 @@ func (me *MysqlService) DeleteMysqlRollbackById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().StopRollback(request)
 	if err != nil {
 		errRet = err
@@ -3027,8 +2902,6 @@ func (me *MysqlService) DescribeMysqlRoGroupById(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseMysqlClient().DescribeRoGroups(request)
 	if err != nil {
 		errRet = err
@@ -3080,8 +2953,6 @@ func (me *MysqlService) DescribeMysqlErrorLogByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -3128,7 +2999,6 @@ func (me *MysqlService) DescribeMysqlProjectSecurityGroupByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeProjectSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -3164,7 +3034,6 @@ func (me *MysqlService) DescribeMysqlRoMinScaleByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseMysqlClient().DescribeRoMinScale(request)
 	if err != nil {
 		errRet = err
@@ -3201,8 +3070,6 @@ func (me *MysqlService) DescribeMysqlDatabasesByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset   int64 = 0
 		limit    int64 = 20
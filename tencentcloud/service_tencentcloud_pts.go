@@ -6,7 +6,6 @@ import (
 
 	pts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/pts/v20210728"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type PtsService struct {
@@ -57,7 +56,6 @@ func (me *PtsService) DeletePtsProjectById(ctx context.Context, projectId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePtsClient().DeleteProjects(request)
 	if err != nil {
 		errRet = err
@@ -115,7 +113,6 @@ func (me *PtsService) DeletePtsAlertChannelById(ctx context.Context, noticeId, p
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePtsClient().DeleteAlertChannel(request)
 	if err != nil {
 		errRet = err
@@ -173,7 +170,6 @@ func (me *PtsService) DeletePtsScenarioById(ctx context.Context, projectId, scen
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePtsClient().DeleteScenarios(request)
 	if err != nil {
 		errRet = err
@@ -231,7 +227,6 @@ func (me *PtsService) DeletePtsFileById(ctx context.Context, projectId, fileIds
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePtsClient().DeleteFiles(request)
 	if err != nil {
 		errRet = err
@@ -291,7 +286,6 @@ func (me *PtsService) DeletePtsJobById(ctx context.Context, projectId, scenarioI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePtsClient().DeleteJobs(request)
 	if err != nil {
 		errRet = err
@@ -349,7 +343,6 @@ func (me *PtsService) DeletePtsCronJobById(ctx context.Context, cronJobId, proje
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UsePtsClient().DeleteCronJobs(request)
 	if err != nil {
 		errRet = err
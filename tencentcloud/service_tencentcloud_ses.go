@@ -6,7 +6,6 @@ import (
 
 	ses "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ses/v20201002"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type SesService struct {
@@ -32,7 +31,6 @@ func (me *SesService) DescribeSesTemplateMetadata(ctx context.Context, templateI
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseSesClient().ListEmailTemplates(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -109,7 +107,6 @@ func (me *SesService) DeleteSesTemplateById(ctx context.Context, templateID uint
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSesClient().DeleteEmailTemplate(request)
 	if err != nil {
 		errRet = err
@@ -171,7 +168,6 @@ func (me *SesService) DeleteSesEmail_addressById(ctx context.Context, emailAddre
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSesClient().DeleteEmailAddress(request)
 	if err != nil {
 		errRet = err
@@ -227,7 +223,6 @@ func (me *SesService) DeleteSesDomainById(ctx context.Context, emailIdentity str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSesClient().DeleteEmailIdentity(request)
 	if err != nil {
 		errRet = err
@@ -7,7 +7,6 @@ import (
 	sms "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sms/v20210111"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type SmsService struct {
@@ -58,7 +57,6 @@ func (me *SmsService) DeleteSmsSignById(ctx context.Context, signId string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSmsClient().DeleteSmsSign(request)
 	if err != nil {
 		errRet = err
@@ -115,7 +113,6 @@ func (me *SmsService) DeleteSmsTemplateById(ctx context.Context, templateId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSmsClient().DeleteSmsTemplate(request)
 	if err != nil {
 		errRet = err
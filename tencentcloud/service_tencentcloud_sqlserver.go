@@ -13,7 +13,6 @@ import (
 	sqlserver "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type SqlserverService struct {
@@ -33,7 +32,6 @@ func (me *SqlserverService) DescribeZones(ctx context.Context) (zoneInfoList []*
 
 	var response *sqlserver.DescribeZonesResponse
 	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseSqlserverClient().DescribeZones(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s DescribeZones fail, reason:%s\n", logId, e.Error())
@@ -66,7 +64,6 @@ func (me *SqlserverService) DescribeProductConfig(ctx context.Context, zone stri
 
 	var response *sqlserver.DescribeProductConfigResponse
 	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseSqlserverClient().DescribeProductConfig(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s DescribeProductConfig fail, reason:%s\n", logId, e.Error())
@@ -93,7 +90,6 @@ func (me *SqlserverService) CreateSqlserverInstance(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().CreateDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -130,7 +126,6 @@ func (me *SqlserverService) ModifySqlserverInstanceName(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().ModifyDBInstanceName(request)
 	return err
 }
@@ -146,7 +141,6 @@ func (me *SqlserverService) ModifySqlserverInstanceProjectId(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().ModifyDBInstanceProject(request)
 	return err
 }
@@ -170,7 +164,6 @@ func (me *SqlserverService) UpgradeSqlserverInstance(ctx context.Context, instan
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().UpgradeDBInstance(request)
 	if err != nil {
 		return err
@@ -210,7 +203,6 @@ func (me *SqlserverService) RemoveSecurityGroup(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().DisassociateSecurityGroups(request)
 
 	return err
@@ -228,7 +220,6 @@ func (me *SqlserverService) AddSecurityGroup(ctx context.Context, instanceId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().AssociateSecurityGroups(request)
 	time.Sleep(10 * time.Second)
 	return err
@@ -256,7 +247,6 @@ func (me *SqlserverService) ModifySqlserverInstanceMaintenanceSpan(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().ModifyMaintenanceSpan(request)
 
 	return err
@@ -272,7 +262,6 @@ func (me *SqlserverService) TerminateSqlserverInstance(ctx context.Context, inst
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().TerminateDBInstance(request)
 	return err
 }
@@ -287,7 +276,6 @@ func (me *SqlserverService) DeleteSqlserverInstance(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().DeleteDBInstance(request)
 	return err
 }
@@ -327,7 +315,6 @@ func (me *SqlserverService) DescribeSqlserverInstances(ctx context.Context, inst
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 		if err != nil {
 			errRet = err
@@ -373,7 +360,6 @@ func (me *SqlserverService) DescribeMaintenanceSpan(ctx context.Context, instanc
 			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]", logId, request.GetAction(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeMaintenanceSpan(request)
 	if err != nil {
 		errRet = err
@@ -402,7 +388,6 @@ func (me *SqlserverService) DescribeInstanceSecurityGroups(ctx context.Context,
 			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]", logId, request.GetAction(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeDBSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -438,7 +423,6 @@ func (me *SqlserverService) DescribeSqlserverBackups(ctx context.Context, instan
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseSqlserverClient().DescribeBackups(request)
 		if err != nil {
 			errRet = err
@@ -465,7 +449,6 @@ func (me *SqlserverService) DescribeReadonlyGroupList(ctx context.Context, insta
 			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]", logId, request.GetAction(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeReadOnlyGroupList(request)
 	if err != nil {
 		errRet = err
@@ -489,7 +472,6 @@ func (me *SqlserverService) CreateSqlserverReadonlyInstance(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().CreateReadOnlyDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -526,7 +508,6 @@ func (me *SqlserverService) DescribeReadonlyGroupListByReadonlyInstanceId(ctx co
 			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]", logId, request.GetAction(), errRet.Error())
 		}
 	}()
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeReadOnlyGroupByReadOnlyInstance(request)
 	if err != nil {
 		errRet = err
@@ -558,7 +539,6 @@ func (me *SqlserverService) CreateSqlserverAccount(ctx context.Context, instance
 	}
 	request.Accounts = []*sqlserver.AccountCreateInfo{&account}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().CreateAccount(request)
 	if err != nil {
 		errRet = err
@@ -593,7 +573,6 @@ func (me *SqlserverService) DescribeSqlserverAccounts(ctx context.Context, insta
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseSqlserverClient().DescribeAccounts(request)
 		if err != nil {
 			ee, ok := err.(*SDKErrors.TencentCloudSDKError)
@@ -648,7 +627,6 @@ func (me *SqlserverService) ModifySqlserverAccountRemark(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().ModifyAccountRemark(request)
 	return err
 }
@@ -664,7 +642,6 @@ func (me *SqlserverService) ResetSqlserverAccountPassword(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().ResetAccountPassword(request)
 	if err != nil {
 		errRet = err
@@ -701,7 +678,6 @@ func (me *SqlserverService) DeleteSqlserverAccount(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().DeleteAccount(request)
 	if err != nil {
 		ee, ok := err.(*SDKErrors.TencentCloudSDKError)
@@ -764,7 +740,6 @@ func (me *SqlserverService) ModifyAccountDBAttachment(ctx context.Context, insta
 		return fmt.Errorf("SQL Server DB %s , instance ID %s is not exist", dbName, instanceId)
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().ModifyAccountPrivilege(request)
 	if response == nil || response.Response == nil {
 		errRet = fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
@@ -856,7 +831,6 @@ func (me *SqlserverService) GetInfoFromDeal(ctx context.Context, dealId string)
 
 	var flowId int64
 	outErr := resource.Retry(40*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseSqlserverClient().DescribeOrders(request)
 		if err != nil {
 			return resource.RetryableError(err)
@@ -909,7 +883,6 @@ func (me *SqlserverService) WaitForTaskFinish(ctx context.Context, flowId int64)
 
 	errRet = resource.Retry(4*writeRetryTimeout, func() *resource.RetryError {
 		taskResponse, err := me.client.UseSqlserverClient().DescribeFlowStatus(request)
-		ratelimit.Check(request.GetAction())
 		if err != nil {
 			return resource.NonRetryableError(errors.WithStack(err))
 		}
@@ -948,7 +921,6 @@ func (me *SqlserverService) CreateSqlserverDB(ctx context.Context, instanceID st
 
 	var response *sqlserver.CreateDBResponse
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseSqlserverClient().CreateDB(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
@@ -987,7 +959,6 @@ func (me *SqlserverService) DescribeDBsOfInstance(ctx context.Context, instanceI
 		request.Limit = &limit
 		var response *sqlserver.DescribeDBsResponse
 		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			result, e := me.client.UseSqlserverClient().DescribeDBs(request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
@@ -1060,7 +1031,6 @@ func (me *SqlserverService) ModifySqlserverDBRemark(ctx context.Context, instanc
 	}()
 
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, e := me.client.UseSqlserverClient().ModifyDBRemark(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
@@ -1088,7 +1058,6 @@ func (me *SqlserverService) DeleteSqlserverDB(ctx context.Context, instanceId st
 
 	var response *sqlserver.DeleteDBResponse
 	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, e := me.client.UseSqlserverClient().DeleteDB(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s %s fail, reason:%s", logId, request.GetAction(), e.Error())
@@ -1125,7 +1094,6 @@ func (me *SqlserverService) CreateSqlserverPublishSubscribe(ctx context.Context,
 
 	var response *sqlserver.CreatePublishSubscribeResponse
 	errRet = resource.Retry(2*writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet = me.client.UseSqlserverClient().CreatePublishSubscribe(request)
 		if errRet != nil {
 			if ee, ok := errRet.(*SDKErrors.TencentCloudSDKError); ok {
@@ -1222,11 +1190,9 @@ func (me *SqlserverService) DescribeSqlserverPublishSubscribes(ctx context.Conte
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
 		var response *sqlserver.DescribePublishSubscribeResponse
 		var err error
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseSqlserverClient().DescribePublishSubscribe(request)
 			if err != nil {
 				log.Printf("[CRITAL]%s DescribePublishSubscribe fail, reason:%s", logId, err.Error())
@@ -1262,7 +1228,6 @@ func (me *SqlserverService) ModifyPublishSubscribeName(ctx context.Context, id u
 	request.PublishSubscribeId = &id
 	request.PublishSubscribeName = &name
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, e := me.client.UseSqlserverClient().ModifyPublishSubscribeName(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s", logId, request.GetAction(), e.Error())
@@ -1286,7 +1251,6 @@ func (me *SqlserverService) DeletePublishSubscribe(ctx context.Context, publishS
 	var response *sqlserver.DeletePublishSubscribeResponse
 	var err error
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseSqlserverClient().DeletePublishSubscribe(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s %s fail, reason:%s", logId, request.GetAction(), err.Error())
@@ -1313,7 +1277,6 @@ func (me *SqlserverService) RecycleDBInstance(ctx context.Context, instanceId st
 	}()
 	var err error
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err = me.client.UseSqlserverClient().RecycleDBInstance(request)
 		if err != nil {
 			// FIXME: if action offline then kill this function
@@ -1396,7 +1359,6 @@ func (me *SqlserverService) CreateSqlserverBasicInstance(ctx context.Context, pa
 		request.SecurityGroupList = append(request.SecurityGroupList, &v)
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().CreateBasicDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -1435,7 +1397,6 @@ func (me *SqlserverService) UpgradeSqlserverBasicInstance(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().UpgradeDBInstance(request)
 	if err != nil {
 		return err
@@ -1480,7 +1441,6 @@ func (me *SqlserverService) NewModifyDBInstanceRenewFlag(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseSqlserverClient().ModifyDBInstanceRenewFlag(request)
 
 	return err
@@ -1498,8 +1458,6 @@ func (me *SqlserverService) DescribeSqlserverMigrationById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeMigrationDetail(request)
 	if err != nil {
 		errRet = err
@@ -1523,8 +1481,6 @@ func (me *SqlserverService) DeleteSqlserverMigrationById(ctx context.Context, mi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DeleteMigration(request)
 	if err != nil {
 		errRet = err
@@ -1566,7 +1522,6 @@ func (me *SqlserverService) DescribeSqlserverMigrationsByFilter(ctx context.Cont
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 20
@@ -1574,7 +1529,6 @@ func (me *SqlserverService) DescribeSqlserverMigrationsByFilter(ctx context.Cont
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseSqlserverClient().DescribeMigrations(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1609,8 +1563,6 @@ func (me *SqlserverService) DescribeSqlserverConfigBackupStrategyById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -1645,7 +1597,6 @@ func (me *SqlserverService) DescribeSqlserverBackupByBackupId(ctx context.Contex
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseSqlserverClient().DescribeBackups(request)
 		if err != nil {
 			errRet = err
@@ -1679,7 +1630,6 @@ func (me *SqlserverService) DescribeBackupByFlowId(ctx context.Context, instance
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeBackupByFlowId(request)
 	if err != nil {
 		errRet = err
@@ -1704,7 +1654,6 @@ func (me *SqlserverService) DescribeSqlserverBackupsById(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeBackupFiles(request)
 	if err != nil {
 		errRet = err
@@ -1733,8 +1682,6 @@ func (me *SqlserverService) DeleteSqlserverGeneralBackupsById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().RemoveBackups(request)
 	if err != nil {
 		errRet = err
@@ -1772,8 +1719,6 @@ func (me *SqlserverService) DescribeSqlserverBackupCommand(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeBackupCommand(request)
 	if err != nil {
 		errRet = err
@@ -1801,7 +1746,6 @@ func (me *SqlserverService) DescribeCloneStatusByFlowId(ctx context.Context, flo
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeFlowStatus(request)
 	if err != nil {
 		errRet = err
@@ -1824,8 +1768,6 @@ func (me *SqlserverService) DescribeSqlserverGeneralCloneById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBsNormal(request)
 	if err != nil {
 		errRet = err
@@ -1853,7 +1795,6 @@ func (me *SqlserverService) DeleteSqlserverGeneralCloneDB(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DeleteDB(request)
 	if err != nil {
 		errRet = err
@@ -1877,8 +1818,6 @@ func (me *SqlserverService) DescribeSqlserverFullBackupMigrationById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeBackupMigration(request)
 	if err != nil {
 		errRet = err
@@ -1906,8 +1845,6 @@ func (me *SqlserverService) DeleteSqlserverFullBackupMigrationById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DeleteBackupMigration(request)
 	if err != nil {
 		errRet = err
@@ -1932,7 +1869,6 @@ func (me *SqlserverService) DescribeSqlserverIncreBackupMigrationById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeIncrementalMigration(request)
 	if err != nil {
 		errRet = err
@@ -1962,8 +1898,6 @@ func (me *SqlserverService) DeleteSqlserverIncreBackupMigrationById(ctx context.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DeleteIncrementalMigration(request)
 	if err != nil {
 		errRet = err
@@ -1988,8 +1922,6 @@ func (me *SqlserverService) DescribeSqlserverBusinessIntelligenceFileById(ctx co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeBusinessIntelligenceFile(request)
 	if err != nil {
 		errRet = err
@@ -2018,8 +1950,6 @@ func (me *SqlserverService) DeleteSqlserverBusinessIntelligenceFileById(ctx cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DeleteBusinessIntelligenceFile(request)
 	if err != nil {
 		errRet = err
@@ -2042,8 +1972,6 @@ func (me *SqlserverService) DescribeSqlserverBusinessIntelligenceInstanceById(ct
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -2071,8 +1999,6 @@ func (me *SqlserverService) DescribeMaintenanceSpanById(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeMaintenanceSpan(request)
 	if err != nil {
 		errRet = err
@@ -2100,7 +2026,6 @@ func (me *SqlserverService) TerminateSqlserverInstanceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().TerminateDBInstance(request)
 	if err != nil {
 		errRet = err
@@ -2123,7 +2048,6 @@ func (me *SqlserverService) DeleteSqlserverInstanceById(ctx context.Context, ins
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DeleteDBInstance(request)
 	if err != nil {
 		errRet = err
@@ -2149,8 +2073,6 @@ func (me *SqlserverService) DescribeSqlserverGeneralCommunicationById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstanceInter(request)
 	if err != nil {
 		errRet = err
@@ -2178,8 +2100,6 @@ func (me *SqlserverService) DeleteSqlserverGeneralCommunicationById(ctx context.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().CloseInterCommunication(request)
 	if err != nil {
 		errRet = err
@@ -2219,7 +2139,6 @@ func (me *SqlserverService) DescribeSqlserverBackupUploadSizeByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeBackupUploadSize(request)
 	if err != nil {
 		errRet = err
@@ -2253,8 +2172,6 @@ func (me *SqlserverService) DescribeSqlserverCrossRegionZoneByFilter(ctx context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeCrossRegionZone(request)
 	if err != nil {
 		errRet = err
@@ -2290,7 +2207,6 @@ func (me *SqlserverService) DescribeSqlserverDatasourceDBCharsetsByFilter(ctx co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSqlserverClient().DescribeDBCharsets(request)
 	if err != nil {
 		errRet = err
@@ -2325,8 +2241,6 @@ func (me *SqlserverService) DescribeSqlserverInstanceParamRecordsByFilter(ctx co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 100
@@ -2376,8 +2290,6 @@ func (me *SqlserverService) DescribeSqlserverProjectSecurityGroupsByFilter(ctx c
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeProjectSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -2407,8 +2319,6 @@ func (me *SqlserverService) DescribeSqlserverDatasourceRegionsByFilter(ctx conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeRegions(request)
 	if err != nil {
 		errRet = err
@@ -2447,8 +2357,6 @@ func (me *SqlserverService) DescribeSqlserverRollbackTimeByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeRollbackTime(request)
 	if err != nil {
 		errRet = err
@@ -2490,8 +2398,6 @@ func (me *SqlserverService) DescribeSqlserverSlowlogsByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		limit  int64  = 100
 		offset uint64 = 0
@@ -2544,8 +2450,6 @@ func (me *SqlserverService) DescribeSqlserverUploadBackupInfoByFilter(ctx contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeUploadBackupInfo(request)
 	if err != nil {
 		errRet = err
@@ -2587,8 +2491,6 @@ func (me *SqlserverService) DescribeSqlserverUploadIncrementalInfoByFilter(ctx c
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeUploadIncrementalInfo(request)
 	if err != nil {
 		errRet = err
@@ -2618,8 +2520,6 @@ func (me *SqlserverService) DescribeSqlserverConfigDatabaseCDCById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBsNormal(request)
 	if err != nil {
 		errRet = err
@@ -2648,8 +2548,6 @@ func (me *SqlserverService) DescribeSqlserverGeneralCloudInstanceById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -2678,8 +2576,6 @@ func (me *SqlserverService) DescribeSqlserverConfigDatabaseCTById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBsNormal(request)
 	if err != nil {
 		errRet = err
@@ -2708,8 +2604,6 @@ func (me *SqlserverService) DescribeSqlserverConfigDatabaseMdfById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBsNormal(request)
 	if err != nil {
 		errRet = err
@@ -2738,8 +2632,6 @@ func (me *SqlserverService) DescribeSqlserverConfigInstanceNetworkById(ctx conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -2768,8 +2660,6 @@ func (me *SqlserverService) DescribeSqlserverConfigInstanceParamById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeInstanceParams(request)
 	if err != nil {
 		errRet = err
@@ -2799,8 +2689,6 @@ func (me *SqlserverService) DescribeSqlserverConfigInstanceRoGroupById(ctx conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeReadOnlyGroupDetails(request)
 	if err != nil {
 		errRet = err
@@ -2828,8 +2716,6 @@ func (me *SqlserverService) DescribeSqlserverConfigInstanceSecurityGroupsById(ct
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -2858,8 +2744,6 @@ func (me *SqlserverService) DescribeSqlserverRenewDBInstanceById(ctx context.Con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -2887,8 +2771,6 @@ func (me *SqlserverService) DescribeSqlserverRenewPostpaidDBInstanceById(ctx con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -2916,8 +2798,6 @@ func (me *SqlserverService) DescribeSqlserverRestartDBInstanceById(ctx context.C
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -2945,8 +2825,6 @@ func (me *SqlserverService) DescribeSqlserverRestoreInstanceById(ctx context.Con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBs(request)
 	if err != nil {
 		errRet = err
@@ -2987,8 +2865,6 @@ func (me *SqlserverService) DescribeSqlserverRollbackInstanceById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBs(request)
 	if err != nil {
 		errRet = err
@@ -3029,8 +2905,6 @@ func (me *SqlserverService) DescribeSqlserverConfigTerminateDBInstanceById(ctx c
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -3059,8 +2933,6 @@ func (me *SqlserverService) DescribeSqlserverDBS(ctx context.Context, instanceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBs(request)
 	if err != nil {
 		errRet = err
@@ -3089,8 +2961,6 @@ func (me *SqlserverService) DescribeSqlserverGeneralCloudRoInstanceById(ctx cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
 	if err != nil {
 		errRet = err
@@ -3119,8 +2989,6 @@ func (me *SqlserverService) DeleteSqlserverGeneralCloudRoInstanceById(ctx contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DeleteDBInstance(request)
 	if err != nil {
 		errRet = err
@@ -3158,8 +3026,6 @@ func (me *SqlserverService) DescribeSqlserverQueryXeventByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -3206,8 +3072,6 @@ func (me *SqlserverService) DescribeSqlserverInsAttributeByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstancesAttribute(request)
 	if err != nil {
 		errRet = err
@@ -3237,8 +3101,6 @@ func (me *SqlserverService) DescribeSqlserverInstanceTDEById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBInstancesAttribute(request)
 	if err != nil {
 		errRet = err
@@ -3263,8 +3125,6 @@ func (me *SqlserverService) DescribeSqlserverDatabaseTDEById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSqlserverClient().DescribeDBs(request)
 	if err != nil {
 		errRet = err
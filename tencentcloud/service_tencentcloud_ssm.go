@@ -8,7 +8,6 @@ import (
 	ssm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssm/v20190923"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type SsmService struct {
@@ -66,7 +65,6 @@ func (me *SsmService) DescribeSecretsByFilter(ctx context.Context, param map[str
 	for {
 		request.Offset = helper.Uint64(offset)
 		request.Limit = helper.Uint64(pageSize)
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseSsmClient().ListSecrets(request)
 		if err != nil {
 			errRet = err
@@ -92,7 +90,6 @@ func (me *SsmService) DescribeSecretByName(ctx context.Context, secretName strin
 	logId := getLogId(ctx)
 	request := ssm.NewDescribeSecretRequest()
 	request.SecretName = helper.String(secretName)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().DescribeSecret(request)
 	if err != nil {
@@ -121,7 +118,6 @@ func (me *SsmService) DescribeSecretVersionIdsByName(ctx context.Context, secret
 	logId := getLogId(ctx)
 	request := ssm.NewListSecretVersionIdsRequest()
 	request.SecretName = helper.String(secretName)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().ListSecretVersionIds(request)
 	if err != nil {
@@ -143,7 +139,6 @@ func (me *SsmService) DescribeSecretVersion(ctx context.Context, secretName, ver
 	request := ssm.NewGetSecretValueRequest()
 	request.SecretName = helper.String(secretName)
 	request.VersionId = helper.String(versionId)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().GetSecretValue(request)
 	if err != nil {
@@ -193,7 +188,6 @@ func (me *SsmService) CreateSecret(ctx context.Context, param map[string]interfa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseSsmClient().CreateSecret(request)
 	if err != nil {
 		errRet = err
@@ -211,7 +205,6 @@ func (me *SsmService) UpdateSecretDescription(ctx context.Context, secretName, d
 	request := ssm.NewUpdateDescriptionRequest()
 	request.SecretName = helper.String(secretName)
 	request.Description = helper.String(description)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().UpdateDescription(request)
 	if err != nil {
@@ -240,7 +233,6 @@ func (me *SsmService) UpdateSecret(ctx context.Context, param map[string]interfa
 			request.SecretString = helper.String(v.(string))
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().UpdateSecret(request)
 	if err != nil {
@@ -256,7 +248,6 @@ func (me *SsmService) EnableSecret(ctx context.Context, secretName string) (errR
 	logId := getLogId(ctx)
 	request := ssm.NewEnableSecretRequest()
 	request.SecretName = helper.String(secretName)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().EnableSecret(request)
 	if err != nil {
@@ -272,7 +263,6 @@ func (me *SsmService) DisableSecret(ctx context.Context, secretName string) (err
 	logId := getLogId(ctx)
 	request := ssm.NewDisableSecretRequest()
 	request.SecretName = helper.String(secretName)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().DisableSecret(request)
 	if err != nil {
@@ -301,7 +291,6 @@ func (me *SsmService) PutSecretValue(ctx context.Context, param map[string]inter
 			request.SecretString = helper.String(v.(string))
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().PutSecretValue(request)
 	if err != nil {
@@ -321,7 +310,6 @@ func (me *SsmService) DeleteSecretVersion(ctx context.Context, secretName, versi
 	request := ssm.NewDeleteSecretVersionRequest()
 	request.SecretName = helper.String(secretName)
 	request.VersionId = helper.String(versionId)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().DeleteSecretVersion(request)
 	if err != nil {
@@ -338,7 +326,6 @@ func (me *SsmService) DeleteSecret(ctx context.Context, secretName string, recov
 	request := ssm.NewDeleteSecretRequest()
 	request.SecretName = helper.String(secretName)
 	request.RecoveryWindowInDays = helper.Uint64(recoveryWindowInDays)
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseSsmClient().DeleteSecret(request)
 	if err != nil {
@@ -363,8 +350,6 @@ func (me *SsmService) DescribeSecretById(ctx context.Context, secretName string,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -414,8 +399,6 @@ func (me *SsmService) DeleteSsmSshKeyPairSecretById(ctx context.Context, secretN
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseSsmClient().DeleteSecret(request)
 	if err != nil {
 		errRet = err
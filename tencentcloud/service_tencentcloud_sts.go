@@ -6,7 +6,6 @@ import (
 
 	sts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sts/v20180813"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type StsService struct {
@@ -26,7 +25,6 @@ func (me *StsService) DescribeStsCallerIdentityByFilter(ctx context.Context) (ca
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseStsClient().GetCallerIdentity(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -9,7 +9,6 @@ import (
 	tag "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type TagService struct {
@@ -43,8 +42,6 @@ func (me *TagService) ModifyTags(ctx context.Context, resourceName string, repla
 	}
 
 	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseTagClient().ModifyResourceTags(request); err != nil {
 			return retryError(errors.WithStack(err))
 		}
@@ -68,8 +65,6 @@ func (me *TagService) DescribeResourceTags(ctx context.Context, serviceType, res
 	count := DESCRIBE_TAGS_LIMIT
 	for count == DESCRIBE_TAGS_LIMIT {
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := me.client.UseTagClient().DescribeResourceTagsByResourceIds(request)
 			if err != nil {
 				count = 0
@@ -102,6 +97,50 @@ func (me *TagService) DescribeResourceTags(ctx context.Context, serviceType, res
 	return
 }
 
+// DescribeResourcesByTagKey returns the tags of the resources with the tag key in all the regions, the keys of the
+// result are the resource names like the ones built by BuildTagResourceName
+func (me *TagService) DescribeResourcesByTagKey(ctx context.Context, tagKey string) (resources map[string]map[string]string, errRet error) {
+	logId := getLogId(ctx)
+	request := tag.NewGetResourcesRequest()
+	request.TagFilters = []*tag.TagFilter{{TagKey: &tagKey}}
+	request.MaxResults = helper.IntUint64(DESCRIBE_TAGS_LIMIT)
+
+	resources = make(map[string]map[string]string)
+	for {
+		var response *tag.GetResourcesResponse
+		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			result, err := me.client.UseTagClient().GetResources(request)
+			if err != nil {
+				return retryError(errors.WithStack(err))
+			}
+			response = result
+			return nil
+		}); err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), err.Error())
+			errRet = err
+			return
+		}
+
+		for _, mapping := range response.Response.ResourceTagMappingList {
+			if mapping.Resource == nil {
+				continue
+			}
+			tags := make(map[string]string, len(mapping.Tags))
+			for _, t := range mapping.Tags {
+				if t.TagKey != nil && t.TagValue != nil {
+					tags[*t.TagKey] = *t.TagValue
+				}
+			}
+			resources[*mapping.Resource] = tags
+		}
+		if response.Response.PaginationToken == nil || *response.Response.PaginationToken == "" {
+			return
+		}
+		request.PaginationToken = response.Response.PaginationToken
+	}
+}
+
 func diffTags(oldTags, newTags map[string]interface{}) (replaceTags map[string]string, deleteTags []string) {
 	replaceTags = make(map[string]string)
 	deleteTags = make([]string, 0)
@@ -131,8 +170,6 @@ func (me *TagService) DescribeProjectById(ctx context.Context, projectId uint64)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	// query enable project
 	request.AllList = helper.Uint64(0)
 
@@ -223,8 +260,6 @@ func (me *TagService) DisableProjectById(ctx context.Context, projectId uint64)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTagClient().UpdateProject(request)
 	if err != nil {
 		errRet = err
@@ -253,8 +288,6 @@ func (me *TagService) DescribeProjects(ctx context.Context, param map[string]int
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
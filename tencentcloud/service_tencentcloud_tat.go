@@ -7,7 +7,6 @@ import (
 	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type TatService struct {
@@ -34,7 +33,6 @@ func (me *TatService) DescribeTatCommand(ctx context.Context, commandId string)
 			Values: []*string{&commandId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 100
@@ -43,7 +41,6 @@ func (me *TatService) DescribeTatCommand(ctx context.Context, commandId string)
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTatClient().DescribeCommands(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -87,7 +84,6 @@ func (me *TatService) DeleteTatCommandById(ctx context.Context, commandId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTatClient().DeleteCommand(request)
 	if err != nil {
 		errRet = err
@@ -119,7 +115,6 @@ func (me *TatService) DescribeTatInvoker(ctx context.Context, invokerId string)
 			Values: []*string{&invokerId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 100
@@ -128,7 +123,6 @@ func (me *TatService) DescribeTatInvoker(ctx context.Context, invokerId string)
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTatClient().DescribeInvokers(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -172,7 +166,6 @@ func (me *TatService) DeleteTatInvokerById(ctx context.Context, invokerId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTatClient().DeleteInvoker(request)
 	if err != nil {
 		errRet = err
@@ -239,15 +232,12 @@ func (me *TatService) DescribeTatCommandByFilter(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var offset uint64 = 0
 	var pageSize uint64 = 20
 
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTatClient().DescribeCommands(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -314,7 +304,6 @@ func (me *TatService) DescribeTatInvokerByFilter(ctx context.Context, param map[
 			)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -322,7 +311,6 @@ func (me *TatService) DescribeTatInvokerByFilter(ctx context.Context, param map[
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTatClient().DescribeInvokers(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -366,8 +354,6 @@ func (me *TatService) DescribeTatAgentByFilter(ctx context.Context, param map[st
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -414,8 +400,6 @@ func (me *TatService) DescribeTatInvokerRecordsByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -456,8 +440,6 @@ func (me *TatService) DescribeTatInvokerConfigById(ctx context.Context, invokerI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -503,8 +485,6 @@ func (me *TatService) DescribeTatInvocationById(ctx context.Context, invocationI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -551,8 +531,6 @@ func (me *TatService) DeleteTatInvocationById(ctx context.Context, invocationId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTatClient().CancelInvocation(request)
 	if err != nil {
 		errRet = err
@@ -587,8 +565,6 @@ func (me *TatService) DescribeTatInvocationTaskByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -14,7 +14,6 @@ import (
 	tcaplusdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcaplusdb/v20190823"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type TcaplusService struct {
@@ -34,7 +33,6 @@ func (me *TcaplusService) CreateCluster(ctx context.Context, idlType, clusterNam
 	request.SubnetId = &subnetId
 	request.IdlType = &idlType
 	request.ClusterName = &clusterName
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().CreateCluster(request)
 	if err != nil {
 		errRet = err
@@ -81,7 +79,6 @@ func (me *TcaplusService) DescribeClusters(ctx context.Context, clusterId string
 	request.Limit = &limit
 	request.Offset = &offset
 	for {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTcaplusClient().DescribeClusters(request)
 		if err != nil {
 			if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -114,7 +111,6 @@ func (me *TcaplusService) DescribeCluster(ctx context.Context, id string) (clust
 		}
 	}()
 	request.ClusterIds = []*string{&id}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().DescribeClusters(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -155,7 +151,6 @@ func (me *TcaplusService) DeleteCluster(ctx context.Context, id string) (taskId
 	}()
 	request.ClusterId = &id
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().DeleteCluster(request)
 	if err != nil {
 		errRet = err
@@ -185,7 +180,6 @@ func (me *TcaplusService) ModifyClusterName(ctx context.Context, id string, clus
 	request.ClusterId = &id
 	request.ClusterName = helper.String(url.QueryEscape(clusterName))
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().ModifyClusterName(request)
 	if err != nil {
 		errRet = err
@@ -221,7 +215,6 @@ func (me *TcaplusService) ModifyClusterPassword(ctx context.Context, id string,
 		ex := expireTime.In(loc).Format("2006-01-02 15:04:05")
 		request.OldPasswordExpireTime = &ex
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().ModifyClusterPassword(request)
 	if err != nil {
 		errRet = err
@@ -243,7 +236,6 @@ func (me *TcaplusService) DescribeTask(ctx context.Context, clusterId string, ta
 	}()
 	request.ClusterIds = []*string{&clusterId}
 	request.TaskIds = []*string{&taskId}
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseTcaplusClient().DescribeTasks(request)
 	if err != nil {
@@ -277,7 +269,6 @@ func (me *TcaplusService) CreateGroup(ctx context.Context, id string, groupName
 	}()
 	request.TableGroupName = &groupName
 	request.ClusterId = &id
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().CreateTableGroup(request)
 	if err != nil {
 		errRet = err
@@ -334,7 +325,6 @@ func (me *TcaplusService) DescribeGroups(ctx context.Context, clusterId string,
 	request.Limit = &limit
 
 	for {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTcaplusClient().DescribeTableGroups(request)
 
 		if err != nil {
@@ -378,7 +368,6 @@ func (me *TcaplusService) DescribeGroup(ctx context.Context, id string, groupId
 
 	request.ClusterId = &id
 	request.TableGroupIds = []*string{&groupId}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().DescribeTableGroups(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -427,7 +416,6 @@ func (me *TcaplusService) DeleteGroup(ctx context.Context, clusterId string, gro
 
 	request.ClusterId = &clusterId
 	request.TableGroupId = &groupId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().DeleteTableGroup(request)
 	if err != nil {
 		errRet = err
@@ -458,7 +446,6 @@ func (me *TcaplusService) ModifyGroupName(ctx context.Context, id string, groupI
 	request.ClusterId = &id
 	request.TableGroupId = &groupId
 	request.TableGroupName = &groupName
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().ModifyTableGroupName(request)
 	if err != nil {
 		errRet = err
@@ -491,7 +478,6 @@ func (me *TcaplusService) DeleteIdlFiles(ctx context.Context, tid TcaplusIdlId)
 			FileId:      &tid.FileId,
 		},
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().DeleteIdlFiles(request)
 	if err != nil {
 		errRet = err
@@ -524,7 +510,6 @@ func (me *TcaplusService) DesOldIdlFiles(ctx context.Context, tid TcaplusIdlId)
 			FileId:      &tid.FileId,
 		},
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().VerifyIdlFiles(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -567,7 +552,6 @@ func (me *TcaplusService) VerifyIdlFiles(ctx context.Context, tid TcaplusIdlId,
 		},
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().VerifyIdlFiles(request)
 	if err != nil {
 		errRet = err
@@ -645,7 +629,6 @@ func (me *TcaplusService) CreateTables(ctx context.Context, tid TcaplusIdlId,
 		},
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().CreateTables(request)
 	if err != nil {
 		errRet = err
@@ -727,7 +710,6 @@ func (me *TcaplusService) DescribeTables(ctx context.Context, clusterId string,
 	request.Offset = &offset
 	request.Limit = &limit
 	for {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTcaplusClient().DescribeTables(request)
 
 		if err != nil {
@@ -770,7 +752,6 @@ func (me *TcaplusService) DescribeTable(ctx context.Context, clusterId, tableIns
 		},
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().DescribeTables(request)
 	if err != nil {
 		if sdkErr, ok := err.(*sdkError.TencentCloudSDKError); ok {
@@ -830,7 +811,6 @@ func (me *TcaplusService) DeleteTable(ctx context.Context, clusterId, groupId, t
 		},
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().DeleteTables(request)
 	if err != nil {
 		errRet = err
@@ -877,7 +857,6 @@ func (me *TcaplusService) ModifyTableMemo(ctx context.Context, clusterId, groupI
 		},
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcaplusClient().ModifyTableMemos(request)
 	if err != nil {
 		errRet = err
@@ -940,7 +919,6 @@ func (me *TcaplusService) ModifyTables(ctx context.Context, tid TcaplusIdlId,
 			TableIdlType:    &tableIdType,
 		},
 	}
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseTcaplusClient().ModifyTables(request)
 	if err != nil {
@@ -982,7 +960,6 @@ func (me *TcaplusService) DescribeIdlFileInfos(ctx context.Context, clusterId st
 	request.Offset = &offset
 	request.Limit = &limit
 	for {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTcaplusClient().DescribeIdlFileInfos(request)
 
 		if err != nil {
@@ -7,7 +7,6 @@ import (
 	tcm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcm/v20210413"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type TcmService struct {
@@ -55,7 +54,6 @@ func (me *TcmService) DeleteTcmMeshById(ctx context.Context, meshId string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcmClient().DeleteMesh(request)
 	if err != nil {
 		errRet = err
@@ -82,7 +80,6 @@ func (me *TcmService) DeleteTcmClusterAttachmentById(ctx context.Context, meshId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcmClient().UnlinkCluster(request)
 	if err != nil {
 		errRet = err
@@ -108,7 +105,6 @@ func (me *TcmService) DeleteTcmPrometheusAttachmentById(ctx context.Context, mes
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTcmClient().UnlinkPrometheus(request)
 	if err != nil {
 		errRet = err
@@ -169,15 +165,12 @@ func (me *TcmService) DescribeTcmMeshByFilter(ctx context.Context, param map[str
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var offset int64 = 0
 	var pageSize int64 = 50
 
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTcmClient().DescribeMeshList(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
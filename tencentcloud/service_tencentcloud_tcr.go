@@ -11,7 +11,6 @@ import (
 	tcr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tcr/v20190924"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type TCRService struct {
@@ -66,7 +65,6 @@ func (me *TCRService) CreateTCRInstance(ctx context.Context, name string, instan
 		request.TagSpecification = &tagSpec
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().CreateInstance(request)
 	if err != nil {
 		errRet = err
@@ -91,7 +89,6 @@ func (me *TCRService) ManageTCRExternalEndpoint(ctx context.Context, instanceId,
 	request.Operation = &operation
 	request.RegistryId = &instanceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().ManageExternalEndpoint(request)
 	if err != nil {
 		errRet = err
@@ -114,7 +111,6 @@ func (me *TCRService) DescribeExternalEndpointStatus(ctx context.Context, instan
 	}()
 	request.RegistryId = &instanceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().DescribeExternalEndpointStatus(request)
 	if err != nil {
 		errRet = err
@@ -142,7 +138,6 @@ func (me *TCRService) DescribeSecurityPolicies(ctx context.Context, request *tcr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().DescribeSecurityPolicies(request)
 	if err != nil {
 		errRet = err
@@ -169,7 +164,6 @@ func (me *TCRService) DescribeTCRInstanceById(ctx context.Context, instanceId st
 	}()
 	request.Registryids = []*string{&instanceId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().DescribeInstances(request)
 	if err != nil {
 		ee, ok := err.(*sdkErrors.TencentCloudSDKError)
@@ -217,7 +211,6 @@ func (me *TCRService) DescribeTCRInstances(ctx context.Context, instanceId strin
 		request.Offset = &offset
 		request.Limit = &limit
 
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTCRClient().DescribeInstances(request)
 		if err != nil {
 			errRet = err
@@ -245,7 +238,6 @@ func (me *TCRService) DeleteTCRInstance(ctx context.Context, instanceId string,
 	request.RegistryId = &instanceId
 	request.DeleteBucket = &deleteBucket
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTCRClient().DeleteInstance(request)
 	return err
 }
@@ -281,7 +273,6 @@ func (me *TCRService) CreateTCRNameSpace(ctx context.Context, instanceId string,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().CreateNamespace(request)
 	if err != nil {
 		errRet = err
@@ -304,7 +295,6 @@ func (me *TCRService) ModifyInstance(ctx context.Context, registryId, registryTy
 	}()
 	request.RegistryId = helper.String(registryId)
 	request.RegistryType = helper.String(registryType)
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTCRClient().ModifyInstance(request)
 	return err
 }
@@ -337,7 +327,6 @@ func (me *TCRService) ModifyTCRNameSpace(ctx context.Context, instanceId string,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTCRClient().ModifyNamespace(request)
 	return err
 }
@@ -353,7 +342,6 @@ func (me *TCRService) DeleteTCRNameSpace(ctx context.Context, instanceId string,
 	request.RegistryId = &instanceId
 	request.NamespaceName = &name
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTCRClient().DeleteNamespace(request)
 	return err
 }
@@ -375,7 +363,6 @@ func (me *TCRService) DescribeTCRNameSpaces(ctx context.Context, instanceId stri
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTCRClient().DescribeNamespaces(request)
 		if err != nil {
 			ee, ok := err.(*sdkErrors.TencentCloudSDKError)
@@ -442,7 +429,6 @@ func (me *TCRService) CreateTCRRepository(ctx context.Context, instanceId string
 		request.Description = &description
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().CreateRepository(request)
 	if err != nil {
 		errRet = err
@@ -474,7 +460,6 @@ func (me *TCRService) ModifyTCRRepository(ctx context.Context, instanceId string
 		request.Description = &description
 	}
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTCRClient().ModifyRepository(request)
 	return err
 }
@@ -491,7 +476,6 @@ func (me *TCRService) DeleteTCRRepository(ctx context.Context, instanceId string
 	request.NamespaceName = &namespace
 	request.RepositoryName = &repositoryName
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTCRClient().DeleteRepository(request)
 	return err
 }
@@ -515,7 +499,6 @@ func (me *TCRService) DescribeTCRRepositories(ctx context.Context, instanceId st
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTCRClient().DescribeRepositories(request)
 		if err != nil {
 			ee, ok := err.(*sdkErrors.TencentCloudSDKError)
@@ -575,7 +558,6 @@ func (me *TCRService) CreateTCRLongTermToken(ctx context.Context, instanceId str
 	request.TokenType = helper.String("longterm")
 	request.Desc = &description
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().CreateInstanceToken(request)
 	if err != nil {
 		errRet = err
@@ -603,7 +585,6 @@ func (me *TCRService) ModifyTCRLongTermToken(ctx context.Context, instanceId str
 	request.TokenId = &tokenId
 	request.Enable = &enable
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTCRClient().ModifyInstanceToken(request)
 	return err
 }
@@ -619,7 +600,6 @@ func (me *TCRService) DeleteTCRLongTermToken(ctx context.Context, instanceId str
 	request.RegistryId = &instanceId
 	request.TokenId = &tokenId
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTCRClient().DeleteInstanceToken(request)
 	return err
 }
@@ -638,7 +618,6 @@ func (me *TCRService) DescribeTCRTokens(ctx context.Context, instanceId string,
 	for {
 		request.Offset = &offset
 		request.Limit = &limit
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTCRClient().DescribeInstanceToken(request)
 		if err != nil {
 			errRet = err
@@ -699,7 +678,6 @@ func (me *TCRService) CreateTCRVPCAttachment(ctx context.Context, instanceId str
 	request.RegionId = helper.Int64Uint64(regionId)
 	request.RegionName = &regionName
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().ManageInternalEndpoint(request)
 	if err != nil {
 		errRet = err
@@ -728,7 +706,6 @@ func (me *TCRService) DeleteTCRVPCAttachment(ctx context.Context, instanceId str
 	request.RegionId = helper.IntUint64(regionId)
 	request.RegionName = &regionName
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().ManageInternalEndpoint(request)
 	if err != nil {
 		errRet = err
@@ -762,7 +739,6 @@ func (me *TCRService) DescribeTCRVPCAttachments(ctx context.Context, instanceId
 	}()
 	request.RegistryId = &instanceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().DescribeInternalEndpoints(request)
 	if err != nil {
 		errRet = err
@@ -817,7 +793,6 @@ func (me *TCRService) CreateTcrVpcDns(ctx context.Context, instanceId string, vp
 	request.UsePublicDomain = &usePublicDomain
 	request.RegionName = &regionName
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().CreateInternalEndpointDns(request)
 	if err != nil {
 		errRet = err
@@ -843,7 +818,6 @@ func (me *TCRService) DeleteTcrVpcDns(ctx context.Context, instanceId string, vp
 	request.UsePublicDomain = &usePublicDomain
 	request.RegionName = &regionName
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().DeleteInternalEndpointDns(request)
 	if err != nil {
 		errRet = err
@@ -870,7 +844,6 @@ func (me *TCRService) DescribeTcrVpcDnsById(ctx context.Context, instanceId stri
 		UsePublicDomain: &usePublicDomain,
 	})
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().DescribeInternalEndpointDnsStatus(request)
 	if err != nil {
 		errRet = err
@@ -900,7 +873,6 @@ func (me *TCRService) CreateReplicationInstance(ctx context.Context, request *tc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().CreateReplicationInstance(request)
 	if err != nil {
 		errRet = err
@@ -959,7 +931,6 @@ func (me *TCRService) DeleteReplicationInstance(ctx context.Context, request *tc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().DeleteReplicationInstance(request)
 	if err != nil {
 		errRet = err
@@ -1017,7 +988,6 @@ func (me *TCRService) DescribeReplicationInstances(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTCRClient().DescribeReplicationInstances(request)
 	if err != nil {
 		errRet = err
@@ -1045,8 +1015,6 @@ func (me *TCRService) DescribeTcrTagRetentionRuleById(ctx context.Context, regis
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTCRClient().DescribeTagRetentionRules(request)
 	if err != nil {
 		errRet = err
@@ -1085,8 +1053,6 @@ func (me *TCRService) DeleteTcrTagRetentionRuleById(ctx context.Context, registr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTCRClient().DeleteTagRetentionRule(request)
 	if err != nil {
 		errRet = err
@@ -1110,8 +1076,6 @@ func (me *TCRService) DescribeTcrWebhookTriggerById(ctx context.Context, registr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTCRClient().DescribeWebhookTrigger(request)
 	if err != nil {
 		errRet = err
@@ -1147,8 +1111,6 @@ func (me *TCRService) DeleteTcrWebhookTriggerById(ctx context.Context, registryI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTCRClient().DeleteWebhookTrigger(request)
 	if err != nil {
 		errRet = err
@@ -1183,8 +1145,6 @@ func (me *TCRService) DescribeTcrWebhookTriggerLogByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1225,8 +1185,6 @@ func (me *TCRService) DescribeTcrCustomizedDomainById(ctx context.Context, regis
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTCRClient().DescribeInstanceCustomizedDomain(request)
 	if err != nil {
 		errRet = err
@@ -1265,8 +1223,6 @@ func (me *TCRService) DeleteTcrCustomizedDomainById(ctx context.Context, registr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTCRClient().DeleteInstanceCustomizedDomain(request)
 	if err != nil {
 		errRet = err
@@ -1302,8 +1258,6 @@ func (me *TCRService) DescribeTcrImmutableTagRuleById(ctx context.Context, regis
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTCRClient().DescribeImmutableTagRules(request)
 	if err != nil {
 		errRet = err
@@ -1355,8 +1309,6 @@ func (me *TCRService) DeleteTcrImmutableTagRuleById(ctx context.Context, registr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTCRClient().DeleteImmutableTagRules(request)
 	if err != nil {
 		errRet = err
@@ -1400,8 +1352,6 @@ func (me *TCRService) DescribeTcrImagesByFilter(ctx context.Context, param map[s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1457,8 +1407,6 @@ func (me *TCRService) DescribeTcrImageManifestsByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTCRClient().DescribeImageManifests(request)
 	if err != nil {
 		errRet = err
@@ -1498,8 +1446,6 @@ func (me *TCRService) DescribeTcrTagRetentionExecutionTasksByFilter(ctx context.
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1541,8 +1487,6 @@ func (me *TCRService) DescribeTcrTagRetentionExecutionConfigById(ctx context.Con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTCRClient().DescribeTagRetentionExecution(request)
 	if err != nil {
 		errRet = err
@@ -1611,8 +1555,6 @@ func (me *TCRService) DescribeTcrReplicationInstanceCreateTasksByFilter(ctx cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTCRClient().DescribeReplicationInstanceCreateTasks(request)
 	if err != nil {
 		errRet = err
@@ -1655,8 +1597,6 @@ func (me *TCRService) DescribeTcrReplicationInstanceSyncStatusByFilter(ctx conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1699,8 +1639,6 @@ func (me *TCRService) DescribeTcrTagRetentionExecutionsByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1741,8 +1679,6 @@ func (me *TCRService) DescribeTcrServiceAccountById(ctx context.Context, registr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTCRClient().DescribeServiceAccounts(request)
 	if err != nil {
 		errRet = err
@@ -1781,8 +1717,6 @@ func (me *TCRService) DeleteTcrServiceAccountById(ctx context.Context, registryI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTCRClient().DeleteServiceAccount(request)
 	if err != nil {
 		errRet = err
@@ -9,7 +9,6 @@ import (
 	tdcpg "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdcpg/v20211118"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 const (
@@ -70,7 +69,6 @@ func (me *TdcpgService) IsolateTdcpgInstanceById(ctx context.Context, clusterId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTdcpgClient().IsolateClusterInstances(request)
 	if err != nil {
 		errRet = err
@@ -125,7 +123,6 @@ func (me *TdcpgService) DeleteTdcpgClusterById(ctx context.Context, clusterId *s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTdcpgClient().DeleteCluster(request)
 	if err != nil {
 		errRet = err
@@ -228,7 +225,6 @@ func (me *TdcpgService) IsolateTdcpgClusterById(ctx context.Context, clusterId *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTdcpgClient().IsolateCluster(request)
 	if err != nil {
 		errRet = err
@@ -286,7 +282,6 @@ func (me *TdcpgService) DeleteTdcpgInstanceById(ctx context.Context, clusterId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTdcpgClient().DeleteClusterInstances(request)
 	if err != nil {
 		errRet = err
@@ -382,7 +377,6 @@ func (me *TdcpgService) DescribeTdcpgClustersByFilter(ctx context.Context, param
 			continue
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	for {
 		request.PageNumber = helper.IntUint64(currNumber)
@@ -465,7 +459,6 @@ func (me *TdcpgService) DescribeTdcpgInstancesByFilter(ctx context.Context, clus
 			continue
 		}
 	}
-	ratelimit.Check(request.GetAction())
 
 	for {
 		request.PageNumber = helper.IntUint64(currNumber)
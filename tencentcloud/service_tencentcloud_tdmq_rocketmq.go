@@ -11,7 +11,6 @@ import (
 	tdmqRocketmq "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tdmq/v20200217"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type TdmqRocketmqService struct {
@@ -60,7 +59,6 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqClusterById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTdmqClient().DeleteRocketMQCluster(request)
 	if err != nil {
 		errRet = err
@@ -92,7 +90,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqNamespace(ctx context.Context
 	for {
 		request.Limit = &limit
 		request.Offset = &offset
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRocketMQNamespaces(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -129,7 +126,6 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqNamespaceById(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTdmqClient().DeleteRocketMQNamespace(request)
 	if err != nil {
 		errRet = err
@@ -162,7 +158,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqRole(ctx context.Context, clu
 			Values: []*string{&roleName},
 		},
 	)
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -171,7 +166,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqRole(ctx context.Context, clu
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRoles(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -216,7 +210,6 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqRoleById(ctx context.Context, c
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTdmqClient().DeleteRoles(request)
 	if err != nil {
 		errRet = err
@@ -251,7 +244,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqTopic(ctx context.Context, cl
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRocketMQTopics(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -291,7 +283,6 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqTopicById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTdmqClient().DeleteRocketMQTopic(request)
 	if err != nil {
 		errRet = err
@@ -326,7 +317,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqGroup(ctx context.Context, cl
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRocketMQGroups(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -366,7 +356,6 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqGroupById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTdmqClient().DeleteRocketMQGroup(request)
 	if err != nil {
 		errRet = err
@@ -400,7 +389,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqEnvironmentRole(ctx context.C
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeEnvironmentRoles(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -439,7 +427,6 @@ func (me *TdmqRocketmqService) DeleteTdmqRocketmqEnvironmentRoleById(ctx context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTdmqClient().DeleteEnvironmentRoles(request)
 	if err != nil {
 		errRet = err
@@ -478,15 +465,12 @@ func (me *TdmqRocketmqService) DescribeRocketmqClusterByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var offset uint64 = 0
 	var pageSize uint64 = 20
 
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRocketMQClusters(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -532,7 +516,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqNamespaceByFilter(ctx context
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -540,7 +523,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqNamespaceByFilter(ctx context
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRocketMQNamespaces(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -599,7 +581,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqTopicByFilter(ctx context.Con
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -607,7 +588,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqTopicByFilter(ctx context.Con
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRocketMQTopics(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -646,7 +626,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqRoleByFilter(ctx context.Cont
 	if v, ok := param["role_name"]; ok {
 		request.RoleName = helper.String(v.(string))
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 20
@@ -654,7 +633,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqRoleByFilter(ctx context.Cont
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRoles(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -712,7 +690,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqGroupByFilter(ctx context.Con
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset uint64 = 0
 	var pageSize uint64 = 20
@@ -720,7 +697,6 @@ func (me *TdmqRocketmqService) DescribeTdmqRocketmqGroupByFilter(ctx context.Con
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTdmqClient().DescribeRocketMQGroups(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -765,8 +741,6 @@ func (me *TdmqService) DescribeTdmqEnvironmentAttributesByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeEnvironmentAttributes(request)
 	if err != nil {
 		errRet = err
@@ -807,8 +781,6 @@ func (me *TdmqService) DescribeTdmqPublisherSummaryByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribePublisherSummary(request)
 	if err != nil {
 		errRet = err
@@ -855,8 +827,6 @@ func (me *TdmqService) DescribeTdmqPublishersByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 100
@@ -901,8 +871,6 @@ func (me *TdmqService) DescribeTdmqSubscriptionAttachmentById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeSubscriptions(request)
 	if err != nil {
 		errRet = err
@@ -941,8 +909,6 @@ func (me *TdmqService) GetTdmqTopicsAttachmentById(ctx context.Context, environm
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeTopics(request)
 	if err != nil {
 		errRet = err
@@ -984,8 +950,6 @@ func (me *TdmqService) DeleteTdmqTopicsAttachmentById(ctx context.Context, envir
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DeleteTopics(request)
 	if err != nil {
 		errRet = err
@@ -1017,8 +981,6 @@ func (me *TdmqService) DeleteTdmqSubscriptionAttachmentById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DeleteSubscriptions(request)
 	if err != nil {
 		errRet = err
@@ -1051,8 +1013,6 @@ func (me *TdmqService) DescribeTdmqDeadLetterSourceQueueByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1113,8 +1073,6 @@ func (me *TdmqService) DescribeTdmqRabbitmqNodeListByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1164,8 +1122,6 @@ func (me *TdmqService) DescribeTdmqRabbitmqVipInstanceByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1214,8 +1170,6 @@ func (me *TdmqService) DescribeTdmqVipInstanceByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeRocketMQVipInstanceDetail(request)
 	if err != nil {
 		errRet = err
@@ -1250,8 +1204,6 @@ func (me *TdmqService) DescribeTdmqProInstanceDetailByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribePulsarProInstanceDetail(request)
 	if err != nil {
 		errRet = err
@@ -1287,8 +1239,6 @@ func (me *TdmqService) DescribeTdmqProInstancesByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1351,8 +1301,6 @@ func (me *TdmqService) DescribeTdmqMessageByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeRocketMQMsg(request)
 	if err != nil {
 		errRet = err
@@ -1388,8 +1336,6 @@ func (me *TdmqService) DescribeTdmqRabbitmqVirtualHostListByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -1435,8 +1381,6 @@ func (me *TdmqService) DescribeTdmqRabbitmqUserById(ctx context.Context, instanc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeRabbitMQUser(request)
 	if err != nil {
 		errRet = err
@@ -1465,8 +1409,6 @@ func (me *TdmqService) DeleteTdmqRabbitmqUserById(ctx context.Context, instanceI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DeleteRabbitMQUser(request)
 	if err != nil {
 		errRet = err
@@ -1493,8 +1435,6 @@ func (me *TdmqService) DescribeTdmqRabbitmqVirtualHostById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeRabbitMQVirtualHost(request)
 	if err != nil {
 		errRet = err
@@ -1524,8 +1464,6 @@ func (me *TdmqService) DeleteTdmqRabbitmqVirtualHostById(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DeleteRabbitMQVirtualHost(request)
 	if err != nil {
 		errRet = err
@@ -1549,8 +1487,6 @@ func (me *TdmqService) DescribeTdmqRabbitmqVipInstanceById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DescribeRabbitMQVipInstance(request)
 	if err != nil {
 		errRet = err
@@ -1577,8 +1513,6 @@ func (me *TdmqService) DeleteTdmqRabbitmqVipInstanceById(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTdmqClient().DeleteRabbitMQVipInstance(request)
 	if err != nil {
 		errRet = err
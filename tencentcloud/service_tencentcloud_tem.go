@@ -10,7 +10,6 @@ import (
 	tem "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tem/v20210701"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type TemService struct {
@@ -87,7 +86,6 @@ func (me *TemService) DeleteTemEnvironmentById(ctx context.Context, environmentI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTemClient().DestroyEnvironment(request)
 	if err != nil {
 		errRet = err
@@ -141,7 +139,6 @@ func (me *TemService) DeleteTemApplicationById(ctx context.Context, applicationI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTemClient().DeleteApplication(request)
 	if err != nil {
 		errRet = err
@@ -195,7 +192,6 @@ func (me *TemService) DeleteTemWorkloadById(ctx context.Context, environmentId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTemClient().DeleteApplication(request)
 	if err != nil {
 		errRet = err
@@ -249,7 +245,6 @@ func (me *TemService) DeleteTemAppConfigById(ctx context.Context, environmentId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTemClient().DestroyConfigData(request)
 	if err != nil {
 		errRet = err
@@ -305,7 +300,6 @@ func (me *TemService) DeleteTemLogConfigById(ctx context.Context, environmentId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTemClient().DestroyLogConfig(request)
 	if err != nil {
 		errRet = err
@@ -366,7 +360,6 @@ func (me *TemService) DisableTemScaleRuleById(ctx context.Context, environmentId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTemClient().DisableApplicationAutoscaler(request)
 	if err != nil {
 		errRet = err
@@ -393,7 +386,6 @@ func (me *TemService) DeleteTemScaleRuleById(ctx context.Context, environmentId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTemClient().DeleteApplicationAutoscaler(request)
 	if err != nil {
 		errRet = err
@@ -458,7 +450,6 @@ func (me *TemService) DeleteTemGatewayById(ctx context.Context, environmentId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTemClient().DeleteIngress(request)
 	if err != nil {
 		errRet = err
@@ -483,8 +474,6 @@ func (me *TemService) DescribeTemApplicationServiceById(ctx context.Context, env
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTemClient().DescribeApplicationServiceList(request)
 	if err != nil {
 		errRet = err
@@ -510,8 +499,6 @@ func (me *TemService) DeleteTemApplicationServiceById(ctx context.Context, envir
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTemClient().DeleteApplicationService(request)
 	if err != nil {
 		errRet = err
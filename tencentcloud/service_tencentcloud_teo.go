@@ -9,7 +9,6 @@ import (
 
 	teo "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/teo/v20220901"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type TeoService struct {
@@ -36,7 +35,6 @@ func (me *TeoService) DescribeTeoZone(ctx context.Context, zoneId string) (zone
 			Values: []*string{&zoneId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -45,7 +43,6 @@ func (me *TeoService) DescribeTeoZone(ctx context.Context, zoneId string) (zone
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTeoClient().DescribeZones(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -87,7 +84,6 @@ func (me *TeoService) DeleteTeoZoneById(ctx context.Context, zoneId string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DeleteZone(request)
 	if err != nil {
 		errRet = err
@@ -125,7 +121,6 @@ func (me *TeoService) DescribeTeoDnsRecord(ctx context.Context, zoneId, name str
 	request.Match = helper.String("all")
 	request.Order = helper.String("created_on")
 	request.Direction = helper.String("desc")
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 10
@@ -134,7 +129,6 @@ func (me *TeoService) DescribeTeoDnsRecord(ctx context.Context, zoneId, name str
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTeoClient().DescribeDnsRecords(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -179,7 +173,6 @@ func (me *TeoService) DeleteTeoDnsRecordById(ctx context.Context, zoneId, dnsRec
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DeleteDnsRecords(request)
 	if err != nil {
 		errRet = err
@@ -227,7 +220,6 @@ func (me *TeoService) DescribeTeoLoadBalancing(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTeoClient().DescribeLoadBalancing(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -271,7 +263,6 @@ func (me *TeoService) DeleteTeoLoadBalancingById(ctx context.Context, zoneId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DeleteLoadBalancing(request)
 	if err != nil {
 		errRet = err
@@ -319,7 +310,6 @@ func (me *TeoService) DescribeTeoOriginGroup(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTeoClient().DescribeOriginGroup(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -362,7 +352,6 @@ func (me *TeoService) DeleteTeoOriginGroupById(ctx context.Context, zoneId, orig
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DeleteOriginGroup(request)
 	if err != nil {
 		errRet = err
@@ -396,7 +385,6 @@ func (me *TeoService) DescribeTeoRuleEngine(ctx context.Context, zoneId, ruleId
 			Values: []*string{&ruleId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DescribeRules(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -435,7 +423,6 @@ func (me *TeoService) DescribeTeoRuleEngines(ctx context.Context, zoneId string)
 	}()
 
 	request.ZoneId = &zoneId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DescribeRules(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -469,7 +456,6 @@ func (me *TeoService) DeleteTeoRuleEngineById(ctx context.Context, zoneId, ruleI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DeleteRules(request)
 	if err != nil {
 		errRet = err
@@ -509,7 +495,6 @@ func (me *TeoService) DescribeTeoApplicationProxy(ctx context.Context,
 			Values: []*string{&proxyId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -518,7 +503,6 @@ func (me *TeoService) DescribeTeoApplicationProxy(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTeoClient().DescribeApplicationProxies(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -562,7 +546,6 @@ func (me *TeoService) DeleteTeoApplicationProxyById(ctx context.Context, zoneId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DeleteApplicationProxy(request)
 	if err != nil {
 		errRet = err
@@ -603,7 +586,6 @@ func (me *TeoService) DescribeTeoApplicationProxyRule(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DescribeApplicationProxies(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -644,7 +626,6 @@ func (me *TeoService) DeleteTeoApplicationProxyRuleById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DeleteApplicationProxyRule(request)
 	if err != nil {
 		errRet = err
@@ -770,7 +751,6 @@ func (me *TeoService) DescribeTeoDefaultCertificate(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTeoClient().DescribeDefaultCertificates(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -853,7 +833,6 @@ func (me *TeoService) DescribeTeoZoneDDoSPolicyByFilter(ctx context.Context,
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DescribeZoneDDoSPolicy(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -949,7 +928,6 @@ func (me *TeoService) DescribeTeoBotManagedRulesByFilter(ctx context.Context,
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -957,7 +935,6 @@ func (me *TeoService) DescribeTeoBotManagedRulesByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTeoClient().DescribeBotManagedRules(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1004,7 +981,6 @@ func (me *TeoService) DescribeTeoBotPortraitRulesByFilter(ctx context.Context,
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DescribeSecurityPortraitRules(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1035,7 +1011,6 @@ func (me *TeoService) DescribeTeoRuleEngineSettingsByFilter(ctx context.Context)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTeoClient().DescribeRulesSetting(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1065,15 +1040,12 @@ func (me *TeoService) DescribeTeoSecurityPolicyRegionsByFilter(ctx context.Conte
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var offset int64 = 0
 	var pageSize int64 = 20
 
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTeoClient().DescribeSecurityPolicyRegions(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -1120,7 +1092,6 @@ func (me *TeoService) DescribeTeoWafRuleGroupsByFilter(ctx context.Context,
 		}
 
 	}
-	ratelimit.Check(request.GetAction())
 
 	var offset int64 = 0
 	var pageSize int64 = 100
@@ -1128,7 +1099,6 @@ func (me *TeoService) DescribeTeoWafRuleGroupsByFilter(ctx context.Context,
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseTeoClient().DescribeSecurityGroupManagedRules(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -17,7 +17,6 @@ import (
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type ClusterBasicSetting struct {
@@ -139,7 +138,6 @@ getMoreData:
 	}
 	request.Limit = &limit
 	request.Offset = &offset
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DescribeClusterInstances(request)
 	if err != nil {
 		errRet = err
@@ -287,7 +285,6 @@ func (me *TkeService) DescribeCluster(ctx context.Context, id string) (
 
 	request.ClusterIds = []*string{&id}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DescribeClusters(request)
 
 	if err != nil {
@@ -341,7 +338,6 @@ func (me *TkeService) DescribeClusterCommonNames(ctx context.Context, request *t
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DescribeClusterCommonNames(request)
 
 	if err != nil {
@@ -370,7 +366,6 @@ func (me *TkeService) DescribeClusterLevelAttribute(ctx context.Context, id stri
 	if id != "" {
 		request.ClusterID = &id
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DescribeClusterLevelAttribute(request)
 
 	if err != nil {
@@ -403,7 +398,6 @@ func (me *TkeService) DescribeClusterConfig(ctx context.Context, id string, isPu
 
 	request.ClusterId = &id
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DescribeClusterKubeconfig(request)
 
 	if err != nil {
@@ -436,7 +430,6 @@ func (me *TkeService) GetUpgradeInstanceResult(ctx context.Context, id string) (
 
 	request.ClusterId = &id
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().GetUpgradeInstanceProgress(request)
 
 	if err != nil {
@@ -625,7 +618,6 @@ func (me *TkeService) CreateCluster(ctx context.Context,
 		request.ExistedInstancesForNode = existedInstance
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().CreateCluster(request)
 
 	if err != nil {
@@ -654,7 +646,6 @@ func (me *TkeService) CreateClusterInstances(ctx context.Context,
 
 	request.InstanceAdvancedSettings = &iAdvanced
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().CreateClusterInstances(request)
 
 	if err != nil {
@@ -734,7 +725,6 @@ func (me *TkeService) DeleteClusterInstances(ctx context.Context, id string, ins
 	}
 
 	request.InstanceDeleteMode = helper.String("terminate")
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTkeClient().DeleteClusterInstances(request)
 	return err
 }
@@ -753,7 +743,6 @@ func (me *TkeService) DeleteCluster(ctx context.Context, id string) (errRet erro
 	request.ClusterId = &id
 	request.InstanceDeleteMode = helper.String("terminate")
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTkeClient().DeleteCluster(request)
 
 	return err
@@ -786,7 +775,6 @@ func (me *TkeService) DescribeClusterAsGroupsByGroupId(ctx context.Context, id s
 	request.ClusterId = &id
 	request.AutoScalingGroupIds = []*string{&groupId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DescribeClusterAsGroups(request)
 
 	if err != nil {
@@ -814,7 +802,6 @@ func (me *TkeService) DeleteClusterAsGroups(ctx context.Context, id, asGroupId s
 	request.ClusterId = &id
 	request.AutoScalingGroupIds = []*string{&asGroupId}
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTkeClient().DeleteClusterAsGroups(request)
 	if err != nil {
 		errRet = err
@@ -854,7 +841,6 @@ func (me *TkeService) CreateClusterEndpoint(ctx context.Context, id string, subn
 		request.ExtensiveParameters = helper.String(extensiveParameters)
 	}
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTkeClient().CreateClusterEndpoint(request)
 	if err != nil {
 		errRet = err
@@ -874,8 +860,6 @@ func (me *TkeService) DescribeClusterEndpointStatus(ctx context.Context, id stri
 	request.ClusterId = &id
 	request.IsExtranet = &isExtranet
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTkeClient().DescribeClusterEndpointStatus(request)
 	if err != nil {
 		errRet = err
@@ -901,8 +885,6 @@ func (me *TkeService) DeleteClusterEndpoint(ctx context.Context, id string, isIn
 	request.ClusterId = &id
 	request.IsExtranet = &isInternet
 
-	ratelimit.Check(request.GetAction())
-
 	_, err := me.client.UseTkeClient().DeleteClusterEndpoint(request)
 	if err != nil {
 		errRet = err
@@ -922,8 +904,6 @@ func (me *TkeService) ModifyClusterEndpointSP(ctx context.Context, id string, se
 	request.ClusterId = &id
 	request.SecurityPolicies = helper.Strings(securityPolicies)
 
-	ratelimit.Check(request.GetAction())
-
 	_, err := me.client.UseTkeClient().ModifyClusterEndpointSP(request)
 	if err != nil {
 		errRet = err
@@ -943,8 +923,6 @@ func (me *TkeService) ModifyClusterEndpointSG(ctx context.Context, id string, se
 	request.ClusterId = &id
 	request.SecurityGroup = &securityGroup
 
-	ratelimit.Check(request.GetAction())
-
 	_, err := me.client.UseTkeClient().ModifyClusterEndpointSP(request)
 	if err != nil {
 		errRet = err
@@ -974,8 +952,6 @@ func (me *TkeService) ModifyClusterAttribute(ctx context.Context, id string, pro
 		IsAutoUpgrade: &autoUpgradeClusterLevel,
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	_, err := me.client.UseTkeClient().ModifyClusterAttribute(request)
 	if err != nil {
 		errRet = err
@@ -1033,8 +1009,6 @@ func (me *TkeService) ModifyClusterVersion(ctx context.Context, id string, clust
 	request.ClusterId = &id
 	request.DstVersion = &clusterVersion
 
-	ratelimit.Check(request.GetAction())
-
 	_, err := me.client.UseTkeClient().UpdateClusterVersion(request)
 	if err != nil {
 		errRet = err
@@ -1064,8 +1038,6 @@ func (me *TkeService) DescribeKubernetesAvailableClusterVersionsByFilter(ctx con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTkeClient().DescribeAvailableClusterVersion(request)
 	if err != nil {
 		errRet = err
@@ -1088,7 +1060,6 @@ func (me *TkeService) CheckClusterVersion(ctx context.Context, id string, cluste
 		}
 	}()
 	request.ClusterId = &id
-	ratelimit.Check(request.GetAction())
 
 	resp, err := me.client.UseTkeClient().DescribeAvailableClusterVersion(request)
 	if err != nil {
@@ -1120,7 +1091,6 @@ func (me *TkeService) CheckInstancesUpgradeAble(ctx context.Context, id string,
 	}()
 	request.ClusterId = &id
 	request.UpgradeType = &upgradeType
-	ratelimit.Check(request.GetAction())
 
 	resp, err := me.client.UseTkeClient().CheckInstancesUpgradeAble(request)
 	if err != nil {
@@ -1138,7 +1108,9 @@ func (me *TkeService) CheckInstancesUpgradeAble(ctx context.Context, id string,
 	return
 }
 
-func (me *TkeService) UpgradeClusterInstances(ctx context.Context, id string, upgradeType string, instanceIds []string) (errRet error) {
+// UpgradeClusterInstances creates the task to upgrade the instances, maxNotReadyPercent is the max ratio of the
+// pods which are not ready during the upgrade, 0 means the default of TKE
+func (me *TkeService) UpgradeClusterInstances(ctx context.Context, id string, upgradeType string, instanceIds []string, maxNotReadyPercent float64) (errRet error) {
 	logId := getLogId(ctx)
 	request := tke.NewUpgradeClusterInstancesRequest()
 	defer func() {
@@ -1151,7 +1123,30 @@ func (me *TkeService) UpgradeClusterInstances(ctx context.Context, id string, up
 	request.ClusterId = &id
 	request.UpgradeType = &upgradeType
 	request.InstanceIds = helper.Strings(instanceIds)
-	ratelimit.Check(request.GetAction())
+	if maxNotReadyPercent > 0 {
+		request.MaxNotReadyPercent = &maxNotReadyPercent
+	}
+
+	_, err := me.client.UseTkeClient().UpgradeClusterInstances(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	return
+}
+
+// OperateClusterInstancesUpgrade pauses, resumes or aborts the running task of the upgrade of the instances
+func (me *TkeService) OperateClusterInstancesUpgrade(ctx context.Context, id string, operation string) (errRet error) {
+	logId := getLogId(ctx)
+	request := tke.NewUpgradeClusterInstancesRequest()
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]\n", logId, request.GetAction(), errRet.Error())
+		}
+	}()
+	request.Operation = &operation
+	request.ClusterId = &id
 
 	_, err := me.client.UseTkeClient().UpgradeClusterInstances(request)
 	if err != nil {
@@ -1171,7 +1166,6 @@ func (me *TkeService) DescribeImages(ctx context.Context) (imageIds []string, er
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DescribeImages(request)
 	if err != nil {
 		errRet = err
@@ -1224,7 +1218,6 @@ func (me *TkeService) ModifyClusterAsGroupAttribute(ctx context.Context, id, asG
 		},
 	}
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTkeClient().ModifyClusterAsGroupAttribute(request)
 	if err != nil {
 		errRet = err
@@ -1259,7 +1252,6 @@ func (me *TkeService) CreateClusterNodePool(ctx context.Context, clusterId, name
 		request.Taints = taints
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().CreateClusterNodePool(request)
 	if err != nil {
 		errRet = err
@@ -1311,7 +1303,6 @@ func (me *TkeService) ModifyClusterNodePool(ctx context.Context, clusterId, node
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTkeClient().ModifyClusterNodePool(request)
 	if err != nil {
 		errRet = err
@@ -1333,7 +1324,6 @@ func (me *TkeService) ModifyClusterNodePoolDesiredCapacity(ctx context.Context,
 	request.NodePoolId = &nodePoolId
 	request.DesiredCapacity = &desiredCapacity
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTkeClient().ModifyNodePoolDesiredCapacityAboutAsg(request)
 	if err != nil {
 		errRet = err
@@ -1355,7 +1345,6 @@ func (me *TkeService) ModifyClusterNodePoolInstanceTypes(ctx context.Context, cl
 	request.NodePoolId = &nodePoolId
 	request.InstanceTypes = instanceTypes
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTkeClient().ModifyNodePoolInstanceTypes(request)
 	if err != nil {
 		errRet = err
@@ -1378,7 +1367,6 @@ func (me *TkeService) DeleteClusterNodePool(ctx context.Context, id, nodePoolId
 	request.NodePoolIds = []*string{&nodePoolId}
 	request.KeepInstance = &deleteKeepInstance
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTkeClient().DeleteClusterNodePool(request)
 	if err != nil {
 		errRet = err
@@ -1416,7 +1404,6 @@ func (me *TkeService) DescribeNodePool(ctx context.Context, clusterId string, no
 	request.ClusterId = helper.String(clusterId)
 	request.NodePoolId = helper.String(nodePoolId)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DescribeClusterNodePoolDetail(request)
 
 	if err != nil {
@@ -1434,6 +1421,21 @@ func (me *TkeService) DescribeNodePool(ctx context.Context, clusterId string, no
 	return
 }
 
+// DescribeNodePoolInstances returns the worker nodes of the node pool
+func (me *TkeService) DescribeNodePoolInstances(ctx context.Context, clusterId string, nodePoolId string) (instances []InstanceInfo, errRet error) {
+	_, workers, err := me.DescribeClusterInstances(ctx, clusterId)
+	if err != nil {
+		errRet = err
+		return
+	}
+	for _, worker := range workers {
+		if worker.NodePoolId == nodePoolId {
+			instances = append(instances, worker)
+		}
+	}
+	return
+}
+
 // node pool global config
 func (me *TkeService) ModifyClusterNodePoolGlobalConfig(ctx context.Context, request *tke.ModifyClusterAsGroupOptionAttributeRequest) (errRet error) {
 	logId := getLogId(ctx)
@@ -1443,7 +1445,6 @@ func (me *TkeService) ModifyClusterNodePoolGlobalConfig(ctx context.Context, req
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTkeClient().ModifyClusterAsGroupOptionAttribute(request)
 	if err != nil {
 		errRet = err
@@ -1479,7 +1480,6 @@ func (me *TkeService) DescribeClusterNodePoolGlobalConfig(ctx context.Context, c
 
 	request.ClusterId = helper.String(clusterId)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DescribeClusterAsGroupOption(request)
 
 	if err != nil {
@@ -1535,7 +1535,6 @@ func (me *TkeService) DescribeClusterAuthenticationOptions(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	res, err := me.client.UseTkeClient().DescribeClusterAuthenticationOptions(request)
 	if err != nil {
 		errRet = err
@@ -1558,7 +1557,6 @@ func (me *TkeService) ModifyClusterAuthenticationOptions(ctx context.Context, re
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().ModifyClusterAuthenticationOptions(request)
 	if err != nil {
 		errRet = err
@@ -1580,7 +1578,6 @@ func (me *TkeService) ModifyDeletionProtection(ctx context.Context, id string, e
 		request := tke.NewEnableClusterDeletionProtectionRequest()
 		request.ClusterId = &id
 		action = request.GetAction()
-		ratelimit.Check(action)
 		response, err := me.client.UseTkeClient().EnableClusterDeletionProtection(request)
 		if err != nil {
 			errRet = err
@@ -1593,7 +1590,6 @@ func (me *TkeService) ModifyDeletionProtection(ctx context.Context, id string, e
 		request := tke.NewDisableClusterDeletionProtectionRequest()
 		request.ClusterId = &id
 		action = request.GetAction()
-		ratelimit.Check(action)
 		response, err := me.client.UseTkeClient().DisableClusterDeletionProtection(request)
 		if err != nil {
 			errRet = err
@@ -1626,7 +1622,6 @@ func (me *TkeService) AcquireClusterAdminRole(ctx context.Context, clusterId str
 
 	request.ClusterId = &clusterId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().AcquireClusterAdminRole(request)
 
 	if err != nil {
@@ -1702,7 +1697,6 @@ func (me *TkeService) InstallLogAgent(ctx context.Context, request *tke.InstallL
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().InstallLogAgent(request)
 
 	if err != nil {
@@ -1725,7 +1719,6 @@ func (me *TkeService) UninstallLogAgent(ctx context.Context, request *tke.Uninst
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().UninstallLogAgent(request)
 
 	if err != nil {
@@ -1748,7 +1741,6 @@ func (me *TkeService) EnableEventPersistence(ctx context.Context, request *tke.E
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().EnableEventPersistence(request)
 
 	if err != nil {
@@ -1771,7 +1763,6 @@ func (me *TkeService) DisableEventPersistence(ctx context.Context, request *tke.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DisableEventPersistence(request)
 
 	if err != nil {
@@ -1798,7 +1789,6 @@ func (me *TkeService) EnableClusterAudit(ctx context.Context, request *tke.Enabl
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().EnableClusterAudit(request)
 
 	if err != nil {
@@ -1821,7 +1811,6 @@ func (me *TkeService) DisableClusterAudit(ctx context.Context, request *tke.Disa
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DisableClusterAudit(request)
 
 	if err != nil {
@@ -1848,7 +1837,6 @@ func (me *TkeService) DescribeServerlessNodePoolByClusterIdAndNodePoolId(ctx con
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DescribeClusterVirtualNodePools(request)
 
 	if err != nil {
@@ -1880,7 +1868,6 @@ func (me *TkeService) CreateClusterVirtualNodePool(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().CreateClusterVirtualNodePool(request)
 
 	if err != nil {
@@ -1908,7 +1895,6 @@ func (me *TkeService) DeleteClusterVirtualNodePool(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DeleteClusterVirtualNodePool(request)
 
 	if err != nil {
@@ -1932,7 +1918,6 @@ func (me *TkeService) ModifyClusterVirtualNodePool(ctx context.Context, request
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().ModifyClusterVirtualNodePool(request)
 
 	if err != nil {
@@ -1959,7 +1944,6 @@ func (me *TkeService) DescribeClusterVirtualNode(ctx context.Context, clusterId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DescribeClusterVirtualNode(request)
 
 	if err != nil {
@@ -2142,7 +2126,6 @@ func (me *TkeService) createBackupStorageLocation(ctx context.Context, request *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().CreateBackupStorageLocation(request)
 
 	if err != nil {
@@ -2169,7 +2152,6 @@ func (me *TkeService) describeBackupStorageLocations(ctx context.Context, names
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTkeClient().DescribeBackupStorageLocations(request)
 
 	if err != nil {
@@ -2199,7 +2181,6 @@ func (me *TkeService) deleteBackupStorageLocation(ctx context.Context, name stri
 	}()
 	request.Name = common.StringPtr(name)
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseTkeClient().DeleteBackupStorageLocation(request)
 	return err
 }
@@ -2216,8 +2197,6 @@ func (me *TkeService) DescribeTkeEncryptionProtectionById(ctx context.Context, c
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTkeClient().DescribeEncryptionStatus(request)
 	if err != nil {
 		errRet = err
@@ -2241,8 +2220,6 @@ func (me *TkeService) DeleteTkeEncryptionProtectionById(ctx context.Context, clu
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTkeClient().DisableEncryptionProtection(request)
 	if err != nil {
 		errRet = err
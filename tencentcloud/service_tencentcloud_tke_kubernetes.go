@@ -0,0 +1,321 @@
+package tencentcloud
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// kubernetesClient calls the API server of a cluster with the credential which TKE issues to the CAM identity of
+// the provider, it is for the operations which TKE has no API of, like cordon, drain and the tokens of service accounts
+type kubernetesClient struct {
+	host       string
+	token      string
+	httpClient *http.Client
+	ctx        context.Context
+}
+
+// kubernetesNode is the part of a node of the kubernetes API used by the provider
+type kubernetesNode struct {
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Spec struct {
+		ProviderID    string `json:"providerID"`
+		Unschedulable bool   `json:"unschedulable"`
+	} `json:"spec"`
+}
+
+// kubernetesPod is the part of a pod of the kubernetes API used by the provider
+type kubernetesPod struct {
+	Metadata struct {
+		Name            string            `json:"name"`
+		Namespace       string            `json:"namespace"`
+		Annotations     map[string]string `json:"annotations"`
+		OwnerReferences []struct {
+			Kind string `json:"kind"`
+		} `json:"ownerReferences"`
+	} `json:"metadata"`
+	Status struct {
+		Phase string `json:"phase"`
+	} `json:"status"`
+}
+
+// drainTimeoutError is returned when the pods of a node are not evicted in the timeout of the drain
+type drainTimeoutError struct {
+	node    string
+	timeout time.Duration
+	pods    []string
+}
+
+func (me *drainTimeoutError) Error() string {
+	return fmt.Sprintf("the pods of node %s are not evicted in %s: %s", me.node, me.timeout, strings.Join(me.pods, ", "))
+}
+
+// DescribeClusterKubernetesClient returns the client of the API server of the cluster, the endpoint of the internet
+// is preferred to the one of the intranet
+func (me *TkeService) DescribeClusterKubernetesClient(ctx context.Context, clusterId string) (client *kubernetesClient, errRet error) {
+	logId := getLogId(ctx)
+
+	for _, isExtranet := range []bool{true, false} {
+		config, err := me.DescribeClusterConfig(ctx, clusterId, isExtranet)
+		if err != nil {
+			errRet = err
+			return
+		}
+		if config == "" {
+			continue
+		}
+		auth, err := parseKubeconfigAuth(config)
+		if err != nil {
+			log.Printf("[CRITAL]%s parse kubeconfig of cluster [%s] failed, reason:%+v", logId, clusterId, err)
+			errRet = fmt.Errorf("parse kubeconfig of cluster %s failed: %v", clusterId, err)
+			return
+		}
+		if auth.host == "" {
+			continue
+		}
+		return me.newKubernetesClient(auth)
+	}
+	errRet = fmt.Errorf("neither the internet nor the intranet endpoint of cluster %s is enabled", clusterId)
+	return
+}
+
+// newKubernetesClient returns the client of auth, which is sent through the proxy of the provider
+func (me *TkeService) newKubernetesClient(auth *kubeconfigAuth) (*kubernetesClient, error) {
+	var transport *http.Transport
+	if t, ok := me.client.HTTPTransport.(*http.Transport); ok {
+		transport = t.Clone()
+	} else {
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	}
+
+	tlsConfig := &tls.Config{}
+	if auth.clusterCaCertificate != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(auth.clusterCaCertificate)) {
+			return nil, fmt.Errorf("no certificate found in the CA certificate of %s", auth.host)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if auth.clientCertificate != "" {
+		certificate, err := tls.X509KeyPair([]byte(auth.clientCertificate), []byte(auth.clientKey))
+		if err != nil {
+			return nil, fmt.Errorf("load the client certificate of %s failed: %v", auth.host, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	return &kubernetesClient{
+		host:       strings.TrimSuffix(auth.host, "/"),
+		token:      auth.token,
+		httpClient: &http.Client{Transport: transport, Timeout: 30 * time.Second},
+		ctx:        me.client.Context(),
+	}, nil
+}
+
+// do sends the request to the API server and decodes the response into result if it is not nil,
+// the status code is returned with the error of the non-2xx responses
+func (me *kubernetesClient) do(method, path string, contentType string, body interface{}, result interface{}) (int, error) {
+	var reader *bytes.Reader
+	if body != nil {
+		content, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reader = bytes.NewReader(content)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	request, err := http.NewRequestWithContext(me.ctx, method, me.host+path, reader)
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Accept", "application/json")
+	if body != nil {
+		request.Header.Set("Content-Type", contentType)
+	}
+	if me.token != "" {
+		request.Header.Set("Authorization", "Bearer "+me.token)
+	}
+
+	response, err := me.httpClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	content, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return response.StatusCode, err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		var status struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(content, &status) != nil || status.Message == "" {
+			status.Message = string(content)
+		}
+		return response.StatusCode, fmt.Errorf("%s %s failed with %d: %s", method, path, response.StatusCode, status.Message)
+	}
+	if result != nil {
+		if err := json.Unmarshal(content, result); err != nil {
+			return response.StatusCode, fmt.Errorf("decode the response of %s %s failed: %v", method, path, err)
+		}
+	}
+	return response.StatusCode, nil
+}
+
+// describeNodeByInstanceId returns the node of the CVM instance, which is nil if the instance is not a node,
+// the provider ID of the nodes of TKE is like `qcloud:///800002/ins-xxxxxxxx`
+func (me *kubernetesClient) describeNodeByInstanceId(instanceId string) (*kubernetesNode, error) {
+	var nodes struct {
+		Items []*kubernetesNode `json:"items"`
+	}
+	if _, err := me.do(http.MethodGet, "/api/v1/nodes", "", nil, &nodes); err != nil {
+		return nil, err
+	}
+	for _, node := range nodes.Items {
+		if strings.HasSuffix(node.Spec.ProviderID, "/"+instanceId) {
+			return node, nil
+		}
+	}
+	return nil, nil
+}
+
+// cordonNode marks the node of the instance unschedulable, the name of the node is returned,
+// which is empty if the instance is not a node of the cluster
+func (me *kubernetesClient) cordonNode(instanceId string) (nodeName string, errRet error) {
+	node, err := me.describeNodeByInstanceId(instanceId)
+	if err != nil || node == nil {
+		errRet = err
+		return
+	}
+	nodeName = node.Metadata.Name
+	if node.Spec.Unschedulable {
+		return
+	}
+	patch := map[string]interface{}{"spec": map[string]interface{}{"unschedulable": true}}
+	_, errRet = me.do(http.MethodPatch, "/api/v1/nodes/"+url.PathEscape(nodeName), "application/strategic-merge-patch+json", patch, nil)
+	return
+}
+
+// describeEvictablePods returns the pods on the node which are evicted by the drain, the pods of DaemonSets, the
+// mirror pods and the finished pods are skipped like `kubectl drain --ignore-daemonsets`
+func (me *kubernetesClient) describeEvictablePods(nodeName string) ([]*kubernetesPod, error) {
+	var pods struct {
+		Items []*kubernetesPod `json:"items"`
+	}
+	path := "/api/v1/pods?fieldSelector=" + url.QueryEscape("spec.nodeName="+nodeName)
+	if _, err := me.do(http.MethodGet, path, "", nil, &pods); err != nil {
+		return nil, err
+	}
+
+	evictable := make([]*kubernetesPod, 0, len(pods.Items))
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == "Succeeded" || pod.Status.Phase == "Failed" {
+			continue
+		}
+		if _, ok := pod.Metadata.Annotations["kubernetes.io/config.mirror"]; ok {
+			continue
+		}
+		daemon := false
+		for _, owner := range pod.Metadata.OwnerReferences {
+			if owner.Kind == "DaemonSet" {
+				daemon = true
+			}
+		}
+		if !daemon {
+			evictable = append(evictable, pod)
+		}
+	}
+	return evictable, nil
+}
+
+// drainNode evicts the pods on the node through the eviction API, so the PodDisruptionBudgets are respected,
+// and waits until they are deleted. A *drainTimeoutError is returned if they are not deleted in timeout.
+func (me *kubernetesClient) drainNode(nodeName string, timeout time.Duration) error {
+	var (
+		pending []string
+		waiting bool
+	)
+	err := resource.RetryContext(me.ctx, timeout, func() *resource.RetryError {
+		waiting = false
+		pods, err := me.describeEvictablePods(nodeName)
+		if err != nil {
+			return retryError(err)
+		}
+
+		pending = pending[:0]
+		for _, pod := range pods {
+			pending = append(pending, pod.Metadata.Namespace+"/"+pod.Metadata.Name)
+
+			eviction := map[string]interface{}{
+				"apiVersion": "policy/v1",
+				"kind":       "Eviction",
+				"metadata": map[string]interface{}{
+					"name":      pod.Metadata.Name,
+					"namespace": pod.Metadata.Namespace,
+				},
+			}
+			path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/eviction",
+				url.PathEscape(pod.Metadata.Namespace), url.PathEscape(pod.Metadata.Name))
+			status, err := me.do(http.MethodPost, path, "application/json", eviction, nil)
+			// 429 means the eviction is refused by a PodDisruptionBudget for now
+			if err != nil && status != http.StatusNotFound && status != http.StatusTooManyRequests {
+				return resource.NonRetryableError(err)
+			}
+		}
+
+		if len(pending) > 0 {
+			waiting = true
+			return resource.RetryableError(fmt.Errorf("%d pods on node %s are not evicted", len(pending), nodeName))
+		}
+		return nil
+	})
+	// the error of the timeout is the last retryable one
+	if err != nil && waiting && me.ctx.Err() == nil {
+		sort.Strings(pending)
+		return &drainTimeoutError{node: nodeName, timeout: timeout, pods: pending}
+	}
+	return err
+}
+
+// createServiceAccountToken issues a token of the service account through the TokenRequest API, which expires in
+// expirationSeconds, the expiration time is returned in RFC3339
+func (me *kubernetesClient) createServiceAccountToken(namespace, name string, expirationSeconds int) (token string, expirationTime string, errRet error) {
+	request := map[string]interface{}{
+		"apiVersion": "authentication.k8s.io/v1",
+		"kind":       "TokenRequest",
+		"spec":       map[string]interface{}{"expirationSeconds": expirationSeconds},
+	}
+	var response struct {
+		Status struct {
+			Token               string `json:"token"`
+			ExpirationTimestamp string `json:"expirationTimestamp"`
+		} `json:"status"`
+	}
+	path := fmt.Sprintf("/api/v1/namespaces/%s/serviceaccounts/%s/token", url.PathEscape(namespace), url.PathEscape(name))
+	if _, errRet = me.do(http.MethodPost, path, "application/json", request, &response); errRet != nil {
+		return
+	}
+	if response.Status.Token == "" {
+		errRet = fmt.Errorf("no token of service account %s/%s is issued", namespace, name)
+		return
+	}
+	token, expirationTime = response.Status.Token, response.Status.ExpirationTimestamp
+	return
+}
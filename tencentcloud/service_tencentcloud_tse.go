@@ -10,7 +10,6 @@ import (
 	tse "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tse/v20201207"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type TseService struct {
@@ -33,8 +32,6 @@ func (me *TseService) DescribeTseInstanceById(ctx context.Context, instanceId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DescribeSREInstances(request)
 	if err != nil {
 		errRet = err
@@ -119,8 +116,6 @@ func (me *TseService) DeleteTseInstanceById(ctx context.Context, instanceId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DeleteEngine(request)
 	if err != nil {
 		errRet = err
@@ -161,7 +156,6 @@ func (me *TseService) DescribeTseAccessAddressByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTseClient().DescribeSREInstanceAccessAddress(request)
 	if err != nil {
 		errRet = err
@@ -195,8 +189,6 @@ func (me *TseService) DescribeTseNacosReplicasByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -243,8 +235,6 @@ func (me *TseService) DescribeTseZookeeperReplicasByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -291,8 +281,6 @@ func (me *TseService) DescribeTseZookeeperServerInterfacesByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -335,8 +323,6 @@ func (me *TseService) DescribeTseNacosServerInterfacesByFilter(ctx context.Conte
 
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -386,8 +372,6 @@ func (me *TseService) DescribeTseGatewayNodesByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -437,8 +421,6 @@ func (me *TseService) DescribeTseGatewayCanaryRulesByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -502,8 +484,6 @@ func (me *TseService) DescribeTseGatewayRoutesByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -561,8 +541,6 @@ func (me *TseService) DescribeTseGatewayServicesByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -612,8 +590,6 @@ func (me *TseService) DescribeTseCngwServiceById(ctx context.Context, gatewayId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DescribeOneCloudNativeAPIGatewayService(request)
 	if err != nil {
 		errRet = err
@@ -642,8 +618,6 @@ func (me *TseService) DeleteTseCngwServiceById(ctx context.Context, gatewayId, n
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayService(request)
 	if err != nil {
 		errRet = err
@@ -667,8 +641,6 @@ func (me *TseService) DescribeTseCngwServiceRateLimitById(ctx context.Context, g
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayServiceRateLimit(request)
 	if err != nil {
 		errRet = err
@@ -699,8 +671,6 @@ func (me *TseService) DeleteTseCngwServiceRateLimitById(ctx context.Context, gat
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayServiceRateLimit(request)
 	if err != nil {
 		errRet = err
@@ -724,8 +694,6 @@ func (me *TseService) DescribeTseCngwRouteById(ctx context.Context, gatewayId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayRoutes(request)
 	if err != nil {
 		errRet = err
@@ -759,8 +727,6 @@ func (me *TseService) DeleteTseCngwRouteById(ctx context.Context, gatewayId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayRoute(request)
 	if err != nil {
 		errRet = err
@@ -784,8 +750,6 @@ func (me *TseService) DescribeTseCngwRouteRateLimitById(ctx context.Context, gat
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayRouteRateLimit(request)
 	if err != nil {
 		errRet = err
@@ -814,8 +778,6 @@ func (me *TseService) DeleteTseCngwRouteRateLimitById(ctx context.Context, gatew
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayRouteRateLimit(request)
 	if err != nil {
 		errRet = err
@@ -844,8 +806,6 @@ func (me *TseService) DescribeTseCngwCanaryRuleById(ctx context.Context, gateway
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DescribeCloudNativeAPIGatewayCanaryRules(request)
 	if err != nil {
 		errRet = err
@@ -886,8 +846,6 @@ func (me *TseService) DeleteTseCngwCanaryRuleById(ctx context.Context, gatewayId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTseClient().DeleteCloudNativeAPIGatewayCanaryRule(request)
 	if err != nil {
 		errRet = err
@@ -9,7 +9,6 @@ import (
 	tsf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tsf/v20180326"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 type TsfService struct {
@@ -28,8 +27,6 @@ func (me *TsfService) DescribeTsfClusterById(ctx context.Context, clusterId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -75,8 +72,6 @@ func (me *TsfService) DeleteTsfClusterById(ctx context.Context, clusterId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteCluster(request)
 	if err != nil {
 		errRet = err
@@ -123,8 +118,6 @@ func (me *TsfService) DescribeTsfApplicationConfigById(ctx context.Context, conf
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeConfigs(request)
 	if err != nil {
 		errRet = err
@@ -156,8 +149,6 @@ func (me *TsfService) DeleteTsfApplicationConfigById(ctx context.Context, config
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteConfig(request)
 	if err != nil {
 		errRet = err
@@ -190,8 +181,6 @@ func (me *TsfService) DescribeTsfMicroserviceById(ctx context.Context, namespace
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeMicroservices(request)
 	if err != nil {
 		errRet = err
@@ -219,8 +208,6 @@ func (me *TsfService) DeleteTsfMicroserviceById(ctx context.Context, microservic
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteMicroservice(request)
 	if err != nil {
 		errRet = err
@@ -243,8 +230,6 @@ func (me *TsfService) DescribeTsfApiGroupById(ctx context.Context, groupId strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeApiGroup(request)
 	if err != nil {
 		errRet = err
@@ -272,8 +257,6 @@ func (me *TsfService) DeleteTsfApiGroupById(ctx context.Context, groupId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteApiGroup(request)
 	if err != nil {
 		errRet = err
@@ -296,8 +279,6 @@ func (me *TsfService) DescribeTsfApiRateLimitRuleById(ctx context.Context, apiId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeApiRateLimitRules(request)
 	if err != nil {
 		errRet = err
@@ -331,8 +312,6 @@ func (me *TsfService) DeleteTsfApiRateLimitRuleById(ctx context.Context, apiId,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteApiRateLimitRule(request)
 	if err != nil {
 		errRet = err
@@ -355,8 +334,6 @@ func (me *TsfService) DescribeTsfConfigTemplateById(ctx context.Context, templat
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeConfigTemplate(request)
 	if err != nil {
 		errRet = err
@@ -384,8 +361,6 @@ func (me *TsfService) DeleteTsfConfigTemplateById(ctx context.Context, templateI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteConfigTemplate(request)
 	if err != nil {
 		errRet = err
@@ -408,8 +383,6 @@ func (me *TsfService) DescribeTsfLaneById(ctx context.Context, laneId string) (l
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeLanes(request)
 	if err != nil {
 		errRet = err
@@ -437,8 +410,6 @@ func (me *TsfService) DeleteTsfLaneById(ctx context.Context, laneId string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteLane(request)
 	if err != nil {
 		errRet = err
@@ -463,8 +434,6 @@ func (me *TsfService) DescribeTsfLaneRuleById(ctx context.Context, ruleId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeLaneRules(request)
 	if err != nil {
 		errRet = err
@@ -492,8 +461,6 @@ func (me *TsfService) DeleteTsfLaneRuleById(ctx context.Context, ruleId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteLaneRule(request)
 	if err != nil {
 		errRet = err
@@ -516,8 +483,6 @@ func (me *TsfService) DescribeTsfNamespaceById(ctx context.Context, namespaceId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeSimpleNamespaces(request)
 	if err != nil {
 		errRet = err
@@ -545,8 +510,6 @@ func (me *TsfService) DeleteTsfNamespaceById(ctx context.Context, namespaceId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteNamespace(request)
 	if err != nil {
 		errRet = err
@@ -568,8 +531,6 @@ func (me *TsfService) DescribeTsfPathRewriteById(ctx context.Context, pathRewrit
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribePathRewrite(request)
 	if err != nil {
 		errRet = err
@@ -597,8 +558,6 @@ func (me *TsfService) DeleteTsfPathRewriteById(ctx context.Context, pathRewriteI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeletePathRewrites(request)
 	if err != nil {
 		errRet = err
@@ -621,8 +580,6 @@ func (me *TsfService) DescribeTsfRepositoryById(ctx context.Context, repositoryI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeRepository(request)
 	if err != nil {
 		errRet = err
@@ -650,8 +607,6 @@ func (me *TsfService) DeleteTsfRepositoryById(ctx context.Context, repositoryId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteRepository(request)
 	if err != nil {
 		errRet = err
@@ -674,8 +629,6 @@ func (me *TsfService) DescribeTsfTaskById(ctx context.Context, taskId string) (t
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeTaskDetail(request)
 	if err != nil {
 		errRet = err
@@ -703,8 +656,6 @@ func (me *TsfService) DeleteTsfTaskById(ctx context.Context, taskId string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteTask(request)
 	if err != nil {
 		errRet = err
@@ -727,8 +678,6 @@ func (me *TsfService) DescribeTsfUnitRuleById(ctx context.Context, id string) (u
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeUnitRule(request)
 	if err != nil {
 		errRet = err
@@ -756,8 +705,6 @@ func (me *TsfService) DeleteTsfUnitRuleById(ctx context.Context, id string) (err
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteUnitRule(request)
 	if err != nil {
 		errRet = err
@@ -779,8 +726,6 @@ func (me *TsfService) DescribeTsfContainGroupById(ctx context.Context, groupId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeContainerGroupDetail(request)
 	if err != nil {
 		errRet = err
@@ -808,8 +753,6 @@ func (me *TsfService) DeleteTsfContainGroupById(ctx context.Context, groupId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteContainerGroup(request)
 	if err != nil {
 		errRet = err
@@ -833,8 +776,6 @@ func (me *TsfService) DescribeTsfApplicationReleaseConfigById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeConfigReleases(request)
 	if err != nil {
 		errRet = err
@@ -862,8 +803,6 @@ func (me *TsfService) DeleteTsfApplicationReleaseConfigById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().RevocationConfig(request)
 	if err != nil {
 		errRet = err
@@ -886,8 +825,6 @@ func (me *TsfService) DescribeTsfGroupById(ctx context.Context, groupId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeGroup(request)
 	if err != nil {
 		errRet = err
@@ -915,8 +852,6 @@ func (me *TsfService) DeleteTsfGroupById(ctx context.Context, groupId string) (e
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteGroup(request)
 	if err != nil {
 		errRet = err
@@ -939,8 +874,6 @@ func (me *TsfService) DescribeTsfApplicationById(ctx context.Context, applicatio
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeApplication(request)
 	if err != nil {
 		errRet = err
@@ -968,8 +901,6 @@ func (me *TsfService) DeleteTsfApplicationById(ctx context.Context, applicationI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteApplication(request)
 	if err != nil {
 		errRet = err
@@ -993,8 +924,6 @@ func (me *TsfService) DescribeTsfApplicationFileConfigReleaseById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeFileConfigReleases(request)
 	if err != nil {
 		errRet = err
@@ -1029,8 +958,6 @@ func (me *TsfService) DeleteTsfApplicationFileConfigReleaseById(ctx context.Cont
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().RevokeFileConfig(request)
 	if err != nil {
 		errRet = err
@@ -1053,8 +980,6 @@ func (me *TsfService) DescribeTsfApplicationPublicConfigById(ctx context.Context
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribePublicConfig(request)
 	if err != nil {
 		errRet = err
@@ -1082,8 +1007,6 @@ func (me *TsfService) DeleteTsfApplicationPublicConfigById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeletePublicConfig(request)
 	if err != nil {
 		errRet = err
@@ -1107,8 +1030,6 @@ func (me *TsfService) DescribeTsfApplicationPublicConfigReleaseById(ctx context.
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribePublicConfigReleases(request)
 	if err != nil {
 		errRet = err
@@ -1143,8 +1064,6 @@ func (me *TsfService) DeleteTsfApplicationPublicConfigReleaseById(ctx context.Co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().RevocationPublicConfig(request)
 	if err != nil {
 		errRet = err
@@ -1167,8 +1086,6 @@ func (me *TsfService) DescribeTsfInstancesAttachmentById(ctx context.Context, cl
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1215,8 +1132,6 @@ func (me *TsfService) DeleteTsfInstancesAttachmentById(ctx context.Context, clus
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().RemoveInstances(request)
 	if err != nil {
 		errRet = err
@@ -1254,8 +1169,6 @@ func (me *TsfService) DescribeTsfApplicationByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset  int64 = 0
 		limit   int64 = 20
@@ -1321,8 +1234,6 @@ func (me *TsfService) DescribeTsfApplicationConfigByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1390,8 +1301,6 @@ func (me *TsfService) DescribeTsfApplicationFileConfigByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1455,8 +1364,6 @@ func (me *TsfService) DescribeTsfApplicationPublicConfigByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1520,8 +1427,6 @@ func (me *TsfService) DescribeTsfClusterByFilter(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset   int64 = 0
 		limit    int64 = 20
@@ -1585,8 +1490,6 @@ func (me *TsfService) DescribeTsfMicroserviceByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1644,8 +1547,6 @@ func (me *TsfService) DescribeTsfUnitRulesByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1718,8 +1619,6 @@ func (me *TsfService) DescribeTsfConfigSummaryByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -1774,7 +1673,6 @@ func (me *TsfService) DescribeTsfDeliveryConfigByGroupIdByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTsfClient().DescribeDeliveryConfigByGroupId(request)
 	if err != nil {
 		errRet = err
@@ -1809,8 +1707,6 @@ func (me *TsfService) DescribeTsfDeliveryConfigsByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset    int64 = 0
 		limit     int64 = 20
@@ -1881,8 +1777,6 @@ func (me *TsfService) DescribeTsfPublicConfigSummaryByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset        int64 = 0
 		limit         int64 = 20
@@ -1955,8 +1849,6 @@ func (me *TsfService) DescribeTsfApiGroupByFilter(ctx context.Context, param map
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -2011,7 +1903,6 @@ func (me *TsfService) DescribeTsfApplicationAttributeByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTsfClient().DescribeApplicationAttribute(request)
 	if err != nil {
 		errRet = err
@@ -2052,8 +1943,6 @@ func (me *TsfService) DescribeTsfBusinessLogConfigsByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -2120,7 +2009,6 @@ func (me *TsfService) DescribeTsfApiDetailByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTsfClient().DescribeApiDetail(request)
 	if err != nil {
 		errRet = err
@@ -2161,7 +2049,6 @@ func (me *TsfService) DescribeTsfMicroserviceApiVersionByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTsfClient().DescribeApiVersions(request)
 	if err != nil {
 		errRet = err
@@ -2190,8 +2077,6 @@ func (me *TsfService) DescribeTsfBindApiGroupById(ctx context.Context, groupId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -2244,8 +2129,6 @@ func (me *TsfService) DeleteTsfBindApiGroupById(ctx context.Context, groupId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().UnbindApiGroup(request)
 	if err != nil {
 		errRet = err
@@ -2277,8 +2160,6 @@ func (me *TsfService) DescribeTsfRepositoryByFilter(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset     uint64 = 0
 		limit      uint64 = 20
@@ -2327,8 +2208,6 @@ func (me *TsfService) DescribeTsfApplicationFileConfigById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeFileConfigs(request)
 	if err != nil {
 		errRet = err
@@ -2356,8 +2235,6 @@ func (me *TsfService) DeleteTsfApplicationFileConfigById(ctx context.Context, co
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteFileConfig(request)
 	if err != nil {
 		errRet = err
@@ -2380,8 +2257,6 @@ func (me *TsfService) DescribeTsfEnableUnitRuleById(ctx context.Context, id stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeUnitRule(request)
 	if err != nil {
 		errRet = err
@@ -2418,8 +2293,6 @@ func (me *TsfService) DescribeTsfDescribePodInstancesByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset    int64 = 0
 		limit     int64 = 20
@@ -2475,7 +2348,6 @@ func (me *TsfService) DescribeTsfGatewayAllGroupApisByFilter(ctx context.Context
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTsfClient().DescribeGatewayAllGroupApis(request)
 	if err != nil {
 		errRet = err
@@ -2513,8 +2385,6 @@ func (me *TsfService) DescribeTsfGroupGatewaysByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset   int64 = 0
 		limit    int64 = 20
@@ -2576,8 +2446,6 @@ func (me *TsfService) DescribeTsfGroupInstancesByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset    int64 = 0
 		limit     int64 = 20
@@ -2630,8 +2498,6 @@ func (me *TsfService) DescribeTsfUsableUnitNamespacesByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset     int64 = 0
 		limit      int64 = 20
@@ -2684,7 +2550,6 @@ func (me *TsfService) DescribeTsfGroupConfigReleaseByFilter(ctx context.Context,
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseTsfClient().DescribeGroupRelease(request)
 	if err != nil {
 		errRet = err
@@ -2712,8 +2577,6 @@ func (me *TsfService) DescribeTsfDeployVmGroupById(ctx context.Context, groupId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeGroup(request)
 	if err != nil {
 		errRet = err
@@ -2741,8 +2604,6 @@ func (me *TsfService) DescribeTsfReleaseApiGroupById(ctx context.Context, groupI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeApiGroup(request)
 	if err != nil {
 		errRet = err
@@ -2771,8 +2632,6 @@ func (me *TsfService) DescribeTsfStartContainerGroupById(ctx context.Context, gr
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeContainerGroupAttribute(request)
 	if err != nil {
 		errRet = err
@@ -2801,8 +2660,6 @@ func (me *TsfService) DescribeTsfStartGroupById(ctx context.Context, groupId str
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeGroupAttribute(request)
 	if err != nil {
 		errRet = err
@@ -2830,8 +2687,6 @@ func (me *TsfService) DescribeTsfUnitNamespaceById(ctx context.Context, gatewayI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeUnitNamespaces(request)
 	if err != nil {
 		errRet = err
@@ -2866,8 +2721,6 @@ func (me *TsfService) DeleteTsfUnitNamespaceById(ctx context.Context, gatewayIns
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DeleteUnitNamespaces(request)
 	if err != nil {
 		errRet = err
@@ -2890,8 +2743,6 @@ func (me *TsfService) DescribeTsfDeployContainerGroupById(ctx context.Context, g
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseTsfClient().DescribeContainerGroupDeployInfo(request)
 	if err != nil {
 		errRet = err
@@ -2940,8 +2791,6 @@ func (me *TsfService) DescribeTsfDescriptionContainerGroupByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -3018,8 +2867,6 @@ func (me *TsfService) DescribeTsfGroupsByFilter(ctx context.Context, param map[s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -3075,8 +2922,6 @@ func (me *TsfService) DescribeTsfMsApiListByFilter(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -20,7 +20,6 @@ import (
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
 var eipUnattachLocker = &sync.Mutex{}
@@ -240,7 +239,6 @@ func (me *VpcService) CreateVpc(ctx context.Context, name, cidr string,
 
 	var response *vpc.CreateVpcResponse
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, err := me.client.UseVpcClient().CreateVpc(request)
 		if err != nil {
 			return retryError(err)
@@ -339,7 +337,6 @@ getMoreData:
 	request.Offset = &strOffset
 	var response *vpc.DescribeVpcsResponse
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, err := me.client.UseVpcClient().DescribeVpcs(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -496,7 +493,6 @@ getMoreData:
 	request.Offset = &strOffset
 	var response *vpc.DescribeSubnetsResponse
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, err := me.client.UseVpcClient().DescribeSubnets(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -569,7 +565,6 @@ func (me *VpcService) ModifyVpcAttribute(ctx context.Context, vpcId, name string
 	request.EnableMulticast = &enableMulticast
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseVpcClient().ModifyVpcAttribute(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -600,7 +595,6 @@ func (me *VpcService) DeleteVpc(ctx context.Context, vpcId string) (errRet error
 	request.VpcId = &vpcId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseVpcClient().DeleteVpc(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -645,7 +639,6 @@ func (me *VpcService) CreateSubnet(ctx context.Context, vpcId, name, cidr, zone
 
 	var response *vpc.CreateSubnetResponse
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		result, err := me.client.UseVpcClient().CreateSubnet(request)
 		if err != nil {
 			return retryError(err)
@@ -678,7 +671,6 @@ func (me *VpcService) ModifySubnetAttribute(ctx context.Context, subnetId, name
 	request.SubnetName = &name
 	request.EnableBroadcast = &enableMulticast
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseVpcClient().ModifySubnetAttribute(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -703,7 +695,6 @@ func (me *VpcService) DeleteSubnet(ctx context.Context, subnetId string) (errRet
 	}()
 	request.SubnetId = &subnetId
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseVpcClient().DeleteSubnet(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -728,7 +719,6 @@ func (me *VpcService) ReplaceRouteTableAssociation(ctx context.Context, subnetId
 	}()
 	request.SubnetId = &subnetId
 	request.RouteTableId = &routeTableId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ReplaceRouteTableAssociation(request)
 
 	errRet = err
@@ -827,7 +817,6 @@ getMoreData:
 
 	var strOffset = fmt.Sprintf("%d", offset)
 	request.Offset = &strOffset
-	ratelimit.Check(request.GetAction())
 
 	response, err := me.client.UseVpcClient().DescribeRouteTables(request)
 	if err != nil {
@@ -910,7 +899,6 @@ func (me *VpcService) CreateRouteTable(ctx context.Context, name, vpcId string,
 			request.Tags = append(request.Tags, &tag)
 		}
 	}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateRouteTable(request)
 	errRet = err
 	if err == nil {
@@ -938,7 +926,6 @@ func (me *VpcService) DeleteRouteTable(ctx context.Context, routeTableId string)
 		return
 	}
 	request.RouteTableId = &routeTableId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteRouteTable(request)
 	errRet = err
 	if err == nil {
@@ -966,7 +953,6 @@ func (me *VpcService) ModifyRouteTableAttribute(ctx context.Context, routeTableI
 	}
 	request.RouteTableId = &routeTableId
 	request.RouteTableName = &name
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyRouteTableAttribute(request)
 	errRet = err
 	if err == nil {
@@ -1042,7 +1028,6 @@ func (me *VpcService) DeleteRoutes(ctx context.Context, routeTableId string, ent
 	var route vpc.Route
 	route.RouteId = &entryId
 	request.Routes = []*vpc.Route{&route}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteRoutes(request)
 	errRet = err
 	if err == nil {
@@ -1076,7 +1061,6 @@ func (me *VpcService) CreateRoutes(ctx context.Context,
 	route.GatewayId = &nextHub
 	route.Enabled = &enabled
 	request.Routes = []*vpc.Route{&route}
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateRoutes(request)
 	errRet = err
 	if err == nil {
@@ -1143,7 +1127,6 @@ func (me *VpcService) EnableRoutes(ctx context.Context, request *vpc.EnableRoute
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().EnableRoutes(request)
 
 	if err != nil {
@@ -1165,7 +1148,6 @@ func (me *VpcService) DisableRoutes(ctx context.Context, request *vpc.DisableRou
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DisableRoutes(request)
 
 	if err != nil {
@@ -1201,8 +1183,6 @@ func (me *VpcService) CreateSecurityGroup(ctx context.Context, name, desc string
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseVpcClient().CreateSecurityGroup(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -1233,8 +1213,6 @@ func (me *VpcService) DescribeSecurityGroup(ctx context.Context, id string) (sg
 	request.SecurityGroupIds = []*string{&id}
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseVpcClient().DescribeSecurityGroups(request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1273,7 +1251,6 @@ func (me *VpcService) ModifySecurityGroup(ctx context.Context, id string, newNam
 	request.GroupDescription = newDesc
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err := me.client.UseVpcClient().ModifySecurityGroupAttribute(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -1297,8 +1274,6 @@ func (me *VpcService) DeleteSecurityGroup(ctx context.Context, id string) error
 	request.SecurityGroupId = &id
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseVpcClient().DeleteSecurityGroup(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1319,7 +1294,6 @@ func (me *VpcService) DescribeSecurityGroupsAssociate(ctx context.Context, ids [
 
 	request := vpc.NewDescribeSecurityGroupAssociationStatisticsRequest()
 	request.SecurityGroupIds = common.StringPtrs(ids)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeSecurityGroupAssociationStatistics(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -1374,7 +1348,6 @@ func (me *VpcService) CreateSecurityGroupPolicy(ctx context.Context, info securi
 	case "egress":
 		createRequest.SecurityGroupPolicySet.Egress = []*vpc.SecurityGroupPolicy{policy}
 	}
-	ratelimit.Check(createRequest.GetAction())
 	if _, err := me.client.UseVpcClient().CreateSecurityGroupPolicies(createRequest); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, createRequest.GetAction(), createRequest.ToJsonString(), err)
@@ -1411,7 +1384,6 @@ func (me *VpcService) CreateSecurityGroupPolicies(ctx context.Context, request *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateSecurityGroupPolicies(request)
 
 	if err != nil {
@@ -1437,7 +1409,6 @@ func (me *VpcService) DescribeSecurityGroupPolicy(ctx context.Context, ruleId st
 
 	request := vpc.NewDescribeSecurityGroupPoliciesRequest()
 	request.SecurityGroupId = &info.SgId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeSecurityGroupPolicies(request)
 	if err != nil {
 		if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1496,7 +1467,6 @@ func (me *VpcService) DescribeSecurityGroupPolicies(ctx context.Context, sgId st
 	}()
 	request.SecurityGroupId = &sgId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeSecurityGroupPolicies(request)
 
 	if err != nil {
@@ -1574,7 +1544,6 @@ func (me *VpcService) DeleteSecurityGroupPolicy(ctx context.Context, ruleId stri
 	case "egress":
 		request.SecurityGroupPolicySet.Egress = []*vpc.SecurityGroupPolicy{policy}
 	}
-	ratelimit.Check(request.GetAction())
 	if _, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, request.GetAction(), request.ToJsonString(), err)
@@ -1593,7 +1562,6 @@ func (me *VpcService) DeleteSecurityGroupPolicies(ctx context.Context, request *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request)
 
 	if err != nil {
@@ -1623,7 +1591,6 @@ func (me *VpcService) DeleteSecurityGroupPolicyByPolicyIndex(ctx context.Context
 	case "egress":
 		request.SecurityGroupPolicySet.Egress = []*vpc.SecurityGroupPolicy{policy}
 	}
-	ratelimit.Check(request.GetAction())
 	if _, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, request.GetAction(), request.ToJsonString(), err)
@@ -1662,7 +1629,6 @@ func (me *VpcService) ModifySecurityGroupPolicy(ctx context.Context, ruleId stri
 	case "egress":
 		request.SecurityGroupPolicySet.Egress = []*vpc.SecurityGroupPolicy{policy}
 	}
-	ratelimit.Check(request.GetAction())
 	if _, err := me.client.UseVpcClient().ReplaceSecurityGroupPolicy(request); err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 			logId, request.GetAction(), request.ToJsonString(), err)
@@ -1681,7 +1647,6 @@ func (me *VpcService) ModifySecurityGroupPolicies(ctx context.Context, request *
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifySecurityGroupPolicies(request)
 
 	if err != nil {
@@ -1734,8 +1699,6 @@ func (me *VpcService) DescribeSecurityGroups(ctx context.Context, sgId, sgName *
 		request.Offset = helper.String(strconv.Itoa(offset))
 
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := me.client.UseVpcClient().DescribeSecurityGroups(request)
 			if err != nil {
 				count = 0
@@ -1777,8 +1740,6 @@ func (me *VpcService) modifyLiteRulesInSecurityGroup(ctx context.Context, sgId s
 	request.SecurityGroupPolicySet.Ingress = getSecurityGroupPolicies(ingress)
 
 	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseVpcClient().ModifySecurityGroupPolicies(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1803,8 +1764,6 @@ func (me *VpcService) DeleteLiteRules(ctx context.Context, sgId string, rules []
 	}
 
 	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseVpcClient().DeleteSecurityGroupPolicies(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -1835,8 +1794,6 @@ func (me *VpcService) DescribeSecurityGroupPolices(ctx context.Context, sgId str
 	request.SecurityGroupId = &sgId
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := me.client.UseVpcClient().DescribeSecurityGroupPolicies(request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -1947,8 +1904,6 @@ func (me *VpcService) DetachAllLiteRulesFromSecurityGroup(ctx context.Context, s
 	}
 
 	return resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := me.client.UseVpcClient().ModifySecurityGroupPolicies(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -2239,7 +2194,6 @@ func (me *VpcService) DescribeEipById(ctx context.Context, eipId string) (eip *v
 	request := vpc.NewDescribeAddressesRequest()
 	request.AddressIds = []*string{&eipId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeAddresses(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2272,7 +2226,6 @@ func (me *VpcService) DescribeEipByFilter(ctx context.Context, filters map[strin
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeAddresses(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2293,7 +2246,6 @@ func (me *VpcService) ModifyEipName(ctx context.Context, eipId, eipName string)
 	request.AddressId = &eipId
 	request.AddressName = &eipName
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyAddressAttribute(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2312,7 +2264,6 @@ func (me *VpcService) ModifyEipBandwidthOut(ctx context.Context, eipId string, b
 	request.AddressIds = []*string{&eipId}
 	request.InternetMaxBandwidthOut = helper.IntInt64(bandwidthOut)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyAddressesBandwidth(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2339,7 +2290,6 @@ func (me *VpcService) ModifyEipInternetChargeType(ctx context.Context, eipId str
 		request.AddressChargePrepaid = &addressChargePrepaid
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyAddressInternetChargeType(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2360,7 +2310,6 @@ func (me *VpcService) RenewAddress(ctx context.Context, eipId string, period int
 	addressChargePrepaid.AutoRenewFlag = helper.IntInt64(renewFlag)
 	addressChargePrepaid.Period = helper.IntInt64(period)
 	request.AddressChargePrepaid = &addressChargePrepaid
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().RenewAddresses(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2378,7 +2327,6 @@ func (me *VpcService) DeleteEip(ctx context.Context, eipId string) error {
 	request := vpc.NewReleaseAddressesRequest()
 	request.AddressIds = []*string{&eipId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ReleaseAddresses(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2397,7 +2345,6 @@ func (me *VpcService) AttachEip(ctx context.Context, eipId, instanceId string) e
 	request.AddressId = &eipId
 	request.InstanceId = &instanceId
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().AssociateAddress(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2421,7 +2368,6 @@ func (me *VpcService) DescribeNatGatewayById(ctx context.Context, natGateWayId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeNatGateways(request)
 
 	if err != nil {
@@ -2460,7 +2406,6 @@ func (me *VpcService) DescribeNatGatewayByFilter(ctx context.Context, filters ma
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeNatGateways(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2494,7 +2439,6 @@ func (me *VpcService) DeleteNatGateway(ctx context.Context, natGatewayId string)
 	request.NatGatewayId = &natGatewayId
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteNatGateway(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -2546,7 +2490,6 @@ func (me *VpcService) DisassociateNatGatewayAddress(ctx context.Context, request
 
 	request.PublicIpAddresses = candidates
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DisassociateNatGatewayAddress(request)
 
 	if err != nil {
@@ -2601,7 +2544,6 @@ func (me *VpcService) UnattachEip(ctx context.Context, eipId string) error {
 
 	request := vpc.NewDisassociateAddressRequest()
 	request.AddressId = &eipId
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DisassociateAddress(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -2619,7 +2561,6 @@ func (me *VpcService) UnattachEip(ctx context.Context, eipId string) error {
 	taskRequest := vpc.NewDescribeTaskResultRequest()
 	taskRequest.TaskId = &taskId
 	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(taskRequest.GetAction())
 		taskResponse, err := me.client.UseVpcClient().DescribeTaskResult(taskRequest)
 		if err != nil {
 			return retryError(err)
@@ -2684,8 +2625,6 @@ func (me *VpcService) CreateEni(
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(createRequest.GetAction())
-
 		response, err := client.CreateNetworkInterface(createRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -2851,8 +2790,6 @@ func (me *VpcService) describeEnis(
 	count := ENI_DESCRIBE_LIMIT
 	for count == ENI_DESCRIBE_LIMIT {
 		if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
-
 			response, err := me.client.UseVpcClient().DescribeNetworkInterfaces(request)
 			if err != nil {
 				count = 0
@@ -2899,8 +2836,6 @@ func (me *VpcService) ModifyEniAttribute(ctx context.Context, id string, name, d
 	request.SecurityGroupIds = common.StringPtrs(sgs)
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.ModifyNetworkInterfaceAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -2935,8 +2870,6 @@ func (me *VpcService) UnAssignIpv4FromEni(ctx context.Context, id string, ipv4s
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.UnassignPrivateIpAddresses(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -2985,8 +2918,6 @@ func (me *VpcService) AssignIpv4ToEni(ctx context.Context, id string, ipv4s []Vp
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.AssignPrivateIpAddresses(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -3060,8 +2991,6 @@ func (me *VpcService) DeleteEni(ctx context.Context, id string) error {
 	deleteRequest.NetworkInterfaceId = &id
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(deleteRequest.GetAction())
-
 		if _, err := client.DeleteNetworkInterface(deleteRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, deleteRequest.GetAction(), deleteRequest.ToJsonString(), err)
@@ -3078,8 +3007,6 @@ func (me *VpcService) DeleteEni(ctx context.Context, id string) error {
 	describeRequest.NetworkInterfaceIds = []*string{&id}
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeNetworkInterfaces(describeRequest)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -3125,8 +3052,6 @@ func (me *VpcService) AttachEniToCvm(ctx context.Context, eniId, cvmId string) e
 	attachRequest.InstanceId = &cvmId
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(attachRequest.GetAction())
-
 		if _, err := client.AttachNetworkInterface(attachRequest); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, attachRequest.GetAction(), attachRequest.ToJsonString(), err)
@@ -3143,8 +3068,6 @@ func (me *VpcService) AttachEniToCvm(ctx context.Context, eniId, cvmId string) e
 	describeRequest.NetworkInterfaceIds = []*string{&eniId}
 
 	if err := resource.Retry(2*readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(describeRequest.GetAction())
-
 		response, err := client.DescribeNetworkInterfaces(describeRequest)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -3220,8 +3143,6 @@ func (me *VpcService) DetachEniFromCvm(ctx context.Context, eniId, cvmId string)
 	request.InstanceId = &cvmId
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.DetachNetworkInterface(request); err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 				switch sdkError.Code {
@@ -3267,8 +3188,6 @@ func (me *VpcService) ModifyEniPrimaryIpv4Desc(ctx context.Context, id, ip strin
 	}
 
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if _, err := client.ModifyPrivateIpAddressesAttribute(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -3317,7 +3236,6 @@ func (me *VpcService) DescribeHaVipByFilter(ctx context.Context, filters map[str
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeHaVips(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -3357,8 +3275,6 @@ func (me *VpcService) DescribeHaVipEipById(ctx context.Context, haVipEipAttachme
 	eip = ""
 	haVip = ""
 	if err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		if result, err := client.DescribeHaVips(request); err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
@@ -3400,7 +3316,6 @@ func (me *VpcService) DeleteHaVip(ctx context.Context, haVipId string) (errRet e
 	request.HaVipId = &haVipId
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteHaVip(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -3427,8 +3342,6 @@ func waitEniReady(ctx context.Context, id string, client *vpc.Client, wantIpv4s
 	request.NetworkInterfaceIds = []*string{helper.String(id)}
 
 	if err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.DescribeNetworkInterfaces(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
@@ -3535,8 +3448,6 @@ func waitEniDetach(ctx context.Context, id string, client *vpc.Client) error {
 	request.NetworkInterfaceIds = []*string{helper.String(id)}
 
 	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
 		response, err := client.DescribeNetworkInterfaces(request)
 		if err != nil {
 			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok && sdkError.Code == "ResourceNotFound" {
@@ -3647,7 +3558,6 @@ func (me *VpcService) CreateVpcNetworkAcl(ctx context.Context, vpcID string, nam
 	}
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseVpcClient().CreateNetworkAcl(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -3722,7 +3632,6 @@ func (me *VpcService) ModifyNetWorkAclRules(ctx context.Context, aclID string, i
 	}
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err = me.client.UseVpcClient().ModifyNetworkAclEntries(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -3782,7 +3691,6 @@ func (me *VpcService) DeleteAcl(ctx context.Context, aclID string) (errRet error
 			}
 
 			err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-				ratelimit.Check(request.GetAction())
 				_, err = me.client.UseVpcClient().DisassociateNetworkAclSubnets(requestSubnet)
 				if err != nil {
 					if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -3806,7 +3714,6 @@ func (me *VpcService) DeleteAcl(ctx context.Context, aclID string) (errRet error
 	// delete acl
 	request.NetworkAclId = &aclID
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err = me.client.UseVpcClient().DeleteNetworkAcl(request)
 
 		if err != nil {
@@ -3845,7 +3752,6 @@ func (me *VpcService) ModifyVpcNetworkAcl(ctx context.Context, id *string, name
 	request.NetworkAclName = name
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err = me.client.UseVpcClient().ModifyNetworkAclAttribute(request)
 		if err != nil {
 			ee, ok := err.(*sdkErrors.TencentCloudSDKError)
@@ -3888,7 +3794,6 @@ func (me *VpcService) AssociateAclSubnets(ctx context.Context, aclId string, sub
 	request.SubnetIds = subIds
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err = me.client.UseVpcClient().AssociateNetworkAclSubnets(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -3932,7 +3837,6 @@ func (me *VpcService) DescribeNetWorkAcls(ctx context.Context, aclID, vpcID, nam
 	request.Limit = &pageSize
 	for {
 		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseVpcClient().DescribeNetworkAcls(request)
 			if err != nil {
 				if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
@@ -4016,7 +3920,6 @@ func (me *VpcService) DeleteAclAttachment(ctx context.Context, attachmentAcl str
 	request.SubnetIds = helper.Strings(items[1:])
 
 	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, err = me.client.UseVpcClient().DisassociateNetworkAclSubnets(request)
 		if err != nil {
 			return retryError(err, InternalError)
@@ -4090,7 +3993,6 @@ func (me *VpcService) DescribeVpnGwByFilter(ctx context.Context, filters map[str
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeVpnGateways(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -4124,7 +4026,6 @@ func (me *VpcService) DeleteVpnGateway(ctx context.Context, vpnGatewayId string)
 	request.VpnGatewayId = &vpnGatewayId
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteVpnGateway(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -4155,7 +4056,6 @@ func (me *VpcService) DescribeCustomerGatewayByFilter(ctx context.Context, filte
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeCustomerGateways(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -4189,7 +4089,6 @@ func (me *VpcService) DeleteCustomerGateway(ctx context.Context, customerGateway
 	request.CustomerGatewayId = &customerGatewayId
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteCustomerGateway(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -4215,7 +4114,6 @@ func (me *VpcService) CreateAddressTemplate(ctx context.Context, name string, ad
 		request.Addresses[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateAddressTemplate(request)
 	if err != nil {
 		errRet = err
@@ -4266,7 +4164,6 @@ func (me *VpcService) DescribeAddressTemplates(ctx context.Context, filter []*vp
 		request.Offset = helper.String(strconv.Itoa(offset))
 		request.Limit = helper.String(strconv.Itoa(limit))
 
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeAddressTemplates(request)
 		if err != nil {
 			errRet = err
@@ -4300,7 +4197,6 @@ func (me *VpcService) ModifyAddressTemplate(ctx context.Context, templateId stri
 		request.Addresses[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().ModifyAddressTemplateAttribute(request)
 	return err
 }
@@ -4315,7 +4211,6 @@ func (me *VpcService) DeleteAddressTemplate(ctx context.Context, templateId stri
 	}()
 	request.AddressTemplateId = &templateId
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().DeleteAddressTemplate(request)
 	return err
 }
@@ -4336,7 +4231,6 @@ func (me *VpcService) CreateAddressTemplateGroup(ctx context.Context, name strin
 		request.AddressTemplateIds[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateAddressTemplateGroup(request)
 	if err != nil {
 		errRet = err
@@ -4368,7 +4262,6 @@ func (me *VpcService) ModifyAddressTemplateGroup(ctx context.Context, templateGr
 		request.AddressTemplateIds[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().ModifyAddressTemplateGroupAttribute(request)
 	return err
 }
@@ -4409,7 +4302,6 @@ func (me *VpcService) DescribeAddressTemplateGroups(ctx context.Context, filter
 		request.Offset = helper.String(strconv.Itoa(offset))
 		request.Limit = helper.String(strconv.Itoa(limit))
 
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeAddressTemplateGroups(request)
 		if err != nil {
 			errRet = err
@@ -4436,7 +4328,6 @@ func (me *VpcService) DeleteAddressTemplateGroup(ctx context.Context, templateGr
 	}()
 	request.AddressTemplateGroupId = &templateGroupId
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().DeleteAddressTemplateGroup(request)
 	return err
 }
@@ -4457,7 +4348,6 @@ func (me *VpcService) CreateServiceTemplate(ctx context.Context, name string, se
 		request.Services[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateServiceTemplate(request)
 	if err != nil {
 		errRet = err
@@ -4489,7 +4379,6 @@ func (me *VpcService) ModifyServiceTemplate(ctx context.Context, templateId stri
 		request.Services[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().ModifyServiceTemplateAttribute(request)
 	return err
 }
@@ -4530,7 +4419,6 @@ func (me *VpcService) DescribeServiceTemplates(ctx context.Context, filter []*vp
 		request.Offset = helper.String(strconv.Itoa(offset))
 		request.Limit = helper.String(strconv.Itoa(limit))
 
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeServiceTemplates(request)
 		if err != nil {
 			errRet = err
@@ -4557,7 +4445,6 @@ func (me *VpcService) DeleteServiceTemplate(ctx context.Context, templateId stri
 	}()
 	request.ServiceTemplateId = &templateId
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().DeleteServiceTemplate(request)
 	return err
 }
@@ -4578,7 +4465,6 @@ func (me *VpcService) CreateServiceTemplateGroup(ctx context.Context, name strin
 		request.ServiceTemplateIds[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateServiceTemplateGroup(request)
 	if err != nil {
 		errRet = err
@@ -4629,7 +4515,6 @@ func (me *VpcService) DescribeServiceTemplateGroups(ctx context.Context, filter
 		request.Offset = helper.String(strconv.Itoa(offset))
 		request.Limit = helper.String(strconv.Itoa(limit))
 
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeServiceTemplateGroups(request)
 		if err != nil {
 			errRet = err
@@ -4663,7 +4548,6 @@ func (me *VpcService) ModifyServiceTemplateGroup(ctx context.Context, serviceGro
 		request.ServiceTemplateIds[i] = helper.String(v.(string))
 	}
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().ModifyServiceTemplateGroupAttribute(request)
 	return err
 }
@@ -4678,7 +4562,6 @@ func (me *VpcService) DeleteServiceTemplateGroup(ctx context.Context, templateGr
 	}()
 	request.ServiceTemplateGroupId = &templateGroupId
 
-	ratelimit.Check(request.GetAction())
 	_, err := me.client.UseVpcClient().DeleteServiceTemplateGroup(request)
 	return err
 }
@@ -4696,7 +4579,6 @@ func (me *VpcService) CreateVpnGatewayRoute(ctx context.Context, vpnGatewayId st
 
 	var response *vpc.CreateVpnGatewayRoutesResponse
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet = me.client.UseVpcClient().CreateVpnGatewayRoutes(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s create vpn gateway route failed, reason: %v", logId, errRet)
@@ -4732,7 +4614,6 @@ func (me *VpcService) ModifyVpnGatewayRoute(ctx context.Context, vpnGatewayId, r
 
 	var response *vpc.ModifyVpnGatewayRoutesResponse
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet = me.client.UseVpcClient().ModifyVpnGatewayRoutes(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -4763,7 +4644,6 @@ func (me *VpcService) DeleteVpnGatewayRoutes(ctx context.Context, vpnGatewayId s
 	request.RouteIds = routeIds
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteVpnGatewayRoutes(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -4793,7 +4673,6 @@ func (me *VpcService) DescribeVpnGatewayRoutes(ctx context.Context, vpnGatewayId
 		request.Limit = &limit
 		var response *vpc.DescribeVpnGatewayRoutesResponse
 		errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, errRet = me.client.UseVpcClient().DescribeVpnGatewayRoutes(request)
 			if errRet != nil {
 				return retryError(errRet, InternalError)
@@ -4826,7 +4705,6 @@ func (me *VpcService) DescribeVpcTaskResult(ctx context.Context, taskId *string)
 	}()
 	request.TaskId = taskId
 	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeVpcTaskResult(request)
 		if err != nil {
 			return retryError(err)
@@ -4853,7 +4731,6 @@ func (me *VpcService) DescribeTaskResult(ctx context.Context, taskId *uint64) (r
 	}()
 	request.TaskId = taskId
 	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeTaskResult(request)
 		if err != nil {
 			return retryError(err)
@@ -4924,7 +4801,6 @@ func (me *VpcService) DescribeVpnGwSslServerByFilter(ctx context.Context, filter
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeVpnGatewaySslServers(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -4958,7 +4834,6 @@ func (me *VpcService) DeleteVpnGatewaySslServer(ctx context.Context, SslServerId
 	request.SslVpnServerId = &SslServerId
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet := me.client.UseVpcClient().DeleteVpnGatewaySslServer(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -5026,7 +4901,6 @@ func (me *VpcService) DescribeVpnGwSslClientByFilter(ctx context.Context, filter
 	for {
 		request.Offset = &offset
 		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeVpnGatewaySslClients(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -5060,7 +4934,6 @@ func (me *VpcService) DeleteVpnGatewaySslClient(ctx context.Context, SslClientId
 	request.SslVpnClientId = &SslClientId
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet := me.client.UseVpcClient().DeleteVpnGatewaySslClient(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -5084,7 +4957,6 @@ func (me *VpcService) CreateNatGatewaySnat(ctx context.Context, natGatewayId str
 
 	var response *vpc.CreateNatGatewaySourceIpTranslationNatRuleResponse
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet = me.client.UseVpcClient().CreateNatGatewaySourceIpTranslationNatRule(request)
 		if errRet != nil {
 			log.Printf("[CRITAL]%s create nat gateway source ip translation nat rule failed, reason: %v", logId, errRet)
@@ -5115,7 +4987,6 @@ func (me *VpcService) ModifyNatGatewaySnat(ctx context.Context, natGatewayId str
 
 	var response *vpc.ModifyNatGatewaySourceIpTranslationNatRuleResponse
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		response, errRet = me.client.UseVpcClient().ModifyNatGatewaySourceIpTranslationNatRule(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -5144,7 +5015,6 @@ func (me *VpcService) DeleteNatGatewaySnat(ctx context.Context, natGatewayId str
 	request.NatGatewaySnatIds = []*string{&snatId}
 
 	errRet = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
 		_, errRet = me.client.UseVpcClient().DeleteNatGatewaySourceIpTranslationNatRule(request)
 		if errRet != nil {
 			return retryError(errRet, InternalError)
@@ -5174,7 +5044,6 @@ func (me *VpcService) DescribeNatGatewaySnats(ctx context.Context, natGatewayId
 		request.Limit = &limit
 		var response *vpc.DescribeNatGatewaySourceIpTranslationNatRulesResponse
 		errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			ratelimit.Check(request.GetAction())
 			response, errRet = me.client.UseVpcClient().DescribeNatGatewaySourceIpTranslationNatRules(request)
 			if errRet != nil {
 				return retryError(errRet, InternalError)
@@ -5209,7 +5078,6 @@ func (me *VpcService) DescribeAssistantCidr(ctx context.Context, vpcId string) (
 
 	request.VpcIds = []*string{&vpcId}
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeAssistantCidr(request)
 
 	if err != nil {
@@ -5235,7 +5103,6 @@ func (me *VpcService) CheckAssistantCidr(ctx context.Context, request *vpc.Check
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CheckAssistantCidr(request)
 
 	if err != nil {
@@ -5260,7 +5127,6 @@ func (me *VpcService) CreateAssistantCidr(ctx context.Context, request *vpc.Crea
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().CreateAssistantCidr(request)
 
 	if err != nil {
@@ -5285,7 +5151,6 @@ func (me *VpcService) ModifyAssistantCidr(ctx context.Context, request *vpc.Modi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().ModifyAssistantCidr(request)
 
 	if err != nil {
@@ -5308,7 +5173,6 @@ func (me *VpcService) DeleteAssistantCidr(ctx context.Context, request *vpc.Dele
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteAssistantCidr(request)
 
 	if err != nil {
@@ -5343,7 +5207,6 @@ func (me *VpcService) DescribeVpcBandwidthPackage(ctx context.Context, bandwidth
 	//		Values: []*string{&bandwidthPackageId},
 	//	},
 	//)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeBandwidthPackages(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -5375,7 +5238,6 @@ func (me *VpcService) DeleteVpcBandwidthPackageById(ctx context.Context, bandwid
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DeleteBandwidthPackage(request)
 	if err != nil {
 		errRet = err
@@ -5409,7 +5271,6 @@ func (me *VpcService) DescribeVpcBandwidthPackageAttachment(ctx context.Context,
 		},
 	)
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeBandwidthPackageResources(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -5450,7 +5311,6 @@ func (me *VpcService) DeleteVpcBandwidthPackageAttachmentById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().RemoveBandwidthPackageResources(request)
 	if err != nil {
 		errRet = err
@@ -5471,7 +5331,6 @@ func (me *VpcService) DescribeFlowLogs(ctx context.Context, request *vpc.Describ
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeFlowLogs(request)
 
 	if err != nil {
@@ -5503,8 +5362,6 @@ func (me *VpcService) DescribeVpcFlowLogById(ctx context.Context, flowLogId, vpc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeFlowLog(request)
 	if err != nil {
 		errRet = err
@@ -5535,8 +5392,6 @@ func (me *VpcService) DeleteVpcFlowLogById(ctx context.Context, flowLogId, vpcId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteFlowLog(request)
 	if err != nil {
 		errRet = err
@@ -5559,8 +5414,6 @@ func (me *VpcService) DescribeVpcEndPointServiceById(ctx context.Context, endPoi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -5606,8 +5459,6 @@ func (me *VpcService) DeleteVpcEndPointServiceById(ctx context.Context, endPoint
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteVpcEndPointService(request)
 	if err != nil {
 		errRet = err
@@ -5630,8 +5481,6 @@ func (me *VpcService) DescribeVpcEndPointById(ctx context.Context, endPointId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -5677,8 +5526,6 @@ func (me *VpcService) DeleteVpcEndPointById(ctx context.Context, endPointId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteVpcEndPoint(request)
 	if err != nil {
 		errRet = err
@@ -5716,8 +5563,6 @@ func (me *VpcService) DescribeVpcEndPointServiceWhiteListById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -5764,8 +5609,6 @@ func (me *VpcService) DeleteVpcEndPointServiceWhiteListById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteVpcEndPointServiceWhiteList(request)
 	if err != nil {
 		errRet = err
@@ -5796,7 +5639,6 @@ func (me *VpcService) DescribeVpcBandwidthPackageByEip(ctx context.Context, eipI
 			Values: []*string{&eipId},
 		},
 	)
-	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseVpcClient().DescribeBandwidthPackages(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -5826,8 +5668,6 @@ func (me *VpcService) DescribeVpcCcnRoutesById(ctx context.Context, ccnId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeCcnRoutes(request)
 	if err != nil {
 		errRet = err
@@ -5912,8 +5752,6 @@ func (me *VpcService) DescribeCcnCrossBorderComplianceByFilter(ctx context.Conte
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -5963,8 +5801,6 @@ func (me *VpcService) DescribeTenantCcnByFilter(ctx context.Context, param map[s
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -6031,8 +5867,6 @@ func (me *VpcService) DescribeCcnCrossBorderFlowMonitorByFilter(ctx context.Cont
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeCrossBorderFlowMonitor(request)
 	if err != nil {
 		errRet = err
@@ -6061,8 +5895,6 @@ func (me *VpcService) DescribeVpnCustomerGatewayVendors(ctx context.Context) (vp
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeCustomerGatewayVendors(request)
 	if err != nil {
 		errRet = err
@@ -6090,8 +5922,6 @@ func (me *VpcService) DescribeVpcVpnGatewayCcnRoutesById(ctx context.Context, vp
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeVpnGatewayCcnRoutes(request)
 	if err != nil {
 		errRet = err
@@ -6124,8 +5954,6 @@ func (me *VpcService) DescribeVpcIpv6AddressById(ctx context.Context, ip6Address
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeIp6Addresses(request)
 	if err != nil {
 		errRet = err
@@ -6153,8 +5981,6 @@ func (me *VpcService) DeleteVpcIpv6AddressById(ctx context.Context, ip6AddressId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().ReleaseIp6AddressesBandwidth(request)
 	if err != nil {
 		errRet = err
@@ -6199,8 +6025,6 @@ func (me *VpcService) DescribeVpcCcnRegionBandwidthLimitsByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -6250,8 +6074,6 @@ func (me *VpcService) DescribeNatDcRouteByFilter(ctx context.Context, param map[
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -6292,8 +6114,6 @@ func (me *VpcService) DescribeEipAddressQuota(ctx context.Context) (addressQuota
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeAddressQuota(request)
 	if err != nil {
 		errRet = err
@@ -6318,8 +6138,6 @@ func (me *VpcService) DescribeEipNetworkAccountType(ctx context.Context) (networ
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeNetworkAccountType(request)
 	if err != nil {
 		errRet = err
@@ -6344,8 +6162,6 @@ func (me *VpcService) DescribeVpcBandwidthPackageQuota(ctx context.Context) (ban
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeBandwidthPackageQuota(request)
 	if err != nil {
 		errRet = err
@@ -6376,8 +6192,6 @@ func (me *VpcService) DescribeVpcBandwidthPackageBillUsageByFilter(ctx context.C
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeBandwidthPackageBillUsage(request)
 	if err != nil {
 		errRet = err
@@ -6402,8 +6216,6 @@ func (me *VpcService) DescribeVpcTrafficPackageById(ctx context.Context, traffic
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeTrafficPackages(request)
 	if err != nil {
 		errRet = err
@@ -6431,8 +6243,6 @@ func (me *VpcService) DeleteVpcTrafficPackageById(ctx context.Context, trafficPa
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteTrafficPackages(request)
 	if err != nil {
 		errRet = err
@@ -6455,8 +6265,6 @@ func (me *VpcService) DescribeVpcSnapshotPoliciesById(ctx context.Context, snaps
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeSnapshotPolicies(request)
 	if err != nil {
 		errRet = err
@@ -6480,8 +6288,6 @@ func (me *VpcService) DeleteVpcSnapshotPoliciesById(ctx context.Context, snapsho
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteSnapshotPolicies(request)
 	if err != nil {
 		errRet = err
@@ -6504,8 +6310,6 @@ func (me *VpcService) DescribeVpcSnapshotPolicyAttachmentById(ctx context.Contex
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeSnapshotAttachedInstances(request)
 	if err != nil {
 		errRet = err
@@ -6540,8 +6344,6 @@ func (me *VpcService) DeleteVpcSnapshotPolicyAttachmentById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DetachSnapshotInstances(request)
 	if err != nil {
 		errRet = err
@@ -6564,8 +6366,6 @@ func (me *VpcService) DescribeVpcNetDetectById(ctx context.Context, netDetectId
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeNetDetects(request)
 	if err != nil {
 		errRet = err
@@ -6593,8 +6393,6 @@ func (me *VpcService) DeleteVpcNetDetectById(ctx context.Context, netDetectId st
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteNetDetect(request)
 	if err != nil {
 		errRet = err
@@ -6621,8 +6419,6 @@ func (me *VpcService) DescribeVpcClassicLinkAttachmentById(ctx context.Context,
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -6674,8 +6470,6 @@ func (me *VpcService) DeleteVpcClassicLinkAttachmentById(ctx context.Context, vp
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DetachClassicLinkVpc(request)
 	if err != nil {
 		errRet = err
@@ -6698,8 +6492,6 @@ func (me *VpcService) DescribeVpcDhcpIpById(ctx context.Context, dhcpIpId string
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeDhcpIps(request)
 	if err != nil {
 		errRet = err
@@ -6727,8 +6519,6 @@ func (me *VpcService) DeleteVpcDhcpIpById(ctx context.Context, dhcpIpId string)
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteDhcpIp(request)
 	if err != nil {
 		errRet = err
@@ -6751,8 +6541,6 @@ func (me *VpcService) DescribeVpcDhcpAssociateAddressById(ctx context.Context, d
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeDhcpIps(request)
 	if err != nil {
 		errRet = err
@@ -6785,8 +6573,6 @@ func (me *VpcService) DeleteVpcDhcpAssociateAddressById(ctx context.Context, dhc
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DisassociateDhcpIpWithAddressIp(request)
 	if err != nil {
 		errRet = err
@@ -6809,8 +6595,6 @@ func (me *VpcService) DescribeVpcById(ctx context.Context, vpcId string) (instan
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -6856,8 +6640,6 @@ func (me *VpcService) DeleteVpcIpv6CidrBlockById(ctx context.Context, vpcId stri
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().UnassignIpv6CidrBlock(request)
 	if err != nil {
 		errRet = err
@@ -6880,8 +6662,6 @@ func (me *VpcService) DescribeSubnetById(ctx context.Context, subnetId string) (
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -6931,8 +6711,6 @@ func (me *VpcService) DeleteVpcIpv6SubnetCidrBlockById(ctx context.Context, vpcI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().UnassignIpv6SubnetCidrBlock(request)
 	if err != nil {
 		errRet = err
@@ -6956,8 +6734,6 @@ func (me *VpcService) DescribeVpcIpv6EniAddressById(ctx context.Context, vpcId s
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeVpcIpv6Addresses(request)
 	if err != nil {
 		errRet = err
@@ -6988,8 +6764,6 @@ func (me *VpcService) DeleteVpcIpv6EniAddressById(ctx context.Context, networkIn
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().UnassignIpv6Addresses(request)
 	if err != nil {
 		errRet = err
@@ -7018,8 +6792,6 @@ func (me *VpcService) DescribeVpcLocalGatewayById(ctx context.Context, localGate
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeLocalGateway(request)
 	if err != nil {
 		errRet = err
@@ -7048,8 +6820,6 @@ func (me *VpcService) DeleteVpcLocalGatewayById(ctx context.Context, cdcId strin
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteLocalGateway(request)
 	if err != nil {
 		errRet = err
@@ -7072,8 +6842,6 @@ func (me *VpcService) DescribeVpcAccountAttributes(ctx context.Context) (account
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeAccountAttributes(request)
 	if err != nil {
 		errRet = err
@@ -7104,8 +6872,6 @@ func (me *VpcService) DescribeVpcClassicLinkInstancesByFilter(ctx context.Contex
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset int64 = 0
 		limit  int64 = 20
@@ -7170,8 +6936,6 @@ func (me *VpcService) DescribeVpcGatewayFlowMonitorDetailByFilter(ctx context.Co
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -7221,8 +6985,6 @@ func (me *VpcService) DescribeVpcGatewayFlowQosByFilter(ctx context.Context, par
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -7269,8 +7031,6 @@ func (me *VpcService) DescribeVpcCvmInstancesByFilter(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -7320,8 +7080,6 @@ func (me *VpcService) DescribeVpcNetDetectStatesByFilter(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -7368,8 +7126,6 @@ func (me *VpcService) DescribeVpcNetworkInterfaceLimit(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeNetworkInterfaceLimit(request)
 	if err != nil {
 		errRet = err
@@ -7403,8 +7159,6 @@ func (me *VpcService) DescribeVpcPrivateIpAddresses(ctx context.Context, param m
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeVpcPrivateIpAddresses(request)
 	if err != nil {
 		errRet = err
@@ -7435,8 +7189,6 @@ func (me *VpcService) DescribeVpcProductQuota(ctx context.Context, param map[str
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeProductQuota(request)
 	if err != nil {
 		errRet = err
@@ -7467,8 +7219,6 @@ func (me *VpcService) DescribeVpcResourceDashboard(ctx context.Context, param ma
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeVpcResourceDashboard(request)
 	if err != nil {
 		errRet = err
@@ -7502,8 +7252,6 @@ func (me *VpcService) DescribeVpcRouteConflicts(ctx context.Context, param map[s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeRouteConflicts(request)
 	if err != nil {
 		errRet = err
@@ -7528,8 +7276,6 @@ func (me *VpcService) DescribeVpcSecurityGroupLimits(ctx context.Context, param
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeSecurityGroupLimits(request)
 	if err != nil {
 		errRet = err
@@ -7560,8 +7306,6 @@ func (me *VpcService) DescribeVpcSecurityGroupReferences(ctx context.Context, pa
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeSecurityGroupReferences(request)
 	if err != nil {
 		errRet = err
@@ -7598,8 +7342,6 @@ func (me *VpcService) DescribeVpcSgSnapshotFileContent(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeSgSnapshotFileContent(request)
 	if err != nil {
 		errRet = err
@@ -7639,8 +7381,6 @@ func (me *VpcService) DescribeVpcSnapshotFilesByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -7687,8 +7427,6 @@ func (me *VpcService) DescribeVpcSubnetResourceDashboardByFilter(ctx context.Con
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeSubnetResourceDashboard(request)
 	if err != nil {
 		errRet = err
@@ -7713,8 +7451,6 @@ func (me *VpcService) DescribeVpcTemplateLimits(ctx context.Context) (templateLi
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeTemplateLimits(request)
 	if err != nil {
 		errRet = err
@@ -7751,8 +7487,6 @@ func (me *VpcService) DescribeVpcUsedIpAddressByFilter(ctx context.Context, para
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	var (
 		offset uint64 = 0
 		limit  uint64 = 20
@@ -7799,8 +7533,6 @@ func (me *VpcService) DescribeVpcLimitsByFilter(ctx context.Context, param map[s
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeVpcLimits(request)
 	if err != nil {
 		errRet = err
@@ -7825,8 +7557,6 @@ func (me *VpcService) DescribeVpcNetworkAclQuintupleById(ctx context.Context, ne
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeNetworkAclQuintupleEntries(request)
 	if err != nil {
 		errRet = err
@@ -7854,8 +7584,6 @@ func (me *VpcService) DeleteVpcNetworkAclQuintupleById(ctx context.Context, netw
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DeleteNetworkAclQuintupleEntries(request)
 	if err != nil {
 		errRet = err
@@ -7879,8 +7607,6 @@ func (me *VpcService) DeleteVpcEniSgAttachmentById(ctx context.Context, networkI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DisassociateNetworkInterfaceSecurityGroups(request)
 	if err != nil {
 		errRet = err
@@ -7927,8 +7653,6 @@ func (me *VpcService) DescribeVpcNetDetectStateCheck(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().CheckNetDetectState(request)
 	if err != nil {
 		errRet = err
@@ -7953,8 +7677,6 @@ func (me *VpcService) DescribeVpcNotifyRoutesById(ctx context.Context, routeTabl
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().DescribeRouteTables(request)
 	if err != nil {
 		errRet = err
@@ -7990,8 +7712,6 @@ func (me *VpcService) DeleteVpcNotifyRoutesById(ctx context.Context, routeTableI
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().WithdrawNotifyRoutes(request)
 	if err != nil {
 		errRet = err
@@ -8020,8 +7740,6 @@ func (me *VpcService) DescribeVpnDefaultHealthCheckIp(ctx context.Context, param
 		}
 	}
 
-	ratelimit.Check(request.GetAction())
-
 	response, err := me.client.UseVpcClient().GenerateVpnConnectionDefaultHealthCheckIp(request)
 	if err != nil {
 		errRet = err
@@ -1,47 +1,465 @@
 package tencentcloud
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io"
+	"log"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"text/tabwriter"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
-	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/mockapi"
 )
 
+const (
+	// PROVIDER_SWEEP_DRY_RUN makes the sweepers report the resources to be deleted without deleting them
+	PROVIDER_SWEEP_DRY_RUN = "TENCENTCLOUD_SWEEP_DRY_RUN"
+	// PROVIDER_SWEEP_MIN_AGE is the duration, like `2h`, the resources younger than which are not swept, 30m by default
+	PROVIDER_SWEEP_MIN_AGE = "TENCENTCLOUD_SWEEP_MIN_AGE"
+)
+
+// testAccTagKey is the tag added to the resources created by the acceptance tests, whose value is the unix time
+// the tests started, so the sweepers find the leaked resources by it
+const testAccTagKey = "tencentcloud-terraform-acc-test"
+
+var testAccTagValue = strconv.FormatInt(time.Now().Unix(), 10)
+
+const defaultSweepMinAge = 30 * time.Minute
+
 func TestMain(m *testing.M) {
 	resource.TestMain(m)
+	// the sweepers ran if TestMain returns
+	printSweepSummaries(os.Stdout)
 }
 
+// testAccConfigureWithTestTag adds testAccTagKey to the default tags of the provider configured by configure.
+// The tag is not added in the cassette modes, since its value changes every run.
+func testAccConfigureWithTestTag(configure schema.ConfigureFunc) schema.ConfigureFunc {
+	return func(d *schema.ResourceData) (interface{}, error) {
+		meta, err := configure(d)
+		if err != nil || os.Getenv(PROVIDER_CASSETTE_MODE) != "" {
+			return meta, err
+		}
+		client := meta.(*TencentCloudClient)
+		if client.defaultTags == nil {
+			client.defaultTags = make(map[string]string)
+		}
+		if _, ok := client.defaultTags[testAccTagKey]; !ok {
+			client.defaultTags[testAccTagKey] = testAccTagValue
+		}
+		return meta, nil
+	}
+}
+
+var sharedClients = struct {
+	sync.Mutex
+	clients map[string]*TencentCloudClient
+}{clients: make(map[string]*TencentCloudClient)}
+
+// sharedClientForRegion returns the client of the region configured like the provider, so the credentials of the
+// environment, the shared credentials and the assumed roles all work
 func sharedClientForRegion(region string) (interface{}, error) {
-	var secretId string
-	if secretId = os.Getenv(PROVIDER_SECRET_ID); secretId == "" {
-		return nil, fmt.Errorf("%s can not be empty", PROVIDER_SECRET_ID)
+	sharedClients.Lock()
+	defer sharedClients.Unlock()
+
+	if client, ok := sharedClients.clients[region]; ok {
+		return client, nil
+	}
+	provider := Provider()
+	diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"region": region,
+	}))
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return nil, fmt.Errorf("configure provider of region %s failed: %s %s", region, d.Summary, d.Detail)
+		}
+	}
+	client := provider.Meta().(*TencentCloudClient)
+	sharedClients.clients[region] = client
+	return client, nil
+}
+
+// sweepResource is a resource found by a sweeper
+type sweepResource struct {
+	Id   string
+	Name string
+	// CreatedTime is zero if unknown, then the age of a tagged resource is of its tag, and the untagged resource is
+	// not swept
+	CreatedTime time.Time
+	// Tagged is whether the resource is tagged by testAccTagKey
+	Tagged bool
+	// InUse is why the resource can not be deleted, like a disk attached to an instance
+	InUse string
+}
+
+// sweeper sweeps a kind of resources, the ones tagged by testAccTagKey and the ones not tagged but not kept by their
+// names, see persistResource. The resources younger than PROVIDER_SWEEP_MIN_AGE are not swept.
+type sweeper struct {
+	// Name is the resource type, like tencentcloud_vpc
+	Name string
+	// ServiceType and ResourcePrefix are the ones of the tag resource names, see BuildTagResourceName
+	ServiceType    string
+	ResourcePrefix string
+	// Dependencies are the sweepers which run before this one, like the ones of the resources in a VPC
+	Dependencies []string
+	// List returns the resources of the region, it can be nil, then only the tagged resources are swept
+	List   func(ctx context.Context, client *TencentCloudClient) ([]*sweepResource, error)
+	Delete func(ctx context.Context, client *TencentCloudClient, id string) error
+}
+
+// addTestSweeper registers the sweeper, run like `go test ./tencentcloud -v -sweep=ap-guangzhou -sweep-run=tencentcloud_vpc`
+func addTestSweeper(s *sweeper) {
+	resource.AddTestSweepers(s.Name, &resource.Sweeper{
+		Name:         s.Name,
+		Dependencies: s.Dependencies,
+		F:            s.sweep,
+	})
+}
+
+func (me *sweeper) sweep(region string) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	cli, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("getting tencentcloud client error: %s", err.Error())
+	}
+	client := cli.(*TencentCloudClient)
+
+	resources, err := me.describe(ctx, client, region)
+	if err != nil {
+		return err
+	}
+
+	minAge := sweepMinAge()
+	dryRun := sweepDryRun()
+	summary := getSweepSummary(region, me.Name)
+	for _, r := range resources {
+		summary.Found++
+		if reason := r.skipReason(minAge); reason != "" {
+			summary.Skipped++
+			log.Printf("[DEBUG]%s sweeper %s skips %s(%s): %s", logId, me.Name, r.Id, r.Name, reason)
+			continue
+		}
+		if dryRun {
+			summary.Deleted++
+			log.Printf("[INFO]%s sweeper %s would delete %s(%s)", logId, me.Name, r.Id, r.Name)
+			continue
+		}
+		if err := me.Delete(ctx, client, r.Id); err != nil {
+			summary.Failed++
+			log.Printf("[CRITAL]%s sweeper %s delete %s(%s) failed: %s", logId, me.Name, r.Id, r.Name, err.Error())
+			continue
+		}
+		summary.Deleted++
+		log.Printf("[INFO]%s sweeper %s deleted %s(%s)", logId, me.Name, r.Id, r.Name)
+	}
+	log.Printf("[INFO]%s sweeper %s of region %s: %+v", logId, me.Name, region, *summary)
+	return nil
+}
+
+// describe returns the listed resources and the tagged ones not listed
+func (me *sweeper) describe(ctx context.Context, client *TencentCloudClient, region string) ([]*sweepResource, error) {
+	var resources []*sweepResource
+	listed := make(map[string]*sweepResource)
+	if me.List != nil {
+		list, err := me.List(ctx, client)
+		if err != nil {
+			return nil, fmt.Errorf("get instance list error: %s", err.Error())
+		}
+		for _, r := range list {
+			listed[r.Id] = r
+			resources = append(resources, r)
+		}
+	}
+
+	tagged, err := describeSweepTaggedResources(ctx, client, region)
+	if err != nil {
+		// the untagged resources are still swept without the permission of the tag API
+		log.Printf("[WARN]%s sweeper %s describe tagged resources failed: %s", getLogId(ctx), me.Name, err.Error())
+		return resources, nil
+	}
+	var ids []string
+	for id := range tagged[me.ServiceType+"/"+me.ResourcePrefix] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		taggedTime := tagged[me.ServiceType+"/"+me.ResourcePrefix][id]
+		if r, ok := listed[id]; ok {
+			r.Tagged = true
+			if r.CreatedTime.IsZero() {
+				r.CreatedTime = taggedTime
+			}
+			continue
+		}
+		resources = append(resources, &sweepResource{Id: id, CreatedTime: taggedTime, Tagged: true})
+	}
+	return resources, nil
+}
+
+func (me *sweepResource) skipReason(minAge time.Duration) string {
+	if persistResource.MatchString(me.Name) {
+		return "kept by name"
+	}
+	if me.CreatedTime.IsZero() {
+		// the resources tagged by the acceptance tests are swept even if their ages are unknown
+		if !me.Tagged {
+			return "created at an unknown time"
+		}
+	} else if time.Since(me.CreatedTime) < minAge {
+		return fmt.Sprintf("created within %s", minAge)
+	}
+	return me.InUse
+}
+
+var sweepTaggedResources = struct {
+	sync.Mutex
+	regions map[string]map[string]map[string]time.Time
+}{regions: make(map[string]map[string]map[string]time.Time)}
+
+// describeSweepTaggedResources returns the resources of the region tagged by testAccTagKey, which are grouped by
+// `<service type>/<resource prefix>` and keyed by the ids, with the time of the tags
+func describeSweepTaggedResources(ctx context.Context, client *TencentCloudClient, region string) (map[string]map[string]time.Time, error) {
+	sweepTaggedResources.Lock()
+	defer sweepTaggedResources.Unlock()
+
+	if resources, ok := sweepTaggedResources.regions[region]; ok {
+		return resources, nil
+	}
+	service := TagService{client: client.apiV3Conn}
+	tagged, err := service.DescribeResourcesByTagKey(ctx, testAccTagKey)
+	if err != nil {
+		return nil, err
+	}
+	resources := make(map[string]map[string]time.Time)
+	for name, tags := range tagged {
+		serviceType, resourcePrefix, resourceRegion, id, err := ParseTagResourceName(name)
+		if err != nil {
+			log.Printf("[WARN]%s %s", getLogId(ctx), err.Error())
+			continue
+		}
+		if resourceRegion != region {
+			continue
+		}
+		var taggedTime time.Time
+		if unix, err := strconv.ParseInt(tags[testAccTagKey], 10, 64); err == nil {
+			taggedTime = time.Unix(unix, 0)
+		}
+		group := serviceType + "/" + resourcePrefix
+		if resources[group] == nil {
+			resources[group] = make(map[string]time.Time)
+		}
+		resources[group][id] = taggedTime
+	}
+	sweepTaggedResources.regions[region] = resources
+	return resources, nil
+}
+
+func sweepDryRun() bool {
+	dryRun, _ := strconv.ParseBool(os.Getenv(PROVIDER_SWEEP_DRY_RUN))
+	return dryRun
+}
+
+func sweepMinAge() time.Duration {
+	value := os.Getenv(PROVIDER_SWEEP_MIN_AGE)
+	if value == "" {
+		return defaultSweepMinAge
+	}
+	minAge, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("[WARN] invalid %s %q, %s is used: %s", PROVIDER_SWEEP_MIN_AGE, value, defaultSweepMinAge, err.Error())
+		return defaultSweepMinAge
+	}
+	return minAge
+}
+
+// sweepSummary counts the resources of a sweeper in a region, Deleted is the count to be deleted in the dry run
+type sweepSummary struct {
+	Found   int
+	Deleted int
+	Skipped int
+	Failed  int
+}
+
+var sweepSummaries = struct {
+	sync.Mutex
+	regions map[string]map[string]*sweepSummary
+}{regions: make(map[string]map[string]*sweepSummary)}
+
+func getSweepSummary(region, name string) *sweepSummary {
+	sweepSummaries.Lock()
+	defer sweepSummaries.Unlock()
+
+	if sweepSummaries.regions[region] == nil {
+		sweepSummaries.regions[region] = make(map[string]*sweepSummary)
+	}
+	summary, ok := sweepSummaries.regions[region][name]
+	if !ok {
+		summary = &sweepSummary{}
+		sweepSummaries.regions[region][name] = summary
+	}
+	return summary
+}
+
+func printSweepSummaries(out io.Writer) {
+	sweepSummaries.Lock()
+	defer sweepSummaries.Unlock()
+
+	if len(sweepSummaries.regions) == 0 {
+		return
+	}
+	deleted := "DELETED"
+	if sweepDryRun() {
+		deleted = "WOULD DELETE"
+	}
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "REGION\tRESOURCE\tFOUND\t%s\tSKIPPED\tFAILED\n", deleted)
+	var regions []string
+	for region := range sweepSummaries.regions {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	for _, region := range regions {
+		var names []string
+		for name := range sweepSummaries.regions[region] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			s := sweepSummaries.regions[region][name]
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\n", region, name, s.Found, s.Deleted, s.Skipped, s.Failed)
+		}
+	}
+	_ = w.Flush()
+}
+
+func TestSweeper(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	meta := testMockapiMeta(server)
+	region := meta.apiV3Conn.Region
+
+	sharedClients.Lock()
+	sharedClients.clients[region] = meta
+	sharedClients.Unlock()
+	defer func() {
+		sharedClients.Lock()
+		delete(sharedClients.clients, region)
+		sharedClients.Unlock()
+	}()
+
+	createVpc := func(name string, tags map[string]string) string {
+		meta.defaultTags = tags
+		state := testMockapiApply(t, meta, "tencentcloud_vpc", nil, map[string]interface{}{
+			"name":       name,
+			"cidr_block": "10.0.0.0/16",
+		})
+		return state.ID
+	}
+	leaked := createVpc("tf-leaked", map[string]string{
+		testAccTagKey: strconv.FormatInt(time.Now().Add(-2*time.Hour).Unix(), 10),
+	})
+	running := createVpc("tf-running", map[string]string{testAccTagKey: testAccTagValue})
+	untagged := createVpc("tf-untagged", nil)
+	kept := createVpc("keep-vpc", nil)
+
+	vpcService := VpcService{client: meta.apiV3Conn}
+	exists := func(id string) bool {
+		_, has, err := vpcService.DescribeVpc(context.TODO(), id, "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return has == 1
+	}
+	s := &sweeper{
+		Name:           "test_vpc",
+		ServiceType:    "vpc",
+		ResourcePrefix: "vpc",
+		Delete: func(ctx context.Context, client *TencentCloudClient, id string) error {
+			service := VpcService{client: client.apiV3Conn}
+			return service.DeleteVpc(ctx, id)
+		},
+	}
+	run := func(s *sweeper) sweepSummary {
+		sweepTaggedResources.Lock()
+		delete(sweepTaggedResources.regions, region)
+		sweepTaggedResources.Unlock()
+		sweepSummaries.Lock()
+		delete(sweepSummaries.regions, region)
+		sweepSummaries.Unlock()
+		if err := s.sweep(region); err != nil {
+			t.Fatal(err)
+		}
+		return *getSweepSummary(region, s.Name)
 	}
 
-	var secretKey string
-	if secretKey = os.Getenv(PROVIDER_SECRET_KEY); secretKey == "" {
-		return nil, fmt.Errorf("%s can not be empty", PROVIDER_SECRET_KEY)
+	t.Setenv(PROVIDER_SWEEP_DRY_RUN, "true")
+	if summary := run(s); summary != (sweepSummary{Found: 2, Deleted: 1, Skipped: 1}) || !exists(leaked) {
+		t.Errorf("unexpected dry run %+v", summary)
+	}
+	var out bytes.Buffer
+	printSweepSummaries(&out)
+	if !strings.Contains(out.String(), "WOULD DELETE") || !strings.Contains(out.String(), "test_vpc") {
+		t.Errorf("unexpected summary %s", out.String())
 	}
 
-	securityToken := os.Getenv(PROVIDER_SECURITY_TOKEN)
-	protocol := os.Getenv(PROVIDER_PROTOCOL)
-	domain := os.Getenv(PROVIDER_DOMAIN)
+	t.Setenv(PROVIDER_SWEEP_DRY_RUN, "")
+	if summary := run(s); summary != (sweepSummary{Found: 2, Deleted: 1, Skipped: 1}) || exists(leaked) || !exists(running) {
+		t.Errorf("unexpected sweep %+v", summary)
+	}
 
-	client := &connectivity.TencentCloudClient{
-		Credential: common.NewTokenCredential(
-			secretId,
-			secretKey,
-			securityToken,
-		),
-		Region:   region,
-		Protocol: protocol,
-		Domain:   domain,
+	// the listed resources are swept by their names and ages without the tags, the untagged ones of unknown ages
+	// are kept
+	createdTimes := make(map[string]time.Time)
+	s.List = func(ctx context.Context, client *TencentCloudClient) ([]*sweepResource, error) {
+		service := VpcService{client: client.apiV3Conn}
+		vpcs, err := service.DescribeVpcs(ctx, "", "", nil, nil, "", "")
+		if err != nil {
+			return nil, err
+		}
+		resources := make([]*sweepResource, 0, len(vpcs))
+		for _, v := range vpcs {
+			resources = append(resources, &sweepResource{Id: v.vpcId, Name: v.name, CreatedTime: createdTimes[v.vpcId]})
+		}
+		return resources, nil
+	}
+	t.Setenv(PROVIDER_SWEEP_MIN_AGE, "1h")
+	if summary := run(s); summary != (sweepSummary{Found: 3, Skipped: 3}) || !exists(untagged) || !exists(running) || !exists(kept) {
+		t.Errorf("unexpected sweep of the listed resources of unknown ages %+v", summary)
 	}
 
-	var tcClient TencentCloudClient
-	tcClient.apiV3Conn = client
+	createdTimes[untagged] = time.Now().Add(-2 * time.Hour)
+	createdTimes[kept] = time.Now().Add(-2 * time.Hour)
+	if summary := run(s); summary != (sweepSummary{Found: 3, Deleted: 1, Skipped: 2}) || exists(untagged) || !exists(running) || !exists(kept) {
+		t.Errorf("unexpected sweep of the listed resources %+v", summary)
+	}
+}
 
-	return &tcClient, nil
+func TestParseTagResourceName(t *testing.T) {
+	for _, name := range []string{
+		BuildTagResourceName("vpc", "subnet", "ap-guangzhou", "subnet-1"),
+		"qcs::cos:ap-guangzhou:uid/1250000000:bucket/test-1250000000",
+	} {
+		serviceType, resourceType, region, id, err := ParseTagResourceName(name)
+		if err != nil || region != "ap-guangzhou" || BuildTagResourceName(serviceType, resourceType, region, id) != strings.Replace(name, "uid/1250000000", "uid/", 1) {
+			t.Errorf("unexpected %s %s %s %s of %s: %v", serviceType, resourceType, region, id, name, err)
+		}
+	}
+	for _, name := range []string{"", "qcs::vpc:ap-guangzhou:uin/", "qcs::vpc:ap-guangzhou:uin/:subnet"} {
+		if _, _, _, _, err := ParseTagResourceName(name); err == nil {
+			t.Errorf("expected error of %q", name)
+		}
+	}
 }
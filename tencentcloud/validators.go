@@ -3,6 +3,7 @@ package tencentcloud
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
@@ -467,3 +468,48 @@ func validateTkeGpuDriverVersion(v interface{}, k string) (ws []string, errors [
 	}
 	return
 }
+
+func validateApiRateLimits(v interface{}, k string) (ws []string, errors []error) {
+	for key, limit := range v.(map[string]interface{}) {
+		items := strings.Split(key, ".")
+		if len(items) > 2 || items[0] == "" || (len(items) == 2 && items[1] == "") {
+			errors = append(errors, fmt.Errorf("%q key %q must be `product` or `product.Action`", k, key))
+		}
+		if value, err := strconv.ParseFloat(fmt.Sprint(limit), 64); err == nil && value <= 0 {
+			errors = append(errors, fmt.Errorf("%q value of %q must be greater than 0, got %v", k, key, limit))
+		}
+	}
+	return
+}
+
+func validateEndpoint(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !strings.Contains(value, "://") {
+		if value == "" || strings.ContainsAny(value, "/ ") {
+			errors = append(errors, fmt.Errorf("%q must be a host or URL, got %q", k, value))
+		}
+		return
+	}
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") || strings.Trim(u.Path, "/") != "" {
+		errors = append(errors, fmt.Errorf("%q must be a URL like `https://host:port` without path, got %q", k, value))
+	}
+	return
+}
+
+func validateProxyURL(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "socks5") {
+		errors = append(errors, fmt.Errorf("%q must be a URL like `http://host:port` with scheme `http`, `https` or `socks5`, got %q", k, value))
+	}
+	return
+}
+
+func validateRegion(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regionRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be a region like `ap-guangzhou`, got %q", k, value))
+	}
+	return
+}